@@ -3,12 +3,15 @@ package main
 import (
 	"flag"
 	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
 	"strconv"
 	"time"
 
 	"github.com/renderedtext/go-watchman"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/plumber"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/retention"
 	privateserver "github.com/semaphoreio/semaphore/artifacthub/pkg/server/private"
 	publicserver "github.com/semaphoreio/semaphore/artifacthub/pkg/server/public"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/storage"
@@ -16,19 +19,29 @@ import (
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/workers/bucketcleaner"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/workers/jobdeletion"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/workers/pipelinedeletion"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/workers/uploadjanitor"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/workers/usagescanner"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/workers/workflowdeletion"
 	"go.uber.org/zap"
 )
 
 var (
-	grpcPrivatePort = flag.Int("grpc_private_port", 50051, "The internal GRPC server port")
-	grpcPublicPort  = flag.Int("grpc_public_port", 50052, "The public GRPC server port")
+	grpcPrivatePort  = flag.Int("grpc_private_port", 50051, "The internal GRPC server port")
+	grpcPublicPort   = flag.Int("grpc_public_port", 50052, "The public GRPC server port")
+	localStoragePort = flag.Int("local_storage_port", 9001, "The port serving files for the local storage backend")
 
 	metricPrefix                              = fmt.Sprintf("%s.%s", "artifacthub", os.Getenv("METRICS_NAMESPACE"))
 	amqpURL                                   = os.Getenv("AMQP_URL")
 	bucketcleanerSchedulerNaptime             = os.Getenv("BUCKETCLEANER_SCHEDULER_NAPTIME")
 	bucketcleanerSchedulerBatchSize           = os.Getenv("BUCKETCLEANER_SCHEDULER_BATCHSIZE")
 	bucketcleanerWorkerNumberOfObjectsInOneGo = os.Getenv("BUCKETCLEANER_WORKER_NUMBER_OF_PAGES_TO_PROCESS_IN_ONE_GO")
+	usageScannerNaptime                       = os.Getenv("USAGE_SCANNER_NAPTIME")
+	usageScannerBatchSize                     = os.Getenv("USAGE_SCANNER_BATCHSIZE")
+	usageScannerIntervalInHours               = os.Getenv("USAGE_SCANNER_INTERVAL_IN_HOURS")
+	uploadJanitorNaptime                      = os.Getenv("UPLOAD_JANITOR_NAPTIME")
+	uploadJanitorBatchSize                    = os.Getenv("UPLOAD_JANITOR_BATCHSIZE")
+	uploadJanitorMaxAgeInHours                = os.Getenv("UPLOAD_JANITOR_MAX_AGE_IN_HOURS")
+	plumberEndpoint                           = os.Getenv("INTERNAL_API_URL_PLUMBER")
 )
 
 func configureWatchman() {
@@ -76,11 +89,45 @@ func publicAPI(client storage.Client, secret string) {
 
 func internalAPI(client storage.Client, secret string) {
 	s := privateserver.NewServer(*grpcPrivatePort, client, secret)
+	s.WorkflowRefs = workflowRefs()
 	log.Info("Starting internal API...")
 	s.Serve()
 	log.Info("...internal API stopped")
 }
 
+// Retention rules excluding branches or tags look up workflows in plumber.
+// Without it, such policies are not applied.
+func workflowRefs() retention.WorkflowRefs {
+	if plumberEndpoint == "" {
+		return nil
+	}
+
+	client, err := plumber.NewWorkflowClient(plumberEndpoint)
+	if err != nil {
+		log.Error("Failed to connect to plumber", zap.Error(err))
+		panic(err)
+	}
+
+	return client
+}
+
+func localStorageServer(client *storage.LocalStorage) {
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", *localStoragePort),
+		Handler:           client,
+		ReadHeaderTimeout: 10 * time.Second,
+
+		// Artifacts can be big, so requests have time to finish on slow connections,
+		// but they can't keep a connection open forever.
+		WriteTimeout: time.Hour,
+	}
+
+	log.Info("Starting local storage server...")
+	if err := server.ListenAndServe(); err != nil {
+		log.Error("Local storage server stopped", zap.Error(err))
+	}
+}
+
 func bucketcleanerScheduler() {
 	batchSize, err := strconv.ParseInt(bucketcleanerSchedulerBatchSize, 10, 64)
 	if err != nil {
@@ -118,10 +165,67 @@ func bucketcleanerWorker(client storage.Client) {
 	}
 
 	worker.NumberOfPagesToProcessInOneGo = int(pages)
+	worker.WorkflowRefs = workflowRefs()
 
 	worker.Start()
 }
 
+func usageScanner(client storage.Client) {
+	batchSize, err := strconv.ParseInt(usageScannerBatchSize, 10, 64)
+	if err != nil {
+		log.Error("Failed to parse USAGE_SCANNER_BATCHSIZE")
+		panic(err)
+	}
+
+	naptimeInSecs, err := strconv.ParseInt(usageScannerNaptime, 10, 64)
+	if err != nil {
+		log.Error("Failed to parse USAGE_SCANNER_NAPTIME")
+		panic(err)
+	}
+
+	intervalInHours, err := strconv.ParseInt(usageScannerIntervalInHours, 10, 64)
+	if err != nil || intervalInHours <= 0 {
+		intervalInHours = 24
+	}
+
+	naptime := time.Duration(naptimeInSecs) * time.Second
+	interval := time.Duration(intervalInHours) * time.Hour
+
+	log.Info("Starting usage scanner...")
+	usagescanner.NewScanner(client, naptime, int(batchSize), interval).Start()
+}
+
+func uploadJanitor(client storage.Client) {
+	multipartClient, ok := client.(storage.MultipartClient)
+	if !ok {
+		log.Info("Storage backend doesn't support multipart uploads, not starting upload janitor")
+		return
+	}
+
+	batchSize, err := strconv.ParseInt(uploadJanitorBatchSize, 10, 64)
+	if err != nil {
+		log.Error("Failed to parse UPLOAD_JANITOR_BATCHSIZE")
+		panic(err)
+	}
+
+	naptimeInSecs, err := strconv.ParseInt(uploadJanitorNaptime, 10, 64)
+	if err != nil {
+		log.Error("Failed to parse UPLOAD_JANITOR_NAPTIME")
+		panic(err)
+	}
+
+	maxAgeInHours, err := strconv.ParseInt(uploadJanitorMaxAgeInHours, 10, 64)
+	if err != nil || maxAgeInHours <= 0 {
+		maxAgeInHours = 48
+	}
+
+	naptime := time.Duration(naptimeInSecs) * time.Second
+	maxAge := time.Duration(maxAgeInHours) * time.Hour
+
+	log.Info("Starting upload janitor...")
+	uploadjanitor.NewJanitor(multipartClient, naptime, int(batchSize), maxAge).Start()
+}
+
 func jobDeletionWorker(client storage.Client) {
 	log.Info("Starting job deletion workers...")
 	parallelWorkers := os.Getenv("JOB_DELETION_WORKER_PARALLEL_WORKERS")
@@ -193,6 +297,16 @@ func main() {
 		go internalAPI(storageClient, secret)
 	}
 
+	if os.Getenv("START_LOCAL_STORAGE_SERVER") == "yes" {
+		localStorage, ok := storageClient.(*storage.LocalStorage)
+		if !ok {
+			fmt.Println("START_LOCAL_STORAGE_SERVER requires ARTIFACT_STORAGE_BACKEND=local")
+			os.Exit(1)
+		}
+
+		go localStorageServer(localStorage)
+	}
+
 	if os.Getenv("START_BUCKETCLEANER_SCHEDULER") == "yes" {
 		go bucketcleanerScheduler()
 	}
@@ -201,6 +315,14 @@ func main() {
 		go bucketcleanerWorker(storageClient)
 	}
 
+	if os.Getenv("START_USAGE_SCANNER") == "yes" {
+		go usageScanner(storageClient)
+	}
+
+	if os.Getenv("START_UPLOAD_JANITOR") == "yes" {
+		go uploadJanitor(storageClient)
+	}
+
 	if os.Getenv("START_JOB_DELETION_WORKER") == "yes" {
 		go jobDeletionWorker(storageClient)
 	}
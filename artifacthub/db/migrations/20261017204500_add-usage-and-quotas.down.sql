@@ -0,0 +1,11 @@
+begin;
+
+DROP TABLE storage_quotas;
+DROP TABLE artifact_usages;
+
+DROP INDEX index_artifacts_on_org_id;
+
+ALTER TABLE artifacts DROP COLUMN usage_scanned_at;
+ALTER TABLE artifacts DROP COLUMN org_id;
+
+commit;
@@ -0,0 +1,36 @@
+begin;
+
+ALTER TABLE artifacts ADD COLUMN org_id uuid;
+ALTER TABLE artifacts ADD COLUMN usage_scanned_at timestamp;
+
+CREATE INDEX index_artifacts_on_org_id ON artifacts USING btree (org_id);
+
+CREATE TABLE artifact_usages (
+  id uuid DEFAULT uuid_generate_v4() NOT NULL,
+  artifact_id uuid NOT NULL,
+
+  category     text NOT NULL,
+  category_id  text NOT NULL,
+  size         bigint DEFAULT 0 NOT NULL,
+  object_count bigint DEFAULT 0 NOT NULL,
+  scanned_at   timestamp NOT NULL,
+
+  PRIMARY KEY(id),
+  CONSTRAINT fk_artifact_usages_artifact_id FOREIGN KEY(artifact_id) REFERENCES artifacts(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX uix_artifact_usages_category ON artifact_usages USING btree (artifact_id, category, category_id);
+
+CREATE TABLE storage_quotas (
+  org_id uuid NOT NULL,
+
+  soft_limit bigint DEFAULT 0 NOT NULL,
+  hard_limit bigint DEFAULT 0 NOT NULL,
+
+  created_at timestamp NOT NULL,
+  updated_at timestamp NOT NULL,
+
+  PRIMARY KEY(org_id)
+);
+
+commit;
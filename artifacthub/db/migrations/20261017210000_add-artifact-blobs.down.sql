@@ -0,0 +1,6 @@
+begin;
+
+DROP TABLE artifact_object_refs;
+DROP TABLE artifact_blobs;
+
+commit;
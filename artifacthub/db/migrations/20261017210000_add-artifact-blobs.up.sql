@@ -0,0 +1,28 @@
+begin;
+
+CREATE TABLE artifact_blobs (
+  artifact_id uuid NOT NULL,
+  digest      text NOT NULL,
+
+  ref_count   bigint DEFAULT 0 NOT NULL,
+  created_at  timestamp NOT NULL,
+  released_at timestamp,
+
+  PRIMARY KEY(artifact_id, digest),
+  CONSTRAINT fk_artifact_blobs_artifact_id FOREIGN KEY(artifact_id) REFERENCES artifacts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX index_artifact_blobs_on_released_at ON artifact_blobs USING btree (released_at) WHERE ref_count = 0;
+
+CREATE TABLE artifact_object_refs (
+  artifact_id uuid NOT NULL,
+  path        text NOT NULL,
+  digest      text NOT NULL,
+
+  created_at  timestamp NOT NULL,
+
+  PRIMARY KEY(artifact_id, path),
+  CONSTRAINT fk_artifact_object_refs_blob FOREIGN KEY(artifact_id, digest) REFERENCES artifact_blobs(artifact_id, digest) ON DELETE CASCADE
+);
+
+commit;
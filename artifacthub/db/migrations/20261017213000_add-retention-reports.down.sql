@@ -0,0 +1,6 @@
+begin;
+
+DROP TABLE retention_report_objects;
+DROP TABLE retention_reports;
+
+commit;
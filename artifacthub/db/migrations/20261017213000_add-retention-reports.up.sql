@@ -0,0 +1,30 @@
+begin;
+
+CREATE TABLE retention_reports (
+  id            uuid DEFAULT uuid_generate_v4() NOT NULL PRIMARY KEY,
+  artifact_id   uuid NOT NULL,
+
+  started_at    timestamp NOT NULL,
+  finished_at   timestamp,
+
+  visited_count bigint DEFAULT 0 NOT NULL,
+  deleted_count bigint DEFAULT 0 NOT NULL,
+  deleted_size  bigint DEFAULT 0 NOT NULL,
+
+  CONSTRAINT fk_retention_reports_artifact_id FOREIGN KEY(artifact_id) REFERENCES artifacts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX index_retention_reports_on_artifact_id_started_at ON retention_reports USING btree (artifact_id, started_at);
+
+CREATE TABLE retention_report_objects (
+  report_id uuid NOT NULL,
+  path      text NOT NULL,
+
+  size      bigint DEFAULT 0 NOT NULL,
+  age       bigint DEFAULT 0 NOT NULL,
+
+  PRIMARY KEY(report_id, path),
+  CONSTRAINT fk_retention_report_objects_report_id FOREIGN KEY(report_id) REFERENCES retention_reports(id) ON DELETE CASCADE
+);
+
+commit;
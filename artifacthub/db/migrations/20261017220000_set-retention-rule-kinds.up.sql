@@ -0,0 +1,24 @@
+begin;
+
+UPDATE retention_policies
+SET project_level_policies = jsonb_set(project_level_policies, '{rules}', (
+  SELECT COALESCE(jsonb_agg(rule || '{"kind": "age"}'::jsonb ORDER BY position), '[]'::jsonb)
+  FROM jsonb_array_elements(project_level_policies->'rules') WITH ORDINALITY AS rules(rule, position)
+))
+WHERE jsonb_typeof(project_level_policies->'rules') = 'array';
+
+UPDATE retention_policies
+SET workflow_level_policies = jsonb_set(workflow_level_policies, '{rules}', (
+  SELECT COALESCE(jsonb_agg(rule || '{"kind": "age"}'::jsonb ORDER BY position), '[]'::jsonb)
+  FROM jsonb_array_elements(workflow_level_policies->'rules') WITH ORDINALITY AS rules(rule, position)
+))
+WHERE jsonb_typeof(workflow_level_policies->'rules') = 'array';
+
+UPDATE retention_policies
+SET job_level_policies = jsonb_set(job_level_policies, '{rules}', (
+  SELECT COALESCE(jsonb_agg(rule || '{"kind": "age"}'::jsonb ORDER BY position), '[]'::jsonb)
+  FROM jsonb_array_elements(job_level_policies->'rules') WITH ORDINALITY AS rules(rule, position)
+))
+WHERE jsonb_typeof(job_level_policies->'rules') = 'array';
+
+commit;
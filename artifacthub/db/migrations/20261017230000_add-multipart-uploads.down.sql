@@ -0,0 +1,5 @@
+begin;
+
+DROP TABLE multipart_uploads;
+
+commit;
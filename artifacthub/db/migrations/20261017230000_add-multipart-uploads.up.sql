@@ -0,0 +1,18 @@
+begin;
+
+CREATE TABLE multipart_uploads (
+  id          uuid DEFAULT uuid_generate_v4() NOT NULL PRIMARY KEY,
+  artifact_id uuid NOT NULL,
+
+  path        text NOT NULL,
+  upload_id   text NOT NULL,
+  size        bigint DEFAULT 0 NOT NULL,
+
+  created_at  timestamp NOT NULL,
+
+  CONSTRAINT fk_multipart_uploads_artifact_id FOREIGN KEY(artifact_id) REFERENCES artifacts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX index_multipart_uploads_on_created_at ON multipart_uploads USING btree (created_at);
+
+commit;
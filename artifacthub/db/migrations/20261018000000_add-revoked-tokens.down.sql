@@ -0,0 +1,5 @@
+begin;
+
+DROP TABLE revoked_tokens;
+
+commit;
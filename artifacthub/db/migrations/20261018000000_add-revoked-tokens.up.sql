@@ -0,0 +1,12 @@
+begin;
+
+CREATE TABLE revoked_tokens (
+  id         uuid NOT NULL PRIMARY KEY,
+
+  expires_at timestamp NOT NULL,
+  created_at timestamp NOT NULL
+);
+
+CREATE INDEX index_revoked_tokens_on_expires_at ON revoked_tokens USING btree (expires_at);
+
+commit;
@@ -0,0 +1,5 @@
+begin;
+
+DROP TABLE artifact_object_metadata;
+
+commit;
@@ -0,0 +1,22 @@
+begin;
+
+CREATE TABLE artifact_object_metadata (
+  artifact_id uuid NOT NULL,
+  path        text NOT NULL,
+
+  project_id  text NOT NULL,
+  workflow_id text DEFAULT '' NOT NULL,
+  job_id      text DEFAULT '' NOT NULL,
+  labels      jsonb DEFAULT '{}' NOT NULL,
+
+  created_at  timestamp NOT NULL,
+  updated_at  timestamp NOT NULL,
+
+  PRIMARY KEY(artifact_id, path),
+  CONSTRAINT fk_artifact_object_metadata_artifact_id FOREIGN KEY(artifact_id) REFERENCES artifacts(id) ON DELETE CASCADE
+);
+
+CREATE INDEX index_artifact_object_metadata_on_project_id ON artifact_object_metadata USING btree (artifact_id, project_id);
+CREATE INDEX index_artifact_object_metadata_on_labels ON artifact_object_metadata USING gin (labels jsonb_path_ops);
+
+commit;
@@ -0,0 +1,5 @@
+begin;
+
+ALTER TABLE artifact_blobs DROP COLUMN verified_at;
+
+commit;
@@ -0,0 +1,5 @@
+begin;
+
+ALTER TABLE artifact_blobs ADD COLUMN verified_at timestamp;
+
+commit;
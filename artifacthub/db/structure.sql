@@ -52,7 +52,80 @@ CREATE TABLE public.artifacts (
     idempotency_token text,
     created timestamp with time zone,
     last_cleaned_at timestamp without time zone,
-    deleted_at timestamp without time zone
+    deleted_at timestamp without time zone,
+    org_id uuid,
+    usage_scanned_at timestamp without time zone
+);
+
+
+--
+-- Name: artifact_blobs; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.artifact_blobs (
+    artifact_id uuid NOT NULL,
+    digest text NOT NULL,
+    ref_count bigint DEFAULT 0 NOT NULL,
+    created_at timestamp without time zone NOT NULL,
+    released_at timestamp without time zone,
+    verified_at timestamp without time zone
+);
+
+
+--
+-- Name: artifact_object_metadata; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.artifact_object_metadata (
+    artifact_id uuid NOT NULL,
+    path text NOT NULL,
+    project_id text NOT NULL,
+    workflow_id text DEFAULT ''::text NOT NULL,
+    job_id text DEFAULT ''::text NOT NULL,
+    labels jsonb DEFAULT '{}'::jsonb NOT NULL,
+    created_at timestamp without time zone NOT NULL,
+    updated_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: artifact_object_refs; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.artifact_object_refs (
+    artifact_id uuid NOT NULL,
+    path text NOT NULL,
+    digest text NOT NULL,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: artifact_usages; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.artifact_usages (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    artifact_id uuid NOT NULL,
+    category text NOT NULL,
+    category_id text NOT NULL,
+    size bigint DEFAULT 0 NOT NULL,
+    object_count bigint DEFAULT 0 NOT NULL,
+    scanned_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: multipart_uploads; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.multipart_uploads (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    artifact_id uuid NOT NULL,
+    path text NOT NULL,
+    upload_id text NOT NULL,
+    size bigint DEFAULT 0 NOT NULL,
+    created_at timestamp without time zone NOT NULL
 );
 
 
@@ -71,6 +144,57 @@ CREATE TABLE public.retention_policies (
 );
 
 
+--
+-- Name: retention_report_objects; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.retention_report_objects (
+    report_id uuid NOT NULL,
+    path text NOT NULL,
+    size bigint DEFAULT 0 NOT NULL,
+    age bigint DEFAULT 0 NOT NULL
+);
+
+
+--
+-- Name: retention_reports; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.retention_reports (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    artifact_id uuid NOT NULL,
+    started_at timestamp without time zone NOT NULL,
+    finished_at timestamp without time zone,
+    visited_count bigint DEFAULT 0 NOT NULL,
+    deleted_count bigint DEFAULT 0 NOT NULL,
+    deleted_size bigint DEFAULT 0 NOT NULL
+);
+
+
+--
+-- Name: revoked_tokens; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.revoked_tokens (
+    id uuid NOT NULL,
+    expires_at timestamp without time zone NOT NULL,
+    created_at timestamp without time zone NOT NULL
+);
+
+
+--
+-- Name: storage_quotas; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.storage_quotas (
+    org_id uuid NOT NULL,
+    soft_limit bigint DEFAULT 0 NOT NULL,
+    hard_limit bigint DEFAULT 0 NOT NULL,
+    created_at timestamp without time zone NOT NULL,
+    updated_at timestamp without time zone NOT NULL
+);
+
+
 --
 -- Name: schema_migrations; Type: TABLE; Schema: public; Owner: -
 --
@@ -81,6 +205,38 @@ CREATE TABLE public.schema_migrations (
 );
 
 
+--
+-- Name: artifact_blobs artifact_blobs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.artifact_blobs
+    ADD CONSTRAINT artifact_blobs_pkey PRIMARY KEY (artifact_id, digest);
+
+
+--
+-- Name: artifact_object_metadata artifact_object_metadata_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.artifact_object_metadata
+    ADD CONSTRAINT artifact_object_metadata_pkey PRIMARY KEY (artifact_id, path);
+
+
+--
+-- Name: artifact_object_refs artifact_object_refs_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.artifact_object_refs
+    ADD CONSTRAINT artifact_object_refs_pkey PRIMARY KEY (artifact_id, path);
+
+
+--
+-- Name: artifact_usages artifact_usages_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.artifact_usages
+    ADD CONSTRAINT artifact_usages_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: artifacts artifacts_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -89,6 +245,14 @@ ALTER TABLE ONLY public.artifacts
     ADD CONSTRAINT artifacts_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: multipart_uploads multipart_uploads_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.multipart_uploads
+    ADD CONSTRAINT multipart_uploads_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: retention_policies retention_policies_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -97,6 +261,38 @@ ALTER TABLE ONLY public.retention_policies
     ADD CONSTRAINT retention_policies_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: storage_quotas storage_quotas_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.storage_quotas
+    ADD CONSTRAINT storage_quotas_pkey PRIMARY KEY (org_id);
+
+
+--
+-- Name: retention_report_objects retention_report_objects_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.retention_report_objects
+    ADD CONSTRAINT retention_report_objects_pkey PRIMARY KEY (report_id, path);
+
+
+--
+-- Name: retention_reports retention_reports_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.retention_reports
+    ADD CONSTRAINT retention_reports_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: revoked_tokens revoked_tokens_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.revoked_tokens
+    ADD CONSTRAINT revoked_tokens_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: schema_migrations schema_migrations_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -105,6 +301,62 @@ ALTER TABLE ONLY public.schema_migrations
     ADD CONSTRAINT schema_migrations_pkey PRIMARY KEY (version);
 
 
+--
+-- Name: index_artifact_blobs_on_released_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX index_artifact_blobs_on_released_at ON public.artifact_blobs USING btree (released_at) WHERE (ref_count = 0);
+
+
+--
+-- Name: index_artifact_object_metadata_on_labels; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX index_artifact_object_metadata_on_labels ON public.artifact_object_metadata USING gin (labels jsonb_path_ops);
+
+
+--
+-- Name: index_artifact_object_metadata_on_project_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX index_artifact_object_metadata_on_project_id ON public.artifact_object_metadata USING btree (artifact_id, project_id);
+
+
+--
+-- Name: index_artifacts_on_org_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX index_artifacts_on_org_id ON public.artifacts USING btree (org_id);
+
+
+--
+-- Name: index_multipart_uploads_on_created_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX index_multipart_uploads_on_created_at ON public.multipart_uploads USING btree (created_at);
+
+
+--
+-- Name: index_retention_reports_on_artifact_id_started_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX index_retention_reports_on_artifact_id_started_at ON public.retention_reports USING btree (artifact_id, started_at);
+
+
+--
+-- Name: index_revoked_tokens_on_expires_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX index_revoked_tokens_on_expires_at ON public.revoked_tokens USING btree (expires_at);
+
+
+--
+-- Name: uix_artifact_usages_category; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX uix_artifact_usages_category ON public.artifact_usages USING btree (artifact_id, category, category_id);
+
+
 --
 -- Name: uix_artifacts_idempotency_token; Type: INDEX; Schema: public; Owner: -
 --
@@ -119,6 +371,46 @@ CREATE UNIQUE INDEX uix_artifacts_idempotency_token ON public.artifacts USING bt
 CREATE UNIQUE INDEX uix_retention_policies_artifact_id ON public.retention_policies USING btree (artifact_id);
 
 
+--
+-- Name: artifact_blobs fk_artifact_blobs_artifact_id; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.artifact_blobs
+    ADD CONSTRAINT fk_artifact_blobs_artifact_id FOREIGN KEY (artifact_id) REFERENCES public.artifacts(id) ON DELETE CASCADE;
+
+
+--
+-- Name: artifact_object_metadata fk_artifact_object_metadata_artifact_id; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.artifact_object_metadata
+    ADD CONSTRAINT fk_artifact_object_metadata_artifact_id FOREIGN KEY (artifact_id) REFERENCES public.artifacts(id) ON DELETE CASCADE;
+
+
+--
+-- Name: artifact_object_refs fk_artifact_object_refs_blob; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.artifact_object_refs
+    ADD CONSTRAINT fk_artifact_object_refs_blob FOREIGN KEY (artifact_id, digest) REFERENCES public.artifact_blobs(artifact_id, digest) ON DELETE CASCADE;
+
+
+--
+-- Name: artifact_usages fk_artifact_usages_artifact_id; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.artifact_usages
+    ADD CONSTRAINT fk_artifact_usages_artifact_id FOREIGN KEY (artifact_id) REFERENCES public.artifacts(id) ON DELETE CASCADE;
+
+
+--
+-- Name: multipart_uploads fk_multipart_uploads_artifact_id; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.multipart_uploads
+    ADD CONSTRAINT fk_multipart_uploads_artifact_id FOREIGN KEY (artifact_id) REFERENCES public.artifacts(id) ON DELETE CASCADE;
+
+
 --
 -- Name: retention_policies fk_artifact_id; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -127,6 +419,22 @@ ALTER TABLE ONLY public.retention_policies
     ADD CONSTRAINT fk_artifact_id FOREIGN KEY (artifact_id) REFERENCES public.artifacts(id) ON DELETE CASCADE;
 
 
+--
+-- Name: retention_report_objects fk_retention_report_objects_report_id; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.retention_report_objects
+    ADD CONSTRAINT fk_retention_report_objects_report_id FOREIGN KEY (report_id) REFERENCES public.retention_reports(id) ON DELETE CASCADE;
+
+
+--
+-- Name: retention_reports fk_retention_reports_artifact_id; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.retention_reports
+    ADD CONSTRAINT fk_retention_reports_artifact_id FOREIGN KEY (artifact_id) REFERENCES public.artifacts(id) ON DELETE CASCADE;
+
+
 --
 -- PostgreSQL database dump complete
 --
@@ -159,7 +467,7 @@ SET row_security = off;
 --
 
 COPY public.schema_migrations (version, dirty) FROM stdin;
-20240612182638	f
+20261018020000	f
 \.
 
 
@@ -22,6 +22,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// - AGE       = deletes files older than age
+// - KEEP_LAST = keeps the files of the newest keep_last workflows, workflow level only
+// - MAX_SIZE  = deletes the oldest files once the matched files take more than max_size bytes
+type RetentionPolicy_RetentionPolicyRule_Kind int32
+
+const (
+	RetentionPolicy_RetentionPolicyRule_AGE       RetentionPolicy_RetentionPolicyRule_Kind = 0
+	RetentionPolicy_RetentionPolicyRule_KEEP_LAST RetentionPolicy_RetentionPolicyRule_Kind = 1
+	RetentionPolicy_RetentionPolicyRule_MAX_SIZE  RetentionPolicy_RetentionPolicyRule_Kind = 2
+)
+
+// Enum value maps for RetentionPolicy_RetentionPolicyRule_Kind.
+var (
+	RetentionPolicy_RetentionPolicyRule_Kind_name = map[int32]string{
+		0: "AGE",
+		1: "KEEP_LAST",
+		2: "MAX_SIZE",
+	}
+	RetentionPolicy_RetentionPolicyRule_Kind_value = map[string]int32{
+		"AGE":       0,
+		"KEEP_LAST": 1,
+		"MAX_SIZE":  2,
+	}
+)
+
+func (x RetentionPolicy_RetentionPolicyRule_Kind) Enum() *RetentionPolicy_RetentionPolicyRule_Kind {
+	p := new(RetentionPolicy_RetentionPolicyRule_Kind)
+	*p = x
+	return p
+}
+
+func (x RetentionPolicy_RetentionPolicyRule_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RetentionPolicy_RetentionPolicyRule_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_artifacthub_proto_enumTypes[0].Descriptor()
+}
+
+func (RetentionPolicy_RetentionPolicyRule_Kind) Type() protoreflect.EnumType {
+	return &file_artifacthub_proto_enumTypes[0]
+}
+
+func (x RetentionPolicy_RetentionPolicyRule_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RetentionPolicy_RetentionPolicyRule_Kind.Descriptor instead.
+func (RetentionPolicy_RetentionPolicyRule_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{2, 0, 0}
+}
+
 type CountArtifactsRequest_Category int32
 
 const (
@@ -55,11 +107,11 @@ func (x CountArtifactsRequest_Category) String() string {
 }
 
 func (CountArtifactsRequest_Category) Descriptor() protoreflect.EnumDescriptor {
-	return file_artifacthub_proto_enumTypes[0].Descriptor()
+	return file_artifacthub_proto_enumTypes[1].Descriptor()
 }
 
 func (CountArtifactsRequest_Category) Type() protoreflect.EnumType {
-	return &file_artifacthub_proto_enumTypes[0]
+	return &file_artifacthub_proto_enumTypes[1]
 }
 
 func (x CountArtifactsRequest_Category) Number() protoreflect.EnumNumber {
@@ -68,7 +120,57 @@ func (x CountArtifactsRequest_Category) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CountArtifactsRequest_Category.Descriptor instead.
 func (CountArtifactsRequest_Category) EnumDescriptor() ([]byte, []int) {
-	return file_artifacthub_proto_rawDescGZIP(), []int{21, 0}
+	return file_artifacthub_proto_rawDescGZIP(), []int{32, 0}
+}
+
+// Operations the token allows. A token with no operations allows all of them.
+type GenerateTokenRequest_Operation int32
+
+const (
+	GenerateTokenRequest_PULL GenerateTokenRequest_Operation = 0
+	GenerateTokenRequest_PUSH GenerateTokenRequest_Operation = 1
+	GenerateTokenRequest_YANK GenerateTokenRequest_Operation = 2
+)
+
+// Enum value maps for GenerateTokenRequest_Operation.
+var (
+	GenerateTokenRequest_Operation_name = map[int32]string{
+		0: "PULL",
+		1: "PUSH",
+		2: "YANK",
+	}
+	GenerateTokenRequest_Operation_value = map[string]int32{
+		"PULL": 0,
+		"PUSH": 1,
+		"YANK": 2,
+	}
+)
+
+func (x GenerateTokenRequest_Operation) Enum() *GenerateTokenRequest_Operation {
+	p := new(GenerateTokenRequest_Operation)
+	*p = x
+	return p
+}
+
+func (x GenerateTokenRequest_Operation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GenerateTokenRequest_Operation) Descriptor() protoreflect.EnumDescriptor {
+	return file_artifacthub_proto_enumTypes[2].Descriptor()
+}
+
+func (GenerateTokenRequest_Operation) Type() protoreflect.EnumType {
+	return &file_artifacthub_proto_enumTypes[2]
+}
+
+func (x GenerateTokenRequest_Operation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GenerateTokenRequest_Operation.Descriptor instead.
+func (GenerateTokenRequest_Operation) EnumDescriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{40, 0}
 }
 
 // Request for HealthCheck
@@ -317,30 +419,32 @@ func (x *UpdateRetentionPolicyResponse) GetRetentionPolicy() *RetentionPolicy {
 	return nil
 }
 
-// Request for Create
-// Contains idempotency token, example: project-877b07c5-fde0-4baf-9608-58e28da587f9
-type CreateRequest struct {
+// Request for PreviewRetentionPolicy
+// - retention_policy = rules to preview, the stored retention policy is used if not set
+// - max_objects      = limit of matched objects returned in the response, defaults to 100
+type PreviewRetentionPolicyRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
-	RequestToken    string                 `protobuf:"bytes,1,opt,name=request_token,json=requestToken,proto3" json:"request_token,omitempty"`
+	ArtifactId      string                 `protobuf:"bytes,1,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
 	RetentionPolicy *RetentionPolicy       `protobuf:"bytes,2,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"`
+	MaxObjects      int32                  `protobuf:"varint,3,opt,name=max_objects,json=maxObjects,proto3" json:"max_objects,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
 
-func (x *CreateRequest) Reset() {
-	*x = CreateRequest{}
+func (x *PreviewRetentionPolicyRequest) Reset() {
+	*x = PreviewRetentionPolicyRequest{}
 	mi := &file_artifacthub_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRequest) String() string {
+func (x *PreviewRetentionPolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRequest) ProtoMessage() {}
+func (*PreviewRetentionPolicyRequest) ProtoMessage() {}
 
-func (x *CreateRequest) ProtoReflect() protoreflect.Message {
+func (x *PreviewRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_artifacthub_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -352,48 +456,63 @@ func (x *CreateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
-func (*CreateRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PreviewRetentionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*PreviewRetentionPolicyRequest) Descriptor() ([]byte, []int) {
 	return file_artifacthub_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *CreateRequest) GetRequestToken() string {
+func (x *PreviewRetentionPolicyRequest) GetArtifactId() string {
 	if x != nil {
-		return x.RequestToken
+		return x.ArtifactId
 	}
 	return ""
 }
 
-func (x *CreateRequest) GetRetentionPolicy() *RetentionPolicy {
+func (x *PreviewRetentionPolicyRequest) GetRetentionPolicy() *RetentionPolicy {
 	if x != nil {
 		return x.RetentionPolicy
 	}
 	return nil
 }
 
-// Response for Create
-// Contains ID of a new Artifact and response status
-type CreateResponse struct {
+func (x *PreviewRetentionPolicyRequest) GetMaxObjects() int32 {
+	if x != nil {
+		return x.MaxObjects
+	}
+	return 0
+}
+
+// Response for PreviewRetentionPolicy
+// - objects       = objects the retention policy would delete, up to max_objects
+// - matched_*     = count and size in bytes of all the objects the retention policy would delete
+// - visited_*     = count and size in bytes of all the visited objects
+// - truncated     = the artifact store has more objects than a preview visits, the totals cover only the visited ones
+type PreviewRetentionPolicyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Artifact      *Artifact              `protobuf:"bytes,1,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	Objects       []*RetentionObject     `protobuf:"bytes,1,rep,name=objects,proto3" json:"objects,omitempty"`
+	MatchedCount  int64                  `protobuf:"varint,2,opt,name=matched_count,json=matchedCount,proto3" json:"matched_count,omitempty"`
+	MatchedSize   int64                  `protobuf:"varint,3,opt,name=matched_size,json=matchedSize,proto3" json:"matched_size,omitempty"`
+	VisitedCount  int64                  `protobuf:"varint,4,opt,name=visited_count,json=visitedCount,proto3" json:"visited_count,omitempty"`
+	VisitedSize   int64                  `protobuf:"varint,5,opt,name=visited_size,json=visitedSize,proto3" json:"visited_size,omitempty"`
+	Truncated     bool                   `protobuf:"varint,6,opt,name=truncated,proto3" json:"truncated,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateResponse) Reset() {
-	*x = CreateResponse{}
+func (x *PreviewRetentionPolicyResponse) Reset() {
+	*x = PreviewRetentionPolicyResponse{}
 	mi := &file_artifacthub_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateResponse) String() string {
+func (x *PreviewRetentionPolicyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateResponse) ProtoMessage() {}
+func (*PreviewRetentionPolicyResponse) ProtoMessage() {}
 
-func (x *CreateResponse) ProtoReflect() protoreflect.Message {
+func (x *PreviewRetentionPolicyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_artifacthub_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -405,42 +524,76 @@ func (x *CreateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
-func (*CreateResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PreviewRetentionPolicyResponse.ProtoReflect.Descriptor instead.
+func (*PreviewRetentionPolicyResponse) Descriptor() ([]byte, []int) {
 	return file_artifacthub_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *CreateResponse) GetArtifact() *Artifact {
+func (x *PreviewRetentionPolicyResponse) GetObjects() []*RetentionObject {
 	if x != nil {
-		return x.Artifact
+		return x.Objects
 	}
 	return nil
 }
 
-// Request for Describe
-// Contains ID of Artifact to read credential
-type DescribeRequest struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	ArtifactId             string                 `protobuf:"bytes,1,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
-	IncludeRetentionPolicy bool                   `protobuf:"varint,2,opt,name=include_retention_policy,json=includeRetentionPolicy,proto3" json:"include_retention_policy,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *PreviewRetentionPolicyResponse) GetMatchedCount() int64 {
+	if x != nil {
+		return x.MatchedCount
+	}
+	return 0
 }
 
-func (x *DescribeRequest) Reset() {
-	*x = DescribeRequest{}
+func (x *PreviewRetentionPolicyResponse) GetMatchedSize() int64 {
+	if x != nil {
+		return x.MatchedSize
+	}
+	return 0
+}
+
+func (x *PreviewRetentionPolicyResponse) GetVisitedCount() int64 {
+	if x != nil {
+		return x.VisitedCount
+	}
+	return 0
+}
+
+func (x *PreviewRetentionPolicyResponse) GetVisitedSize() int64 {
+	if x != nil {
+		return x.VisitedSize
+	}
+	return 0
+}
+
+func (x *PreviewRetentionPolicyResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type RetentionObject struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"` // size in bytes
+	Age           int64                  `protobuf:"varint,3,opt,name=age,proto3" json:"age,omitempty"`   // age in seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetentionObject) Reset() {
+	*x = RetentionObject{}
 	mi := &file_artifacthub_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeRequest) String() string {
+func (x *RetentionObject) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeRequest) ProtoMessage() {}
+func (*RetentionObject) ProtoMessage() {}
 
-func (x *DescribeRequest) ProtoReflect() protoreflect.Message {
+func (x *RetentionObject) ProtoReflect() protoreflect.Message {
 	mi := &file_artifacthub_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -452,49 +605,61 @@ func (x *DescribeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeRequest.ProtoReflect.Descriptor instead.
-func (*DescribeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RetentionObject.ProtoReflect.Descriptor instead.
+func (*RetentionObject) Descriptor() ([]byte, []int) {
 	return file_artifacthub_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *DescribeRequest) GetArtifactId() string {
+func (x *RetentionObject) GetPath() string {
 	if x != nil {
-		return x.ArtifactId
+		return x.Path
 	}
 	return ""
 }
 
-func (x *DescribeRequest) GetIncludeRetentionPolicy() bool {
+func (x *RetentionObject) GetSize() int64 {
 	if x != nil {
-		return x.IncludeRetentionPolicy
+		return x.Size
 	}
-	return false
+	return 0
 }
 
-// Response for Describe
-// Contains Artifact description and response status
-type DescribeResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Artifact        *Artifact              `protobuf:"bytes,1,opt,name=artifact,proto3" json:"artifact,omitempty"`
-	RetentionPolicy *RetentionPolicy       `protobuf:"bytes,2,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"` // populated only if include_retention_policy was true in the request
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *RetentionObject) GetAge() int64 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
 }
 
-func (x *DescribeResponse) Reset() {
-	*x = DescribeResponse{}
+// Every cleaning pass of an artifact store records a report.
+// Passes span multiple cleaning runs for large stores, and finished_at is not set until the pass ends.
+type RetentionReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ArtifactId    string                 `protobuf:"bytes,2,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
+	StartedAt     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	VisitedCount  int64                  `protobuf:"varint,5,opt,name=visited_count,json=visitedCount,proto3" json:"visited_count,omitempty"`
+	DeletedCount  int64                  `protobuf:"varint,6,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"`
+	DeletedSize   int64                  `protobuf:"varint,7,opt,name=deleted_size,json=deletedSize,proto3" json:"deleted_size,omitempty"` // size in bytes
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetentionReport) Reset() {
+	*x = RetentionReport{}
 	mi := &file_artifacthub_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DescribeResponse) String() string {
+func (x *RetentionReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DescribeResponse) ProtoMessage() {}
+func (*RetentionReport) ProtoMessage() {}
 
-func (x *DescribeResponse) ProtoReflect() protoreflect.Message {
+func (x *RetentionReport) ProtoReflect() protoreflect.Message {
 	mi := &file_artifacthub_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -506,48 +671,82 @@ func (x *DescribeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DescribeResponse.ProtoReflect.Descriptor instead.
-func (*DescribeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RetentionReport.ProtoReflect.Descriptor instead.
+func (*RetentionReport) Descriptor() ([]byte, []int) {
 	return file_artifacthub_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *DescribeResponse) GetArtifact() *Artifact {
+func (x *RetentionReport) GetId() string {
 	if x != nil {
-		return x.Artifact
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RetentionReport) GetArtifactId() string {
+	if x != nil {
+		return x.ArtifactId
+	}
+	return ""
+}
+
+func (x *RetentionReport) GetStartedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartedAt
 	}
 	return nil
 }
 
-func (x *DescribeResponse) GetRetentionPolicy() *RetentionPolicy {
+func (x *RetentionReport) GetFinishedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.RetentionPolicy
+		return x.FinishedAt
 	}
 	return nil
 }
 
-// Request for Destroy
-// Contains ID of the Artifact to destroy
-type DestroyRequest struct {
+func (x *RetentionReport) GetVisitedCount() int64 {
+	if x != nil {
+		return x.VisitedCount
+	}
+	return 0
+}
+
+func (x *RetentionReport) GetDeletedCount() int64 {
+	if x != nil {
+		return x.DeletedCount
+	}
+	return 0
+}
+
+func (x *RetentionReport) GetDeletedSize() int64 {
+	if x != nil {
+		return x.DeletedSize
+	}
+	return 0
+}
+
+type ListRetentionReportsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ArtifactId    string                 `protobuf:"bytes,1,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"` // defaults to 10
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DestroyRequest) Reset() {
-	*x = DestroyRequest{}
+func (x *ListRetentionReportsRequest) Reset() {
+	*x = ListRetentionReportsRequest{}
 	mi := &file_artifacthub_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DestroyRequest) String() string {
+func (x *ListRetentionReportsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DestroyRequest) ProtoMessage() {}
+func (*ListRetentionReportsRequest) ProtoMessage() {}
 
-func (x *DestroyRequest) ProtoReflect() protoreflect.Message {
+func (x *ListRetentionReportsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_artifacthub_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -559,40 +758,46 @@ func (x *DestroyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DestroyRequest.ProtoReflect.Descriptor instead.
-func (*DestroyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRetentionReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListRetentionReportsRequest) Descriptor() ([]byte, []int) {
 	return file_artifacthub_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *DestroyRequest) GetArtifactId() string {
+func (x *ListRetentionReportsRequest) GetArtifactId() string {
 	if x != nil {
 		return x.ArtifactId
 	}
 	return ""
 }
 
-// Response for Destroy
-// Contains response status
-type DestroyResponse struct {
+func (x *ListRetentionReportsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListRetentionReportsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reports       []*RetentionReport     `protobuf:"bytes,1,rep,name=reports,proto3" json:"reports,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DestroyResponse) Reset() {
-	*x = DestroyResponse{}
+func (x *ListRetentionReportsResponse) Reset() {
+	*x = ListRetentionReportsResponse{}
 	mi := &file_artifacthub_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DestroyResponse) String() string {
+func (x *ListRetentionReportsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DestroyResponse) ProtoMessage() {}
+func (*ListRetentionReportsResponse) ProtoMessage() {}
 
-func (x *DestroyResponse) ProtoReflect() protoreflect.Message {
+func (x *ListRetentionReportsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_artifacthub_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -604,39 +809,44 @@ func (x *DestroyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DestroyResponse.ProtoReflect.Descriptor instead.
-func (*DestroyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRetentionReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListRetentionReportsResponse) Descriptor() ([]byte, []int) {
 	return file_artifacthub_proto_rawDescGZIP(), []int{10}
 }
 
-// Request for List a Bucket directory
-// Contains ID of the Artifact, and the path to list
-type ListPathRequest struct {
-	state      protoimpl.MessageState `protogen:"open.v1"`
-	ArtifactId string                 `protobuf:"bytes,1,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
-	Path       string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	// By default, files under a subdirectory are not returned,
-	// and only the subdirectory itself is returned in an item with is_directory=true.
-	// If you want to list the files under subdirectories too, use unwrap_directories=true.
-	UnwrapDirectories bool `protobuf:"varint,3,opt,name=unwrap_directories,json=unwrapDirectories,proto3" json:"unwrap_directories,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *ListRetentionReportsResponse) GetReports() []*RetentionReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
 }
 
-func (x *ListPathRequest) Reset() {
-	*x = ListPathRequest{}
+// Request for DescribeRetentionReport
+// - page_size  = deleted objects returned per page, defaults to 100
+// - page_token = next_page_token of the previous page, empty for the first one
+type DescribeRetentionReportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReportId      string                 `protobuf:"bytes,1,opt,name=report_id,json=reportId,proto3" json:"report_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DescribeRetentionReportRequest) Reset() {
+	*x = DescribeRetentionReportRequest{}
 	mi := &file_artifacthub_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPathRequest) String() string {
+func (x *DescribeRetentionReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPathRequest) ProtoMessage() {}
+func (*DescribeRetentionReportRequest) ProtoMessage() {}
 
-func (x *ListPathRequest) ProtoReflect() protoreflect.Message {
+func (x *DescribeRetentionReportRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_artifacthub_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -648,55 +858,55 @@ func (x *ListPathRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPathRequest.ProtoReflect.Descriptor instead.
-func (*ListPathRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeRetentionReportRequest.ProtoReflect.Descriptor instead.
+func (*DescribeRetentionReportRequest) Descriptor() ([]byte, []int) {
 	return file_artifacthub_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ListPathRequest) GetArtifactId() string {
+func (x *DescribeRetentionReportRequest) GetReportId() string {
 	if x != nil {
-		return x.ArtifactId
+		return x.ReportId
 	}
 	return ""
 }
 
-func (x *ListPathRequest) GetPath() string {
+func (x *DescribeRetentionReportRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Path
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *ListPathRequest) GetUnwrapDirectories() bool {
+func (x *DescribeRetentionReportRequest) GetPageToken() string {
 	if x != nil {
-		return x.UnwrapDirectories
+		return x.PageToken
 	}
-	return false
+	return ""
 }
 
-// Response for List a Bucket directory
-// Contains the directory contents and response status
-type ListPathResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Items         []*ListItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type DescribeRetentionReportResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Report         *RetentionReport       `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	DeletedObjects []*RetentionObject     `protobuf:"bytes,2,rep,name=deleted_objects,json=deletedObjects,proto3" json:"deleted_objects,omitempty"`
+	NextPageToken  string                 `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *ListPathResponse) Reset() {
-	*x = ListPathResponse{}
+func (x *DescribeRetentionReportResponse) Reset() {
+	*x = DescribeRetentionReportResponse{}
 	mi := &file_artifacthub_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListPathResponse) String() string {
+func (x *DescribeRetentionReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListPathResponse) ProtoMessage() {}
+func (*DescribeRetentionReportResponse) ProtoMessage() {}
 
-func (x *ListPathResponse) ProtoReflect() protoreflect.Message {
+func (x *DescribeRetentionReportResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_artifacthub_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -708,42 +918,57 @@ func (x *ListPathResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPathResponse.ProtoReflect.Descriptor instead.
-func (*ListPathResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeRetentionReportResponse.ProtoReflect.Descriptor instead.
+func (*DescribeRetentionReportResponse) Descriptor() ([]byte, []int) {
 	return file_artifacthub_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListPathResponse) GetItems() []*ListItem {
+func (x *DescribeRetentionReportResponse) GetReport() *RetentionReport {
 	if x != nil {
-		return x.Items
+		return x.Report
 	}
 	return nil
 }
 
-// Request for Delete an object or directory from a Bucket
-// Contains ID of the Artifact, and the path to the object
-type DeletePathRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ArtifactId    string                 `protobuf:"bytes,1,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
-	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DescribeRetentionReportResponse) GetDeletedObjects() []*RetentionObject {
+	if x != nil {
+		return x.DeletedObjects
+	}
+	return nil
 }
 
-func (x *DeletePathRequest) Reset() {
-	*x = DeletePathRequest{}
+func (x *DescribeRetentionReportResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Request for Create
+// Contains idempotency token, example: project-877b07c5-fde0-4baf-9608-58e28da587f9
+type CreateRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RequestToken    string                 `protobuf:"bytes,1,opt,name=request_token,json=requestToken,proto3" json:"request_token,omitempty"`
+	RetentionPolicy *RetentionPolicy       `protobuf:"bytes,2,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"`
+	OrgId           string                 `protobuf:"bytes,3,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"` // organization owning the artifact store, used for storage quotas
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateRequest) Reset() {
+	*x = CreateRequest{}
 	mi := &file_artifacthub_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePathRequest) String() string {
+func (x *CreateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePathRequest) ProtoMessage() {}
+func (*CreateRequest) ProtoMessage() {}
 
-func (x *DeletePathRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_artifacthub_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -755,47 +980,55 @@ func (x *DeletePathRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePathRequest.ProtoReflect.Descriptor instead.
-func (*DeletePathRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateRequest.ProtoReflect.Descriptor instead.
+func (*CreateRequest) Descriptor() ([]byte, []int) {
 	return file_artifacthub_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *DeletePathRequest) GetArtifactId() string {
+func (x *CreateRequest) GetRequestToken() string {
 	if x != nil {
-		return x.ArtifactId
+		return x.RequestToken
 	}
 	return ""
 }
 
-func (x *DeletePathRequest) GetPath() string {
+func (x *CreateRequest) GetRetentionPolicy() *RetentionPolicy {
 	if x != nil {
-		return x.Path
+		return x.RetentionPolicy
+	}
+	return nil
+}
+
+func (x *CreateRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
 	}
 	return ""
 }
 
-// Response for Delete an object or directory from a Bucket
-// Contains response status
-type DeletePathResponse struct {
+// Response for Create
+// Contains ID of a new Artifact and response status
+type CreateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Artifact      *Artifact              `protobuf:"bytes,1,opt,name=artifact,proto3" json:"artifact,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeletePathResponse) Reset() {
-	*x = DeletePathResponse{}
+func (x *CreateResponse) Reset() {
+	*x = CreateResponse{}
 	mi := &file_artifacthub_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeletePathResponse) String() string {
+func (x *CreateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeletePathResponse) ProtoMessage() {}
+func (*CreateResponse) ProtoMessage() {}
 
-func (x *DeletePathResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_artifacthub_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -807,32 +1040,42 @@ func (x *DeletePathResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeletePathResponse.ProtoReflect.Descriptor instead.
-func (*DeletePathResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateResponse.ProtoReflect.Descriptor instead.
+func (*CreateResponse) Descriptor() ([]byte, []int) {
 	return file_artifacthub_proto_rawDescGZIP(), []int{14}
 }
 
-// Request for Cleanup all expired paths for all Buckets
-type CleanupRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CreateResponse) GetArtifact() *Artifact {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
 }
 
-func (x *CleanupRequest) Reset() {
-	*x = CleanupRequest{}
+// Request for Describe
+// Contains ID of Artifact to read credential
+type DescribeRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	ArtifactId             string                 `protobuf:"bytes,1,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
+	IncludeRetentionPolicy bool                   `protobuf:"varint,2,opt,name=include_retention_policy,json=includeRetentionPolicy,proto3" json:"include_retention_policy,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DescribeRequest) Reset() {
+	*x = DescribeRequest{}
 	mi := &file_artifacthub_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CleanupRequest) String() string {
+func (x *DescribeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CleanupRequest) ProtoMessage() {}
+func (*DescribeRequest) ProtoMessage() {}
 
-func (x *CleanupRequest) ProtoReflect() protoreflect.Message {
+func (x *DescribeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_artifacthub_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -844,34 +1087,1296 @@ func (x *CleanupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CleanupRequest.ProtoReflect.Descriptor instead.
-func (*CleanupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DescribeRequest.ProtoReflect.Descriptor instead.
+func (*DescribeRequest) Descriptor() ([]byte, []int) {
 	return file_artifacthub_proto_rawDescGZIP(), []int{15}
 }
 
-// Response for Cleanup all expired paths for all Buckets
+func (x *DescribeRequest) GetArtifactId() string {
+	if x != nil {
+		return x.ArtifactId
+	}
+	return ""
+}
+
+func (x *DescribeRequest) GetIncludeRetentionPolicy() bool {
+	if x != nil {
+		return x.IncludeRetentionPolicy
+	}
+	return false
+}
+
+// Response for Describe
+// Contains Artifact description and response status
+type DescribeResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Artifact        *Artifact              `protobuf:"bytes,1,opt,name=artifact,proto3" json:"artifact,omitempty"`
+	RetentionPolicy *RetentionPolicy       `protobuf:"bytes,2,opt,name=retention_policy,json=retentionPolicy,proto3" json:"retention_policy,omitempty"` // populated only if include_retention_policy was true in the request
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DescribeResponse) Reset() {
+	*x = DescribeResponse{}
+	mi := &file_artifacthub_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DescribeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeResponse) ProtoMessage() {}
+
+func (x *DescribeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeResponse.ProtoReflect.Descriptor instead.
+func (*DescribeResponse) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DescribeResponse) GetArtifact() *Artifact {
+	if x != nil {
+		return x.Artifact
+	}
+	return nil
+}
+
+func (x *DescribeResponse) GetRetentionPolicy() *RetentionPolicy {
+	if x != nil {
+		return x.RetentionPolicy
+	}
+	return nil
+}
+
+// Request for Destroy
+// Contains ID of the Artifact to destroy
+type DestroyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArtifactId    string                 `protobuf:"bytes,1,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DestroyRequest) Reset() {
+	*x = DestroyRequest{}
+	mi := &file_artifacthub_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DestroyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestroyRequest) ProtoMessage() {}
+
+func (x *DestroyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestroyRequest.ProtoReflect.Descriptor instead.
+func (*DestroyRequest) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DestroyRequest) GetArtifactId() string {
+	if x != nil {
+		return x.ArtifactId
+	}
+	return ""
+}
+
+// Response for Destroy
 // Contains response status
-type CleanupResponse struct {
+type DestroyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CleanupResponse) Reset() {
-	*x = CleanupResponse{}
-	mi := &file_artifacthub_proto_msgTypes[16]
+func (x *DestroyResponse) Reset() {
+	*x = DestroyResponse{}
+	mi := &file_artifacthub_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DestroyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DestroyResponse) ProtoMessage() {}
+
+func (x *DestroyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DestroyResponse.ProtoReflect.Descriptor instead.
+func (*DestroyResponse) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{18}
+}
+
+// Request for List a Bucket directory
+// Contains ID of the Artifact, and the path to list
+type ListPathRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	ArtifactId string                 `protobuf:"bytes,1,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
+	Path       string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// By default, files under a subdirectory are not returned,
+	// and only the subdirectory itself is returned in an item with is_directory=true.
+	// If you want to list the files under subdirectories too, use unwrap_directories=true.
+	UnwrapDirectories bool `protobuf:"varint,3,opt,name=unwrap_directories,json=unwrapDirectories,proto3" json:"unwrap_directories,omitempty"`
+	// If set, only the files pushed with all of these labels are returned, and no directories.
+	// Files under subdirectories are returned the same way as with unwrap_directories=true.
+	Labels        map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPathRequest) Reset() {
+	*x = ListPathRequest{}
+	mi := &file_artifacthub_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPathRequest) ProtoMessage() {}
+
+func (x *ListPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPathRequest.ProtoReflect.Descriptor instead.
+func (*ListPathRequest) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListPathRequest) GetArtifactId() string {
+	if x != nil {
+		return x.ArtifactId
+	}
+	return ""
+}
+
+func (x *ListPathRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ListPathRequest) GetUnwrapDirectories() bool {
+	if x != nil {
+		return x.UnwrapDirectories
+	}
+	return false
+}
+
+func (x *ListPathRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+// Response for List a Bucket directory
+// Contains the directory contents and response status
+type ListPathResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ListItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPathResponse) Reset() {
+	*x = ListPathResponse{}
+	mi := &file_artifacthub_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPathResponse) ProtoMessage() {}
+
+func (x *ListPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPathResponse.ProtoReflect.Descriptor instead.
+func (*ListPathResponse) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListPathResponse) GetItems() []*ListItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Request for SearchArtifacts
+// - page_size  = files returned per page, defaults to 100
+// - page_token = next_page_token of the previous page, empty for the first one
+type SearchArtifactsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArtifactId    string                 `protobuf:"bytes,1,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchArtifactsRequest) Reset() {
+	*x = SearchArtifactsRequest{}
+	mi := &file_artifacthub_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchArtifactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchArtifactsRequest) ProtoMessage() {}
+
+func (x *SearchArtifactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchArtifactsRequest.ProtoReflect.Descriptor instead.
+func (*SearchArtifactsRequest) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SearchArtifactsRequest) GetArtifactId() string {
+	if x != nil {
+		return x.ArtifactId
+	}
+	return ""
+}
+
+func (x *SearchArtifactsRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *SearchArtifactsRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *SearchArtifactsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *SearchArtifactsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchArtifactsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ArtifactMetadata    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchArtifactsResponse) Reset() {
+	*x = SearchArtifactsResponse{}
+	mi := &file_artifacthub_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchArtifactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchArtifactsResponse) ProtoMessage() {}
+
+func (x *SearchArtifactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchArtifactsResponse.ProtoReflect.Descriptor instead.
+func (*SearchArtifactsResponse) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SearchArtifactsResponse) GetItems() []*ArtifactMetadata {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *SearchArtifactsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// What pushed a file, and the labels it was pushed with.
+type ArtifactMetadata struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	ProjectId     string                 `protobuf:"bytes,2,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	WorkflowId    string                 `protobuf:"bytes,3,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	JobId         string                 `protobuf:"bytes,4,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Labels        map[string]string      `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArtifactMetadata) Reset() {
+	*x = ArtifactMetadata{}
+	mi := &file_artifacthub_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArtifactMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtifactMetadata) ProtoMessage() {}
+
+func (x *ArtifactMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtifactMetadata.ProtoReflect.Descriptor instead.
+func (*ArtifactMetadata) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ArtifactMetadata) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ArtifactMetadata) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *ArtifactMetadata) GetWorkflowId() string {
+	if x != nil {
+		return x.WorkflowId
+	}
+	return ""
+}
+
+func (x *ArtifactMetadata) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *ArtifactMetadata) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ArtifactMetadata) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ArtifactMetadata) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Request for Delete an object or directory from a Bucket
+// Contains ID of the Artifact, and the path to the object
+type DeletePathRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArtifactId    string                 `protobuf:"bytes,1,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePathRequest) Reset() {
+	*x = DeletePathRequest{}
+	mi := &file_artifacthub_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePathRequest) ProtoMessage() {}
+
+func (x *DeletePathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePathRequest.ProtoReflect.Descriptor instead.
+func (*DeletePathRequest) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeletePathRequest) GetArtifactId() string {
+	if x != nil {
+		return x.ArtifactId
+	}
+	return ""
+}
+
+func (x *DeletePathRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// Response for Delete an object or directory from a Bucket
+// Contains response status
+type DeletePathResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePathResponse) Reset() {
+	*x = DeletePathResponse{}
+	mi := &file_artifacthub_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePathResponse) ProtoMessage() {}
+
+func (x *DeletePathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePathResponse.ProtoReflect.Descriptor instead.
+func (*DeletePathResponse) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{25}
+}
+
+// Request for Cleanup all expired paths for all Buckets
+type CleanupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CleanupRequest) Reset() {
+	*x = CleanupRequest{}
+	mi := &file_artifacthub_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CleanupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanupRequest) ProtoMessage() {}
+
+func (x *CleanupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanupRequest.ProtoReflect.Descriptor instead.
+func (*CleanupRequest) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{26}
+}
+
+// Response for Cleanup all expired paths for all Buckets
+// Contains response status
+type CleanupResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CleanupResponse) Reset() {
+	*x = CleanupResponse{}
+	mi := &file_artifacthub_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CleanupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanupResponse) ProtoMessage() {}
+
+func (x *CleanupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanupResponse.ProtoReflect.Descriptor instead.
+func (*CleanupResponse) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{27}
+}
+
+type GetSignedURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArtifactId    string                 `protobuf:"bytes,1,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
+	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSignedURLRequest) Reset() {
+	*x = GetSignedURLRequest{}
+	mi := &file_artifacthub_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSignedURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSignedURLRequest) ProtoMessage() {}
+
+func (x *GetSignedURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSignedURLRequest.ProtoReflect.Descriptor instead.
+func (*GetSignedURLRequest) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetSignedURLRequest) GetArtifactId() string {
+	if x != nil {
+		return x.ArtifactId
+	}
+	return ""
+}
+
+func (x *GetSignedURLRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *GetSignedURLRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+type GetSignedURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSignedURLResponse) Reset() {
+	*x = GetSignedURLResponse{}
+	mi := &file_artifacthub_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSignedURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSignedURLResponse) ProtoMessage() {}
+
+func (x *GetSignedURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSignedURLResponse.ProtoReflect.Descriptor instead.
+func (*GetSignedURLResponse) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *GetSignedURLResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type ListBucketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBucketsRequest) Reset() {
+	*x = ListBucketsRequest{}
+	mi := &file_artifacthub_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBucketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBucketsRequest) ProtoMessage() {}
+
+func (x *ListBucketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBucketsRequest.ProtoReflect.Descriptor instead.
+func (*ListBucketsRequest) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListBucketsRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ListBucketsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	BucketNamesForIds map[string]string      `protobuf:"bytes,1,rep,name=bucket_names_for_ids,json=bucketNamesForIds,proto3" json:"bucket_names_for_ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListBucketsResponse) Reset() {
+	*x = ListBucketsResponse{}
+	mi := &file_artifacthub_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBucketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBucketsResponse) ProtoMessage() {}
+
+func (x *ListBucketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBucketsResponse.ProtoReflect.Descriptor instead.
+func (*ListBucketsResponse) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListBucketsResponse) GetBucketNamesForIds() map[string]string {
+	if x != nil {
+		return x.BucketNamesForIds
+	}
+	return nil
+}
+
+// requests a recursive count of files in project/<projectID> or the same for workflow or job
+type CountArtifactsRequest struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Category      CountArtifactsRequest_Category `protobuf:"varint,1,opt,name=category,proto3,enum=InternalApi.Artifacthub.CountArtifactsRequest_Category" json:"category,omitempty"`
+	CategoryId    string                         `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	ArtifactId    string                         `protobuf:"bytes,3,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountArtifactsRequest) Reset() {
+	*x = CountArtifactsRequest{}
+	mi := &file_artifacthub_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountArtifactsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountArtifactsRequest) ProtoMessage() {}
+
+func (x *CountArtifactsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountArtifactsRequest.ProtoReflect.Descriptor instead.
+func (*CountArtifactsRequest) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CountArtifactsRequest) GetCategory() CountArtifactsRequest_Category {
+	if x != nil {
+		return x.Category
+	}
+	return CountArtifactsRequest_PROJECT
+}
+
+func (x *CountArtifactsRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CountArtifactsRequest) GetArtifactId() string {
+	if x != nil {
+		return x.ArtifactId
+	}
+	return ""
+}
+
+type CountArtifactsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArtifactCount int32                  `protobuf:"varint,5,opt,name=artifact_count,json=artifactCount,proto3" json:"artifact_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountArtifactsResponse) Reset() {
+	*x = CountArtifactsResponse{}
+	mi := &file_artifacthub_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountArtifactsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountArtifactsResponse) ProtoMessage() {}
+
+func (x *CountArtifactsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountArtifactsResponse.ProtoReflect.Descriptor instead.
+func (*CountArtifactsResponse) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *CountArtifactsResponse) GetArtifactCount() int32 {
+	if x != nil {
+		return x.ArtifactCount
+	}
+	return 0
+}
+
+type CountBucketsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountBucketsRequest) Reset() {
+	*x = CountBucketsRequest{}
+	mi := &file_artifacthub_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountBucketsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountBucketsRequest) ProtoMessage() {}
+
+func (x *CountBucketsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountBucketsRequest.ProtoReflect.Descriptor instead.
+func (*CountBucketsRequest) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{34}
+}
+
+type CountBucketsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BucketCount   int32                  `protobuf:"varint,1,opt,name=bucket_count,json=bucketCount,proto3" json:"bucket_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CountBucketsResponse) Reset() {
+	*x = CountBucketsResponse{}
+	mi := &file_artifacthub_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CountBucketsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountBucketsResponse) ProtoMessage() {}
+
+func (x *CountBucketsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountBucketsResponse.ProtoReflect.Descriptor instead.
+func (*CountBucketsResponse) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CountBucketsResponse) GetBucketCount() int32 {
+	if x != nil {
+		return x.BucketCount
+	}
+	return 0
+}
+
+type UpdateCORSRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	BucketName    string                 `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCORSRequest) Reset() {
+	*x = UpdateCORSRequest{}
+	mi := &file_artifacthub_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCORSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCORSRequest) ProtoMessage() {}
+
+func (x *UpdateCORSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCORSRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCORSRequest) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateCORSRequest) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+type UpdateCORSResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	NextBucketName string                 `protobuf:"bytes,1,opt,name=next_bucket_name,json=nextBucketName,proto3" json:"next_bucket_name,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateCORSResponse) Reset() {
+	*x = UpdateCORSResponse{}
+	mi := &file_artifacthub_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCORSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCORSResponse) ProtoMessage() {}
+
+func (x *UpdateCORSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCORSResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCORSResponse) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateCORSResponse) GetNextBucketName() string {
+	if x != nil {
+		return x.NextBucketName
+	}
+	return ""
+}
+
+type ListItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	IsDirectory   bool                   `protobuf:"varint,2,opt,name=is_directory,json=isDirectory,proto3" json:"is_directory,omitempty"`
+	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"` // size in bytes, 0 for directories
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListItem) Reset() {
+	*x = ListItem{}
+	mi := &file_artifacthub_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItem) ProtoMessage() {}
+
+func (x *ListItem) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItem.ProtoReflect.Descriptor instead.
+func (*ListItem) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ListItem) GetIsDirectory() bool {
+	if x != nil {
+		return x.IsDirectory
+	}
+	return false
+}
+
+func (x *ListItem) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type Artifact struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	BucketName    string                 `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	ArtifactToken string                 `protobuf:"bytes,4,opt,name=artifact_token,json=artifactToken,proto3" json:"artifact_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Artifact) Reset() {
+	*x = Artifact{}
+	mi := &file_artifacthub_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Artifact) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Artifact) ProtoMessage() {}
+
+func (x *Artifact) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Artifact.ProtoReflect.Descriptor instead.
+func (*Artifact) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *Artifact) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Artifact) GetBucketName() string {
+	if x != nil {
+		return x.BucketName
+	}
+	return ""
+}
+
+func (x *Artifact) GetArtifactToken() string {
+	if x != nil {
+		return x.ArtifactToken
+	}
+	return ""
+}
+
+// Request for GenerateToken
+// - artifact_id = [required] UUID of the artifact
+// - job_id      = [required] UUID of the job
+// - project_id  = [required] UUID of the project
+// - workflow_id = [optional] UUID of the workflow. If not specified, token will not be able to manage artifacts for any workflows
+// - duration    = [optional] How long should the newly generated token last. If not specified, 24h is used
+type GenerateTokenRequest struct {
+	state      protoimpl.MessageState           `protogen:"open.v1"`
+	ArtifactId string                           `protobuf:"bytes,1,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
+	JobId      string                           `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	WorkflowId string                           `protobuf:"bytes,3,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	ProjectId  string                           `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Duration   uint32                           `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	Operations []GenerateTokenRequest_Operation `protobuf:"varint,6,rep,packed,name=operations,proto3,enum=InternalApi.Artifacthub.GenerateTokenRequest_Operation" json:"operations,omitempty"`
+	// If set, the token only gives access to the paths under this prefix,
+	// relative to the project/workflow/job directory, e.g. "test-results/".
+	PathPrefix    string `protobuf:"bytes,7,opt,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateTokenRequest) Reset() {
+	*x = GenerateTokenRequest{}
+	mi := &file_artifacthub_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CleanupResponse) String() string {
+func (x *GenerateTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CleanupResponse) ProtoMessage() {}
+func (*GenerateTokenRequest) ProtoMessage() {}
 
-func (x *CleanupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_artifacthub_proto_msgTypes[16]
+func (x *GenerateTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -882,93 +2387,86 @@ func (x *CleanupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CleanupResponse.ProtoReflect.Descriptor instead.
-func (*CleanupResponse) Descriptor() ([]byte, []int) {
-	return file_artifacthub_proto_rawDescGZIP(), []int{16}
-}
-
-type GetSignedURLRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ArtifactId    string                 `protobuf:"bytes,1,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
-	Path          string                 `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Deprecated: Use GenerateTokenRequest.ProtoReflect.Descriptor instead.
+func (*GenerateTokenRequest) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetSignedURLRequest) Reset() {
-	*x = GetSignedURLRequest{}
-	mi := &file_artifacthub_proto_msgTypes[17]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GenerateTokenRequest) GetArtifactId() string {
+	if x != nil {
+		return x.ArtifactId
+	}
+	return ""
 }
 
-func (x *GetSignedURLRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GenerateTokenRequest) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
 }
 
-func (*GetSignedURLRequest) ProtoMessage() {}
-
-func (x *GetSignedURLRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_artifacthub_proto_msgTypes[17]
+func (x *GenerateTokenRequest) GetWorkflowId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.WorkflowId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetSignedURLRequest.ProtoReflect.Descriptor instead.
-func (*GetSignedURLRequest) Descriptor() ([]byte, []int) {
-	return file_artifacthub_proto_rawDescGZIP(), []int{17}
+func (x *GenerateTokenRequest) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
 }
 
-func (x *GetSignedURLRequest) GetArtifactId() string {
+func (x *GenerateTokenRequest) GetDuration() uint32 {
 	if x != nil {
-		return x.ArtifactId
+		return x.Duration
 	}
-	return ""
+	return 0
 }
 
-func (x *GetSignedURLRequest) GetPath() string {
+func (x *GenerateTokenRequest) GetOperations() []GenerateTokenRequest_Operation {
 	if x != nil {
-		return x.Path
+		return x.Operations
 	}
-	return ""
+	return nil
 }
 
-func (x *GetSignedURLRequest) GetMethod() string {
+func (x *GenerateTokenRequest) GetPathPrefix() string {
 	if x != nil {
-		return x.Method
+		return x.PathPrefix
 	}
 	return ""
 }
 
-type GetSignedURLResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+// Response for GenerateToken
+// - token  = [required] JWT token generated
+type GenerateTokenResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Token string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// ID of the token (its jti claim), used to revoke it.
+	TokenId       string `protobuf:"bytes,2,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSignedURLResponse) Reset() {
-	*x = GetSignedURLResponse{}
-	mi := &file_artifacthub_proto_msgTypes[18]
+func (x *GenerateTokenResponse) Reset() {
+	*x = GenerateTokenResponse{}
+	mi := &file_artifacthub_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSignedURLResponse) String() string {
+func (x *GenerateTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSignedURLResponse) ProtoMessage() {}
+func (*GenerateTokenResponse) ProtoMessage() {}
 
-func (x *GetSignedURLResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_artifacthub_proto_msgTypes[18]
+func (x *GenerateTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -979,40 +2477,48 @@ func (x *GetSignedURLResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSignedURLResponse.ProtoReflect.Descriptor instead.
-func (*GetSignedURLResponse) Descriptor() ([]byte, []int) {
-	return file_artifacthub_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use GenerateTokenResponse.ProtoReflect.Descriptor instead.
+func (*GenerateTokenResponse) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetSignedURLResponse) GetUrl() string {
+func (x *GenerateTokenResponse) GetToken() string {
 	if x != nil {
-		return x.Url
+		return x.Token
 	}
 	return ""
 }
 
-type ListBucketsRequest struct {
+func (x *GenerateTokenResponse) GetTokenId() string {
+	if x != nil {
+		return x.TokenId
+	}
+	return ""
+}
+
+// Request for RevokeToken
+type RevokeTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	TokenId       string                 `protobuf:"bytes,1,opt,name=token_id,json=tokenId,proto3" json:"token_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBucketsRequest) Reset() {
-	*x = ListBucketsRequest{}
-	mi := &file_artifacthub_proto_msgTypes[19]
+func (x *RevokeTokenRequest) Reset() {
+	*x = RevokeTokenRequest{}
+	mi := &file_artifacthub_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBucketsRequest) String() string {
+func (x *RevokeTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBucketsRequest) ProtoMessage() {}
+func (*RevokeTokenRequest) ProtoMessage() {}
 
-func (x *ListBucketsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_artifacthub_proto_msgTypes[19]
+func (x *RevokeTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1023,40 +2529,40 @@ func (x *ListBucketsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBucketsRequest.ProtoReflect.Descriptor instead.
-func (*ListBucketsRequest) Descriptor() ([]byte, []int) {
-	return file_artifacthub_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use RevokeTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeTokenRequest) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *ListBucketsRequest) GetIds() []string {
+func (x *RevokeTokenRequest) GetTokenId() string {
 	if x != nil {
-		return x.Ids
+		return x.TokenId
 	}
-	return nil
+	return ""
 }
 
-type ListBucketsResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	BucketNamesForIds map[string]string      `protobuf:"bytes,1,rep,name=bucket_names_for_ids,json=bucketNamesForIds,proto3" json:"bucket_names_for_ids,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+// Response for RevokeToken
+type RevokeTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListBucketsResponse) Reset() {
-	*x = ListBucketsResponse{}
-	mi := &file_artifacthub_proto_msgTypes[20]
+func (x *RevokeTokenResponse) Reset() {
+	*x = RevokeTokenResponse{}
+	mi := &file_artifacthub_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBucketsResponse) String() string {
+func (x *RevokeTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBucketsResponse) ProtoMessage() {}
+func (*RevokeTokenResponse) ProtoMessage() {}
 
-func (x *ListBucketsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_artifacthub_proto_msgTypes[20]
+func (x *RevokeTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1067,43 +2573,42 @@ func (x *ListBucketsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBucketsResponse.ProtoReflect.Descriptor instead.
-func (*ListBucketsResponse) Descriptor() ([]byte, []int) {
-	return file_artifacthub_proto_rawDescGZIP(), []int{20}
-}
-
-func (x *ListBucketsResponse) GetBucketNamesForIds() map[string]string {
-	if x != nil {
-		return x.BucketNamesForIds
-	}
-	return nil
+// Deprecated: Use RevokeTokenResponse.ProtoReflect.Descriptor instead.
+func (*RevokeTokenResponse) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{43}
 }
 
-// requests a recursive count of files in project/<projectID> or the same for workflow or job
-type CountArtifactsRequest struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Category      CountArtifactsRequest_Category `protobuf:"varint,1,opt,name=category,proto3,enum=InternalApi.Artifacthub.CountArtifactsRequest_Category" json:"category,omitempty"`
-	CategoryId    string                         `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	ArtifactId    string                         `protobuf:"bytes,3,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// Request for CopyPath
+//   - source_path      = a file or a directory of the source artifact store
+//   - destination_path = where a file is copied to, or copied into if it ends with a slash;
+//     files of a directory are copied into it
+//   - force            = overwrites the destination if it exists
+type CopyPathRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	SourceArtifactId      string                 `protobuf:"bytes,1,opt,name=source_artifact_id,json=sourceArtifactId,proto3" json:"source_artifact_id,omitempty"`
+	SourcePath            string                 `protobuf:"bytes,2,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
+	DestinationArtifactId string                 `protobuf:"bytes,3,opt,name=destination_artifact_id,json=destinationArtifactId,proto3" json:"destination_artifact_id,omitempty"`
+	DestinationPath       string                 `protobuf:"bytes,4,opt,name=destination_path,json=destinationPath,proto3" json:"destination_path,omitempty"`
+	Force                 bool                   `protobuf:"varint,5,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *CountArtifactsRequest) Reset() {
-	*x = CountArtifactsRequest{}
-	mi := &file_artifacthub_proto_msgTypes[21]
+func (x *CopyPathRequest) Reset() {
+	*x = CopyPathRequest{}
+	mi := &file_artifacthub_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CountArtifactsRequest) String() string {
+func (x *CopyPathRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountArtifactsRequest) ProtoMessage() {}
+func (*CopyPathRequest) ProtoMessage() {}
 
-func (x *CountArtifactsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_artifacthub_proto_msgTypes[21]
+func (x *CopyPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1114,54 +2619,72 @@ func (x *CountArtifactsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountArtifactsRequest.ProtoReflect.Descriptor instead.
-func (*CountArtifactsRequest) Descriptor() ([]byte, []int) {
-	return file_artifacthub_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use CopyPathRequest.ProtoReflect.Descriptor instead.
+func (*CopyPathRequest) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *CountArtifactsRequest) GetCategory() CountArtifactsRequest_Category {
+func (x *CopyPathRequest) GetSourceArtifactId() string {
 	if x != nil {
-		return x.Category
+		return x.SourceArtifactId
 	}
-	return CountArtifactsRequest_PROJECT
+	return ""
 }
 
-func (x *CountArtifactsRequest) GetCategoryId() string {
+func (x *CopyPathRequest) GetSourcePath() string {
 	if x != nil {
-		return x.CategoryId
+		return x.SourcePath
 	}
 	return ""
 }
 
-func (x *CountArtifactsRequest) GetArtifactId() string {
+func (x *CopyPathRequest) GetDestinationArtifactId() string {
 	if x != nil {
-		return x.ArtifactId
+		return x.DestinationArtifactId
 	}
 	return ""
 }
 
-type CountArtifactsResponse struct {
+func (x *CopyPathRequest) GetDestinationPath() string {
+	if x != nil {
+		return x.DestinationPath
+	}
+	return ""
+}
+
+func (x *CopyPathRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+// Response for CopyPath
+type CopyPathResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ArtifactCount int32                  `protobuf:"varint,5,opt,name=artifact_count,json=artifactCount,proto3" json:"artifact_count,omitempty"`
+	CopiedCount   int32                  `protobuf:"varint,1,opt,name=copied_count,json=copiedCount,proto3" json:"copied_count,omitempty"`
+	CopiedSize    int64                  `protobuf:"varint,2,opt,name=copied_size,json=copiedSize,proto3" json:"copied_size,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	TotalSize     int64                  `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CountArtifactsResponse) Reset() {
-	*x = CountArtifactsResponse{}
-	mi := &file_artifacthub_proto_msgTypes[22]
+func (x *CopyPathResponse) Reset() {
+	*x = CopyPathResponse{}
+	mi := &file_artifacthub_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CountArtifactsResponse) String() string {
+func (x *CopyPathResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountArtifactsResponse) ProtoMessage() {}
+func (*CopyPathResponse) ProtoMessage() {}
 
-func (x *CountArtifactsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_artifacthub_proto_msgTypes[22]
+func (x *CopyPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1172,39 +2695,66 @@ func (x *CountArtifactsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountArtifactsResponse.ProtoReflect.Descriptor instead.
-func (*CountArtifactsResponse) Descriptor() ([]byte, []int) {
-	return file_artifacthub_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use CopyPathResponse.ProtoReflect.Descriptor instead.
+func (*CopyPathResponse) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *CountArtifactsResponse) GetArtifactCount() int32 {
+func (x *CopyPathResponse) GetCopiedCount() int32 {
 	if x != nil {
-		return x.ArtifactCount
+		return x.CopiedCount
 	}
 	return 0
 }
 
-type CountBucketsRequest struct {
+func (x *CopyPathResponse) GetCopiedSize() int64 {
+	if x != nil {
+		return x.CopiedSize
+	}
+	return 0
+}
+
+func (x *CopyPathResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *CopyPathResponse) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// Request for GetUsage
+// - artifact_id = usage of a single artifact store
+// - org_id      = usage of all the artifact stores of an organization
+// Usage is collected periodically by the usage scanner, so it can lag behind the actual storage.
+type GetUsageRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	ArtifactId    string                 `protobuf:"bytes,1,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
+	OrgId         string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CountBucketsRequest) Reset() {
-	*x = CountBucketsRequest{}
-	mi := &file_artifacthub_proto_msgTypes[23]
+func (x *GetUsageRequest) Reset() {
+	*x = GetUsageRequest{}
+	mi := &file_artifacthub_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CountBucketsRequest) String() string {
+func (x *GetUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountBucketsRequest) ProtoMessage() {}
+func (*GetUsageRequest) ProtoMessage() {}
 
-func (x *CountBucketsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_artifacthub_proto_msgTypes[23]
+func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1215,33 +2765,53 @@ func (x *CountBucketsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountBucketsRequest.ProtoReflect.Descriptor instead.
-func (*CountBucketsRequest) Descriptor() ([]byte, []int) {
-	return file_artifacthub_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{46}
 }
 
-type CountBucketsResponse struct {
+func (x *GetUsageRequest) GetArtifactId() string {
+	if x != nil {
+		return x.ArtifactId
+	}
+	return ""
+}
+
+func (x *GetUsageRequest) GetOrgId() string {
+	if x != nil {
+		return x.OrgId
+	}
+	return ""
+}
+
+// Response for GetUsage
+// - usage       = bytes stored per project, workflow and job
+// - total_size  = sum of all the usage sizes, in bytes
+// - quota       = populated only for organizations with a storage quota
+type GetUsageResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	BucketCount   int32                  `protobuf:"varint,1,opt,name=bucket_count,json=bucketCount,proto3" json:"bucket_count,omitempty"`
+	Usage         []*Usage               `protobuf:"bytes,1,rep,name=usage,proto3" json:"usage,omitempty"`
+	TotalSize     int64                  `protobuf:"varint,2,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	Quota         *Quota                 `protobuf:"bytes,3,opt,name=quota,proto3" json:"quota,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CountBucketsResponse) Reset() {
-	*x = CountBucketsResponse{}
-	mi := &file_artifacthub_proto_msgTypes[24]
+func (x *GetUsageResponse) Reset() {
+	*x = GetUsageResponse{}
+	mi := &file_artifacthub_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CountBucketsResponse) String() string {
+func (x *GetUsageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CountBucketsResponse) ProtoMessage() {}
+func (*GetUsageResponse) ProtoMessage() {}
 
-func (x *CountBucketsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_artifacthub_proto_msgTypes[24]
+func (x *GetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1252,40 +2822,59 @@ func (x *CountBucketsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CountBucketsResponse.ProtoReflect.Descriptor instead.
-func (*CountBucketsResponse) Descriptor() ([]byte, []int) {
-	return file_artifacthub_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GetUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetUsageResponse) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetUsageResponse) GetUsage() []*Usage {
+	if x != nil {
+		return x.Usage
+	}
+	return nil
 }
 
-func (x *CountBucketsResponse) GetBucketCount() int32 {
+func (x *GetUsageResponse) GetTotalSize() int64 {
 	if x != nil {
-		return x.BucketCount
+		return x.TotalSize
 	}
 	return 0
 }
 
-type UpdateCORSRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	BucketName    string                 `protobuf:"bytes,1,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+func (x *GetUsageResponse) GetQuota() *Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+type Usage struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	ArtifactId    string                         `protobuf:"bytes,1,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
+	Category      CountArtifactsRequest_Category `protobuf:"varint,2,opt,name=category,proto3,enum=InternalApi.Artifacthub.CountArtifactsRequest_Category" json:"category,omitempty"`
+	CategoryId    string                         `protobuf:"bytes,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Size          int64                          `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"` // size in bytes
+	ObjectCount   int64                          `protobuf:"varint,5,opt,name=object_count,json=objectCount,proto3" json:"object_count,omitempty"`
+	ScannedAt     *timestamp.Timestamp           `protobuf:"bytes,6,opt,name=scanned_at,json=scannedAt,proto3" json:"scanned_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCORSRequest) Reset() {
-	*x = UpdateCORSRequest{}
-	mi := &file_artifacthub_proto_msgTypes[25]
+func (x *Usage) Reset() {
+	*x = Usage{}
+	mi := &file_artifacthub_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCORSRequest) String() string {
+func (x *Usage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCORSRequest) ProtoMessage() {}
+func (*Usage) ProtoMessage() {}
 
-func (x *UpdateCORSRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_artifacthub_proto_msgTypes[25]
+func (x *Usage) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1296,86 +2885,79 @@ func (x *UpdateCORSRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCORSRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCORSRequest) Descriptor() ([]byte, []int) {
-	return file_artifacthub_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use Usage.ProtoReflect.Descriptor instead.
+func (*Usage) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *UpdateCORSRequest) GetBucketName() string {
+func (x *Usage) GetArtifactId() string {
 	if x != nil {
-		return x.BucketName
+		return x.ArtifactId
 	}
 	return ""
 }
 
-type UpdateCORSResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	NextBucketName string                 `protobuf:"bytes,1,opt,name=next_bucket_name,json=nextBucketName,proto3" json:"next_bucket_name,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
-}
-
-func (x *UpdateCORSResponse) Reset() {
-	*x = UpdateCORSResponse{}
-	mi := &file_artifacthub_proto_msgTypes[26]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Usage) GetCategory() CountArtifactsRequest_Category {
+	if x != nil {
+		return x.Category
+	}
+	return CountArtifactsRequest_PROJECT
 }
 
-func (x *UpdateCORSResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Usage) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
 }
 
-func (*UpdateCORSResponse) ProtoMessage() {}
-
-func (x *UpdateCORSResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_artifacthub_proto_msgTypes[26]
+func (x *Usage) GetSize() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Size
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use UpdateCORSResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCORSResponse) Descriptor() ([]byte, []int) {
-	return file_artifacthub_proto_rawDescGZIP(), []int{26}
+func (x *Usage) GetObjectCount() int64 {
+	if x != nil {
+		return x.ObjectCount
+	}
+	return 0
 }
 
-func (x *UpdateCORSResponse) GetNextBucketName() string {
+func (x *Usage) GetScannedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.NextBucketName
+		return x.ScannedAt
 	}
-	return ""
+	return nil
 }
 
-type ListItem struct {
+// Uploads are refused once the usage of an organization reaches the hard limit.
+// Reaching the soft limit is only reported. Limits are in bytes, 0 means no limit.
+type Quota struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	IsDirectory   bool                   `protobuf:"varint,2,opt,name=is_directory,json=isDirectory,proto3" json:"is_directory,omitempty"`
-	Size          int64                  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"` // size in bytes, 0 for directories
+	OrgId         string                 `protobuf:"bytes,1,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
+	SoftLimit     int64                  `protobuf:"varint,2,opt,name=soft_limit,json=softLimit,proto3" json:"soft_limit,omitempty"`
+	HardLimit     int64                  `protobuf:"varint,3,opt,name=hard_limit,json=hardLimit,proto3" json:"hard_limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListItem) Reset() {
-	*x = ListItem{}
-	mi := &file_artifacthub_proto_msgTypes[27]
+func (x *Quota) Reset() {
+	*x = Quota{}
+	mi := &file_artifacthub_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListItem) String() string {
+func (x *Quota) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListItem) ProtoMessage() {}
+func (*Quota) ProtoMessage() {}
 
-func (x *ListItem) ProtoReflect() protoreflect.Message {
-	mi := &file_artifacthub_proto_msgTypes[27]
+func (x *Quota) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1386,56 +2968,54 @@ func (x *ListItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListItem.ProtoReflect.Descriptor instead.
-func (*ListItem) Descriptor() ([]byte, []int) {
-	return file_artifacthub_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use Quota.ProtoReflect.Descriptor instead.
+func (*Quota) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ListItem) GetName() string {
+func (x *Quota) GetOrgId() string {
 	if x != nil {
-		return x.Name
+		return x.OrgId
 	}
 	return ""
 }
 
-func (x *ListItem) GetIsDirectory() bool {
+func (x *Quota) GetSoftLimit() int64 {
 	if x != nil {
-		return x.IsDirectory
+		return x.SoftLimit
 	}
-	return false
+	return 0
 }
 
-func (x *ListItem) GetSize() int64 {
+func (x *Quota) GetHardLimit() int64 {
 	if x != nil {
-		return x.Size
+		return x.HardLimit
 	}
 	return 0
 }
 
-type Artifact struct {
+type SetQuotaRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	BucketName    string                 `protobuf:"bytes,2,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
-	ArtifactToken string                 `protobuf:"bytes,4,opt,name=artifact_token,json=artifactToken,proto3" json:"artifact_token,omitempty"`
+	Quota         *Quota                 `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Artifact) Reset() {
-	*x = Artifact{}
-	mi := &file_artifacthub_proto_msgTypes[28]
+func (x *SetQuotaRequest) Reset() {
+	*x = SetQuotaRequest{}
+	mi := &file_artifacthub_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Artifact) String() string {
+func (x *SetQuotaRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Artifact) ProtoMessage() {}
+func (*SetQuotaRequest) ProtoMessage() {}
 
-func (x *Artifact) ProtoReflect() protoreflect.Message {
-	mi := &file_artifacthub_proto_msgTypes[28]
+func (x *SetQuotaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1446,64 +3026,87 @@ func (x *Artifact) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Artifact.ProtoReflect.Descriptor instead.
-func (*Artifact) Descriptor() ([]byte, []int) {
-	return file_artifacthub_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use SetQuotaRequest.ProtoReflect.Descriptor instead.
+func (*SetQuotaRequest) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *Artifact) GetId() string {
+func (x *SetQuotaRequest) GetQuota() *Quota {
 	if x != nil {
-		return x.Id
+		return x.Quota
 	}
-	return ""
+	return nil
 }
 
-func (x *Artifact) GetBucketName() string {
+type SetQuotaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Quota         *Quota                 `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetQuotaResponse) Reset() {
+	*x = SetQuotaResponse{}
+	mi := &file_artifacthub_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetQuotaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetQuotaResponse) ProtoMessage() {}
+
+func (x *SetQuotaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[51]
 	if x != nil {
-		return x.BucketName
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Artifact) GetArtifactToken() string {
+// Deprecated: Use SetQuotaResponse.ProtoReflect.Descriptor instead.
+func (*SetQuotaResponse) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SetQuotaResponse) GetQuota() *Quota {
 	if x != nil {
-		return x.ArtifactToken
+		return x.Quota
 	}
-	return ""
+	return nil
 }
 
-// Request for GenerateToken
-// - artifact_id = [required] UUID of the artifact
-// - job_id      = [required] UUID of the job
-// - project_id  = [required] UUID of the project
-// - workflow_id = [optional] UUID of the workflow. If not specified, token will not be able to manage artifacts for any workflows
-// - duration    = [optional] How long should the newly generated token last. If not specified, 24h is used
-type GenerateTokenRequest struct {
+// Request for SetOrganization
+// Artifact stores that already belong to another organization are not moved.
+type SetOrganizationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ArtifactId    string                 `protobuf:"bytes,1,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
-	JobId         string                 `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
-	WorkflowId    string                 `protobuf:"bytes,3,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
-	ProjectId     string                 `protobuf:"bytes,4,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-	Duration      uint32                 `protobuf:"varint,5,opt,name=duration,proto3" json:"duration,omitempty"`
+	OrgId         string                 `protobuf:"bytes,2,opt,name=org_id,json=orgId,proto3" json:"org_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateTokenRequest) Reset() {
-	*x = GenerateTokenRequest{}
-	mi := &file_artifacthub_proto_msgTypes[29]
+func (x *SetOrganizationRequest) Reset() {
+	*x = SetOrganizationRequest{}
+	mi := &file_artifacthub_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateTokenRequest) String() string {
+func (x *SetOrganizationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateTokenRequest) ProtoMessage() {}
+func (*SetOrganizationRequest) ProtoMessage() {}
 
-func (x *GenerateTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_artifacthub_proto_msgTypes[29]
+func (x *SetOrganizationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1514,70 +3117,46 @@ func (x *GenerateTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateTokenRequest.ProtoReflect.Descriptor instead.
-func (*GenerateTokenRequest) Descriptor() ([]byte, []int) {
-	return file_artifacthub_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use SetOrganizationRequest.ProtoReflect.Descriptor instead.
+func (*SetOrganizationRequest) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *GenerateTokenRequest) GetArtifactId() string {
+func (x *SetOrganizationRequest) GetArtifactId() string {
 	if x != nil {
 		return x.ArtifactId
 	}
 	return ""
 }
 
-func (x *GenerateTokenRequest) GetJobId() string {
-	if x != nil {
-		return x.JobId
-	}
-	return ""
-}
-
-func (x *GenerateTokenRequest) GetWorkflowId() string {
-	if x != nil {
-		return x.WorkflowId
-	}
-	return ""
-}
-
-func (x *GenerateTokenRequest) GetProjectId() string {
+func (x *SetOrganizationRequest) GetOrgId() string {
 	if x != nil {
-		return x.ProjectId
+		return x.OrgId
 	}
 	return ""
 }
 
-func (x *GenerateTokenRequest) GetDuration() uint32 {
-	if x != nil {
-		return x.Duration
-	}
-	return 0
-}
-
-// Response for GenerateToken
-// - token  = [required] JWT token generated
-type GenerateTokenResponse struct {
+type SetOrganizationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateTokenResponse) Reset() {
-	*x = GenerateTokenResponse{}
-	mi := &file_artifacthub_proto_msgTypes[30]
+func (x *SetOrganizationResponse) Reset() {
+	*x = SetOrganizationResponse{}
+	mi := &file_artifacthub_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateTokenResponse) String() string {
+func (x *SetOrganizationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateTokenResponse) ProtoMessage() {}
+func (*SetOrganizationResponse) ProtoMessage() {}
 
-func (x *GenerateTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_artifacthub_proto_msgTypes[30]
+func (x *SetOrganizationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacthub_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1588,16 +3167,9 @@ func (x *GenerateTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateTokenResponse.ProtoReflect.Descriptor instead.
-func (*GenerateTokenResponse) Descriptor() ([]byte, []int) {
-	return file_artifacthub_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *GenerateTokenResponse) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
+// Deprecated: Use SetOrganizationResponse.ProtoReflect.Descriptor instead.
+func (*SetOrganizationResponse) Descriptor() ([]byte, []int) {
+	return file_artifacthub_proto_rawDescGZIP(), []int{53}
 }
 
 type RetentionPolicy_RetentionPolicyRule struct {
@@ -1614,14 +3186,22 @@ type RetentionPolicy_RetentionPolicyRule struct {
 	// Age of the file expressed in seconds.
 	// One day is 24 * 3600.
 	// One week is 7 * 24 * 3600.
-	Age           int64 `protobuf:"varint,2,opt,name=age,proto3" json:"age,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Age      int64                                    `protobuf:"varint,2,opt,name=age,proto3" json:"age,omitempty"`
+	Kind     RetentionPolicy_RetentionPolicyRule_Kind `protobuf:"varint,3,opt,name=kind,proto3,enum=InternalApi.Artifacthub.RetentionPolicy_RetentionPolicyRule_Kind" json:"kind,omitempty"`
+	KeepLast int32                                    `protobuf:"varint,4,opt,name=keep_last,json=keepLast,proto3" json:"keep_last,omitempty"`
+	MaxSize  int64                                    `protobuf:"varint,5,opt,name=max_size,json=maxSize,proto3" json:"max_size,omitempty"`
+	// Files of workflows running on these branches or tags are skipped by the rule,
+	// and the next rules apply to them. Wildcards are supported, e.g. release/*.
+	// Workflow level only.
+	ExcludeBranches []string `protobuf:"bytes,6,rep,name=exclude_branches,json=excludeBranches,proto3" json:"exclude_branches,omitempty"`
+	ExcludeTags     []string `protobuf:"bytes,7,rep,name=exclude_tags,json=excludeTags,proto3" json:"exclude_tags,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *RetentionPolicy_RetentionPolicyRule) Reset() {
 	*x = RetentionPolicy_RetentionPolicyRule{}
-	mi := &file_artifacthub_proto_msgTypes[31]
+	mi := &file_artifacthub_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1633,7 +3213,7 @@ func (x *RetentionPolicy_RetentionPolicyRule) String() string {
 func (*RetentionPolicy_RetentionPolicyRule) ProtoMessage() {}
 
 func (x *RetentionPolicy_RetentionPolicyRule) ProtoReflect() protoreflect.Message {
-	mi := &file_artifacthub_proto_msgTypes[31]
+	mi := &file_artifacthub_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1663,31 +3243,119 @@ func (x *RetentionPolicy_RetentionPolicyRule) GetAge() int64 {
 	return 0
 }
 
+func (x *RetentionPolicy_RetentionPolicyRule) GetKind() RetentionPolicy_RetentionPolicyRule_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return RetentionPolicy_RetentionPolicyRule_AGE
+}
+
+func (x *RetentionPolicy_RetentionPolicyRule) GetKeepLast() int32 {
+	if x != nil {
+		return x.KeepLast
+	}
+	return 0
+}
+
+func (x *RetentionPolicy_RetentionPolicyRule) GetMaxSize() int64 {
+	if x != nil {
+		return x.MaxSize
+	}
+	return 0
+}
+
+func (x *RetentionPolicy_RetentionPolicyRule) GetExcludeBranches() []string {
+	if x != nil {
+		return x.ExcludeBranches
+	}
+	return nil
+}
+
+func (x *RetentionPolicy_RetentionPolicyRule) GetExcludeTags() []string {
+	if x != nil {
+		return x.ExcludeTags
+	}
+	return nil
+}
+
 var File_artifacthub_proto protoreflect.FileDescriptor
 
 const file_artifacthub_proto_rawDesc = "" +
 	"\n" +
 	"\x11artifacthub.proto\x12\x17InternalApi.Artifacthub\x1a\x1fgoogle/protobuf/timestamp.proto\"\x14\n" +
 	"\x12HealthCheckRequest\"\x15\n" +
-	"\x13HealthCheckResponse\"\x82\x05\n" +
+	"\x13HealthCheckResponse\"\x8e\a\n" +
 	"\x0fRetentionPolicy\x12\x85\x01\n" +
 	" project_level_retention_policies\x18\x01 \x03(\v2<.InternalApi.Artifacthub.RetentionPolicy.RetentionPolicyRuleR\x1dprojectLevelRetentionPolicies\x12\x87\x01\n" +
 	"!workflow_level_retention_policies\x18\x02 \x03(\v2<.InternalApi.Artifacthub.RetentionPolicy.RetentionPolicyRuleR\x1eworkflowLevelRetentionPolicies\x12}\n" +
 	"\x1cjob_level_retention_policies\x18\x03 \x03(\v2<.InternalApi.Artifacthub.RetentionPolicy.RetentionPolicyRuleR\x19jobLevelRetentionPolicies\x12U\n" +
 	"\x19scheduled_for_cleaning_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x16scheduledForCleaningAt\x12B\n" +
-	"\x0flast_cleaned_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rlastCleanedAt\x1aC\n" +
+	"\x0flast_cleaned_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rlastCleanedAt\x1a\xce\x02\n" +
 	"\x13RetentionPolicyRule\x12\x1a\n" +
 	"\bselector\x18\x01 \x01(\tR\bselector\x12\x10\n" +
-	"\x03age\x18\x02 \x01(\x03R\x03age\"\x94\x01\n" +
+	"\x03age\x18\x02 \x01(\x03R\x03age\x12U\n" +
+	"\x04kind\x18\x03 \x01(\x0e2A.InternalApi.Artifacthub.RetentionPolicy.RetentionPolicyRule.KindR\x04kind\x12\x1b\n" +
+	"\tkeep_last\x18\x04 \x01(\x05R\bkeepLast\x12\x19\n" +
+	"\bmax_size\x18\x05 \x01(\x03R\amaxSize\x12)\n" +
+	"\x10exclude_branches\x18\x06 \x03(\tR\x0fexcludeBranches\x12!\n" +
+	"\fexclude_tags\x18\a \x03(\tR\vexcludeTags\",\n" +
+	"\x04Kind\x12\a\n" +
+	"\x03AGE\x10\x00\x12\r\n" +
+	"\tKEEP_LAST\x10\x01\x12\f\n" +
+	"\bMAX_SIZE\x10\x02\"\x94\x01\n" +
 	"\x1cUpdateRetentionPolicyRequest\x12\x1f\n" +
 	"\vartifact_id\x18\x01 \x01(\tR\n" +
 	"artifactId\x12S\n" +
 	"\x10retention_policy\x18\x02 \x01(\v2(.InternalApi.Artifacthub.RetentionPolicyR\x0fretentionPolicy\"t\n" +
 	"\x1dUpdateRetentionPolicyResponse\x12S\n" +
-	"\x10retention_policy\x18\x01 \x01(\v2(.InternalApi.Artifacthub.RetentionPolicyR\x0fretentionPolicy\"\x89\x01\n" +
+	"\x10retention_policy\x18\x01 \x01(\v2(.InternalApi.Artifacthub.RetentionPolicyR\x0fretentionPolicy\"\xb6\x01\n" +
+	"\x1dPreviewRetentionPolicyRequest\x12\x1f\n" +
+	"\vartifact_id\x18\x01 \x01(\tR\n" +
+	"artifactId\x12S\n" +
+	"\x10retention_policy\x18\x02 \x01(\v2(.InternalApi.Artifacthub.RetentionPolicyR\x0fretentionPolicy\x12\x1f\n" +
+	"\vmax_objects\x18\x03 \x01(\x05R\n" +
+	"maxObjects\"\x92\x02\n" +
+	"\x1ePreviewRetentionPolicyResponse\x12B\n" +
+	"\aobjects\x18\x01 \x03(\v2(.InternalApi.Artifacthub.RetentionObjectR\aobjects\x12#\n" +
+	"\rmatched_count\x18\x02 \x01(\x03R\fmatchedCount\x12!\n" +
+	"\fmatched_size\x18\x03 \x01(\x03R\vmatchedSize\x12#\n" +
+	"\rvisited_count\x18\x04 \x01(\x03R\fvisitedCount\x12!\n" +
+	"\fvisited_size\x18\x05 \x01(\x03R\vvisitedSize\x12\x1c\n" +
+	"\ttruncated\x18\x06 \x01(\bR\ttruncated\"K\n" +
+	"\x0fRetentionObject\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x10\n" +
+	"\x03age\x18\x03 \x01(\x03R\x03age\"\xa7\x02\n" +
+	"\x0fRetentionReport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vartifact_id\x18\x02 \x01(\tR\n" +
+	"artifactId\x129\n" +
+	"\n" +
+	"started_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12#\n" +
+	"\rvisited_count\x18\x05 \x01(\x03R\fvisitedCount\x12#\n" +
+	"\rdeleted_count\x18\x06 \x01(\x03R\fdeletedCount\x12!\n" +
+	"\fdeleted_size\x18\a \x01(\x03R\vdeletedSize\"T\n" +
+	"\x1bListRetentionReportsRequest\x12\x1f\n" +
+	"\vartifact_id\x18\x01 \x01(\tR\n" +
+	"artifactId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"b\n" +
+	"\x1cListRetentionReportsResponse\x12B\n" +
+	"\areports\x18\x01 \x03(\v2(.InternalApi.Artifacthub.RetentionReportR\areports\"y\n" +
+	"\x1eDescribeRetentionReportRequest\x12\x1b\n" +
+	"\treport_id\x18\x01 \x01(\tR\breportId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\xde\x01\n" +
+	"\x1fDescribeRetentionReportResponse\x12@\n" +
+	"\x06report\x18\x01 \x01(\v2(.InternalApi.Artifacthub.RetentionReportR\x06report\x12Q\n" +
+	"\x0fdeleted_objects\x18\x02 \x03(\v2(.InternalApi.Artifacthub.RetentionObjectR\x0edeletedObjects\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xa0\x01\n" +
 	"\rCreateRequest\x12#\n" +
 	"\rrequest_token\x18\x01 \x01(\tR\frequestToken\x12S\n" +
-	"\x10retention_policy\x18\x02 \x01(\v2(.InternalApi.Artifacthub.RetentionPolicyR\x0fretentionPolicy\"O\n" +
+	"\x10retention_policy\x18\x02 \x01(\v2(.InternalApi.Artifacthub.RetentionPolicyR\x0fretentionPolicy\x12\x15\n" +
+	"\x06org_id\x18\x03 \x01(\tR\x05orgId\"O\n" +
 	"\x0eCreateResponse\x12=\n" +
 	"\bartifact\x18\x01 \x01(\v2!.InternalApi.Artifacthub.ArtifactR\bartifact\"l\n" +
 	"\x0fDescribeRequest\x12\x1f\n" +
@@ -1700,14 +3368,48 @@ const file_artifacthub_proto_rawDesc = "" +
 	"\x0eDestroyRequest\x12\x1f\n" +
 	"\vartifact_id\x18\x01 \x01(\tR\n" +
 	"artifactId\"\x11\n" +
-	"\x0fDestroyResponse\"u\n" +
+	"\x0fDestroyResponse\"\xfe\x01\n" +
 	"\x0fListPathRequest\x12\x1f\n" +
 	"\vartifact_id\x18\x01 \x01(\tR\n" +
 	"artifactId\x12\x12\n" +
 	"\x04path\x18\x02 \x01(\tR\x04path\x12-\n" +
-	"\x12unwrap_directories\x18\x03 \x01(\bR\x11unwrapDirectories\"K\n" +
+	"\x12unwrap_directories\x18\x03 \x01(\bR\x11unwrapDirectories\x12L\n" +
+	"\x06labels\x18\x04 \x03(\v24.InternalApi.Artifacthub.ListPathRequest.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"K\n" +
 	"\x10ListPathResponse\x127\n" +
-	"\x05items\x18\x01 \x03(\v2!.InternalApi.Artifacthub.ListItemR\x05items\"H\n" +
+	"\x05items\x18\x01 \x03(\v2!.InternalApi.Artifacthub.ListItemR\x05items\"\xa4\x02\n" +
+	"\x16SearchArtifactsRequest\x12\x1f\n" +
+	"\vartifact_id\x18\x01 \x01(\tR\n" +
+	"artifactId\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12S\n" +
+	"\x06labels\x18\x03 \x03(\v2;.InternalApi.Artifacthub.SearchArtifactsRequest.LabelsEntryR\x06labels\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x82\x01\n" +
+	"\x17SearchArtifactsResponse\x12?\n" +
+	"\x05items\x18\x01 \x03(\v2).InternalApi.Artifacthub.ArtifactMetadataR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xfd\x02\n" +
+	"\x10ArtifactMetadata\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x02 \x01(\tR\tprojectId\x12\x1f\n" +
+	"\vworkflow_id\x18\x03 \x01(\tR\n" +
+	"workflowId\x12\x15\n" +
+	"\x06job_id\x18\x04 \x01(\tR\x05jobId\x12M\n" +
+	"\x06labels\x18\x05 \x03(\v25.InternalApi.Artifacthub.ArtifactMetadata.LabelsEntryR\x06labels\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"H\n" +
 	"\x11DeletePathRequest\x12\x1f\n" +
 	"\vartifact_id\x18\x01 \x01(\tR\n" +
 	"artifactId\x12\x12\n" +
@@ -1757,7 +3459,7 @@ const file_artifacthub_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
 	"\vbucket_name\x18\x02 \x01(\tR\n" +
 	"bucketName\x12%\n" +
-	"\x0eartifact_token\x18\x04 \x01(\tR\rartifactToken\"\xaa\x01\n" +
+	"\x0eartifact_token\x18\x04 \x01(\tR\rartifactToken\"\xcf\x02\n" +
 	"\x14GenerateTokenRequest\x12\x1f\n" +
 	"\vartifact_id\x18\x01 \x01(\tR\n" +
 	"artifactId\x12\x15\n" +
@@ -1766,9 +3468,71 @@ const file_artifacthub_proto_rawDesc = "" +
 	"workflowId\x12\x1d\n" +
 	"\n" +
 	"project_id\x18\x04 \x01(\tR\tprojectId\x12\x1a\n" +
-	"\bduration\x18\x05 \x01(\rR\bduration\"-\n" +
+	"\bduration\x18\x05 \x01(\rR\bduration\x12W\n" +
+	"\n" +
+	"operations\x18\x06 \x03(\x0e27.InternalApi.Artifacthub.GenerateTokenRequest.OperationR\n" +
+	"operations\x12\x1f\n" +
+	"\vpath_prefix\x18\a \x01(\tR\n" +
+	"pathPrefix\")\n" +
+	"\tOperation\x12\b\n" +
+	"\x04PULL\x10\x00\x12\b\n" +
+	"\x04PUSH\x10\x01\x12\b\n" +
+	"\x04YANK\x10\x02\"H\n" +
 	"\x15GenerateTokenResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token2\xd2\v\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x19\n" +
+	"\btoken_id\x18\x02 \x01(\tR\atokenId\"/\n" +
+	"\x12RevokeTokenRequest\x12\x19\n" +
+	"\btoken_id\x18\x01 \x01(\tR\atokenId\"\x15\n" +
+	"\x13RevokeTokenResponse\"\xd9\x01\n" +
+	"\x0fCopyPathRequest\x12,\n" +
+	"\x12source_artifact_id\x18\x01 \x01(\tR\x10sourceArtifactId\x12\x1f\n" +
+	"\vsource_path\x18\x02 \x01(\tR\n" +
+	"sourcePath\x126\n" +
+	"\x17destination_artifact_id\x18\x03 \x01(\tR\x15destinationArtifactId\x12)\n" +
+	"\x10destination_path\x18\x04 \x01(\tR\x0fdestinationPath\x12\x14\n" +
+	"\x05force\x18\x05 \x01(\bR\x05force\"\x96\x01\n" +
+	"\x10CopyPathResponse\x12!\n" +
+	"\fcopied_count\x18\x01 \x01(\x05R\vcopiedCount\x12\x1f\n" +
+	"\vcopied_size\x18\x02 \x01(\x03R\n" +
+	"copiedSize\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x04 \x01(\x03R\ttotalSize\"I\n" +
+	"\x0fGetUsageRequest\x12\x1f\n" +
+	"\vartifact_id\x18\x01 \x01(\tR\n" +
+	"artifactId\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\tR\x05orgId\"\x9d\x01\n" +
+	"\x10GetUsageResponse\x124\n" +
+	"\x05usage\x18\x01 \x03(\v2\x1e.InternalApi.Artifacthub.UsageR\x05usage\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x02 \x01(\x03R\ttotalSize\x124\n" +
+	"\x05quota\x18\x03 \x01(\v2\x1e.InternalApi.Artifacthub.QuotaR\x05quota\"\x90\x02\n" +
+	"\x05Usage\x12\x1f\n" +
+	"\vartifact_id\x18\x01 \x01(\tR\n" +
+	"artifactId\x12S\n" +
+	"\bcategory\x18\x02 \x01(\x0e27.InternalApi.Artifacthub.CountArtifactsRequest.CategoryR\bcategory\x12\x1f\n" +
+	"\vcategory_id\x18\x03 \x01(\tR\n" +
+	"categoryId\x12\x12\n" +
+	"\x04size\x18\x04 \x01(\x03R\x04size\x12!\n" +
+	"\fobject_count\x18\x05 \x01(\x03R\vobjectCount\x129\n" +
+	"\n" +
+	"scanned_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tscannedAt\"\\\n" +
+	"\x05Quota\x12\x15\n" +
+	"\x06org_id\x18\x01 \x01(\tR\x05orgId\x12\x1d\n" +
+	"\n" +
+	"soft_limit\x18\x02 \x01(\x03R\tsoftLimit\x12\x1d\n" +
+	"\n" +
+	"hard_limit\x18\x03 \x01(\x03R\thardLimit\"G\n" +
+	"\x0fSetQuotaRequest\x124\n" +
+	"\x05quota\x18\x01 \x01(\v2\x1e.InternalApi.Artifacthub.QuotaR\x05quota\"H\n" +
+	"\x10SetQuotaResponse\x124\n" +
+	"\x05quota\x18\x01 \x01(\v2\x1e.InternalApi.Artifacthub.QuotaR\x05quota\"P\n" +
+	"\x16SetOrganizationRequest\x12\x1f\n" +
+	"\vartifact_id\x18\x01 \x01(\tR\n" +
+	"artifactId\x12\x15\n" +
+	"\x06org_id\x18\x02 \x01(\tR\x05orgId\"\x19\n" +
+	"\x17SetOrganizationResponse2\xee\x13\n" +
 	"\x0fArtifactService\x12h\n" +
 	"\vHealthCheck\x12+.InternalApi.Artifacthub.HealthCheckRequest\x1a,.InternalApi.Artifacthub.HealthCheckResponse\x12Y\n" +
 	"\x06Create\x12&.InternalApi.Artifacthub.CreateRequest\x1a'.InternalApi.Artifacthub.CreateResponse\x12_\n" +
@@ -1776,16 +3540,25 @@ const file_artifacthub_proto_rawDesc = "" +
 	"\aDestroy\x12'.InternalApi.Artifacthub.DestroyRequest\x1a(.InternalApi.Artifacthub.DestroyResponse\x12_\n" +
 	"\bListPath\x12(.InternalApi.Artifacthub.ListPathRequest\x1a).InternalApi.Artifacthub.ListPathResponse\x12e\n" +
 	"\n" +
-	"DeletePath\x12*.InternalApi.Artifacthub.DeletePathRequest\x1a+.InternalApi.Artifacthub.DeletePathResponse\x12\x86\x01\n" +
-	"\x15UpdateRetentionPolicy\x125.InternalApi.Artifacthub.UpdateRetentionPolicyRequest\x1a6.InternalApi.Artifacthub.UpdateRetentionPolicyResponse\x12n\n" +
-	"\rGenerateToken\x12-.InternalApi.Artifacthub.GenerateTokenRequest\x1a..InternalApi.Artifacthub.GenerateTokenResponse\x12\\\n" +
+	"DeletePath\x12*.InternalApi.Artifacthub.DeletePathRequest\x1a+.InternalApi.Artifacthub.DeletePathResponse\x12t\n" +
+	"\x0fSearchArtifacts\x12/.InternalApi.Artifacthub.SearchArtifactsRequest\x1a0.InternalApi.Artifacthub.SearchArtifactsResponse\x12\x86\x01\n" +
+	"\x15UpdateRetentionPolicy\x125.InternalApi.Artifacthub.UpdateRetentionPolicyRequest\x1a6.InternalApi.Artifacthub.UpdateRetentionPolicyResponse\x12\x89\x01\n" +
+	"\x16PreviewRetentionPolicy\x126.InternalApi.Artifacthub.PreviewRetentionPolicyRequest\x1a7.InternalApi.Artifacthub.PreviewRetentionPolicyResponse\x12\x83\x01\n" +
+	"\x14ListRetentionReports\x124.InternalApi.Artifacthub.ListRetentionReportsRequest\x1a5.InternalApi.Artifacthub.ListRetentionReportsResponse\x12\x8c\x01\n" +
+	"\x17DescribeRetentionReport\x127.InternalApi.Artifacthub.DescribeRetentionReportRequest\x1a8.InternalApi.Artifacthub.DescribeRetentionReportResponse\x12n\n" +
+	"\rGenerateToken\x12-.InternalApi.Artifacthub.GenerateTokenRequest\x1a..InternalApi.Artifacthub.GenerateTokenResponse\x12h\n" +
+	"\vRevokeToken\x12+.InternalApi.Artifacthub.RevokeTokenRequest\x1a,.InternalApi.Artifacthub.RevokeTokenResponse\x12a\n" +
+	"\bCopyPath\x12(.InternalApi.Artifacthub.CopyPathRequest\x1a).InternalApi.Artifacthub.CopyPathResponse0\x01\x12\\\n" +
 	"\aCleanup\x12'.InternalApi.Artifacthub.CleanupRequest\x1a(.InternalApi.Artifacthub.CleanupResponse\x12k\n" +
 	"\fGetSignedURL\x12,.InternalApi.Artifacthub.GetSignedURLRequest\x1a-.InternalApi.Artifacthub.GetSignedURLResponse\x12h\n" +
 	"\vListBuckets\x12+.InternalApi.Artifacthub.ListBucketsRequest\x1a,.InternalApi.Artifacthub.ListBucketsResponse\x12q\n" +
 	"\x0eCountArtifacts\x12..InternalApi.Artifacthub.CountArtifactsRequest\x1a/.InternalApi.Artifacthub.CountArtifactsResponse\x12k\n" +
 	"\fCountBuckets\x12,.InternalApi.Artifacthub.CountBucketsRequest\x1a-.InternalApi.Artifacthub.CountBucketsResponse\x12e\n" +
 	"\n" +
-	"UpdateCORS\x12*.InternalApi.Artifacthub.UpdateCORSRequest\x1a+.InternalApi.Artifacthub.UpdateCORSResponseBNZLgithub.com/semaphoreio/semaphore/artifacthub/pkg/api/descriptors/artifacthubb\x06proto3"
+	"UpdateCORS\x12*.InternalApi.Artifacthub.UpdateCORSRequest\x1a+.InternalApi.Artifacthub.UpdateCORSResponse\x12_\n" +
+	"\bGetUsage\x12(.InternalApi.Artifacthub.GetUsageRequest\x1a).InternalApi.Artifacthub.GetUsageResponse\x12_\n" +
+	"\bSetQuota\x12(.InternalApi.Artifacthub.SetQuotaRequest\x1a).InternalApi.Artifacthub.SetQuotaResponse\x12t\n" +
+	"\x0fSetOrganization\x12/.InternalApi.Artifacthub.SetOrganizationRequest\x1a0.InternalApi.Artifacthub.SetOrganizationResponseBNZLgithub.com/semaphoreio/semaphore/artifacthub/pkg/api/descriptors/artifacthubb\x06proto3"
 
 var (
 	file_artifacthub_proto_rawDescOnce sync.Once
@@ -1799,93 +3572,160 @@ func file_artifacthub_proto_rawDescGZIP() []byte {
 	return file_artifacthub_proto_rawDescData
 }
 
-var file_artifacthub_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_artifacthub_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_artifacthub_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_artifacthub_proto_msgTypes = make([]protoimpl.MessageInfo, 59)
 var file_artifacthub_proto_goTypes = []any{
-	(CountArtifactsRequest_Category)(0),         // 0: InternalApi.Artifacthub.CountArtifactsRequest.Category
-	(*HealthCheckRequest)(nil),                  // 1: InternalApi.Artifacthub.HealthCheckRequest
-	(*HealthCheckResponse)(nil),                 // 2: InternalApi.Artifacthub.HealthCheckResponse
-	(*RetentionPolicy)(nil),                     // 3: InternalApi.Artifacthub.RetentionPolicy
-	(*UpdateRetentionPolicyRequest)(nil),        // 4: InternalApi.Artifacthub.UpdateRetentionPolicyRequest
-	(*UpdateRetentionPolicyResponse)(nil),       // 5: InternalApi.Artifacthub.UpdateRetentionPolicyResponse
-	(*CreateRequest)(nil),                       // 6: InternalApi.Artifacthub.CreateRequest
-	(*CreateResponse)(nil),                      // 7: InternalApi.Artifacthub.CreateResponse
-	(*DescribeRequest)(nil),                     // 8: InternalApi.Artifacthub.DescribeRequest
-	(*DescribeResponse)(nil),                    // 9: InternalApi.Artifacthub.DescribeResponse
-	(*DestroyRequest)(nil),                      // 10: InternalApi.Artifacthub.DestroyRequest
-	(*DestroyResponse)(nil),                     // 11: InternalApi.Artifacthub.DestroyResponse
-	(*ListPathRequest)(nil),                     // 12: InternalApi.Artifacthub.ListPathRequest
-	(*ListPathResponse)(nil),                    // 13: InternalApi.Artifacthub.ListPathResponse
-	(*DeletePathRequest)(nil),                   // 14: InternalApi.Artifacthub.DeletePathRequest
-	(*DeletePathResponse)(nil),                  // 15: InternalApi.Artifacthub.DeletePathResponse
-	(*CleanupRequest)(nil),                      // 16: InternalApi.Artifacthub.CleanupRequest
-	(*CleanupResponse)(nil),                     // 17: InternalApi.Artifacthub.CleanupResponse
-	(*GetSignedURLRequest)(nil),                 // 18: InternalApi.Artifacthub.GetSignedURLRequest
-	(*GetSignedURLResponse)(nil),                // 19: InternalApi.Artifacthub.GetSignedURLResponse
-	(*ListBucketsRequest)(nil),                  // 20: InternalApi.Artifacthub.ListBucketsRequest
-	(*ListBucketsResponse)(nil),                 // 21: InternalApi.Artifacthub.ListBucketsResponse
-	(*CountArtifactsRequest)(nil),               // 22: InternalApi.Artifacthub.CountArtifactsRequest
-	(*CountArtifactsResponse)(nil),              // 23: InternalApi.Artifacthub.CountArtifactsResponse
-	(*CountBucketsRequest)(nil),                 // 24: InternalApi.Artifacthub.CountBucketsRequest
-	(*CountBucketsResponse)(nil),                // 25: InternalApi.Artifacthub.CountBucketsResponse
-	(*UpdateCORSRequest)(nil),                   // 26: InternalApi.Artifacthub.UpdateCORSRequest
-	(*UpdateCORSResponse)(nil),                  // 27: InternalApi.Artifacthub.UpdateCORSResponse
-	(*ListItem)(nil),                            // 28: InternalApi.Artifacthub.ListItem
-	(*Artifact)(nil),                            // 29: InternalApi.Artifacthub.Artifact
-	(*GenerateTokenRequest)(nil),                // 30: InternalApi.Artifacthub.GenerateTokenRequest
-	(*GenerateTokenResponse)(nil),               // 31: InternalApi.Artifacthub.GenerateTokenResponse
-	(*RetentionPolicy_RetentionPolicyRule)(nil), // 32: InternalApi.Artifacthub.RetentionPolicy.RetentionPolicyRule
-	nil,                         // 33: InternalApi.Artifacthub.ListBucketsResponse.BucketNamesForIdsEntry
-	(*timestamp.Timestamp)(nil), // 34: google.protobuf.Timestamp
+	(RetentionPolicy_RetentionPolicyRule_Kind)(0), // 0: InternalApi.Artifacthub.RetentionPolicy.RetentionPolicyRule.Kind
+	(CountArtifactsRequest_Category)(0),           // 1: InternalApi.Artifacthub.CountArtifactsRequest.Category
+	(GenerateTokenRequest_Operation)(0),           // 2: InternalApi.Artifacthub.GenerateTokenRequest.Operation
+	(*HealthCheckRequest)(nil),                    // 3: InternalApi.Artifacthub.HealthCheckRequest
+	(*HealthCheckResponse)(nil),                   // 4: InternalApi.Artifacthub.HealthCheckResponse
+	(*RetentionPolicy)(nil),                       // 5: InternalApi.Artifacthub.RetentionPolicy
+	(*UpdateRetentionPolicyRequest)(nil),          // 6: InternalApi.Artifacthub.UpdateRetentionPolicyRequest
+	(*UpdateRetentionPolicyResponse)(nil),         // 7: InternalApi.Artifacthub.UpdateRetentionPolicyResponse
+	(*PreviewRetentionPolicyRequest)(nil),         // 8: InternalApi.Artifacthub.PreviewRetentionPolicyRequest
+	(*PreviewRetentionPolicyResponse)(nil),        // 9: InternalApi.Artifacthub.PreviewRetentionPolicyResponse
+	(*RetentionObject)(nil),                       // 10: InternalApi.Artifacthub.RetentionObject
+	(*RetentionReport)(nil),                       // 11: InternalApi.Artifacthub.RetentionReport
+	(*ListRetentionReportsRequest)(nil),           // 12: InternalApi.Artifacthub.ListRetentionReportsRequest
+	(*ListRetentionReportsResponse)(nil),          // 13: InternalApi.Artifacthub.ListRetentionReportsResponse
+	(*DescribeRetentionReportRequest)(nil),        // 14: InternalApi.Artifacthub.DescribeRetentionReportRequest
+	(*DescribeRetentionReportResponse)(nil),       // 15: InternalApi.Artifacthub.DescribeRetentionReportResponse
+	(*CreateRequest)(nil),                         // 16: InternalApi.Artifacthub.CreateRequest
+	(*CreateResponse)(nil),                        // 17: InternalApi.Artifacthub.CreateResponse
+	(*DescribeRequest)(nil),                       // 18: InternalApi.Artifacthub.DescribeRequest
+	(*DescribeResponse)(nil),                      // 19: InternalApi.Artifacthub.DescribeResponse
+	(*DestroyRequest)(nil),                        // 20: InternalApi.Artifacthub.DestroyRequest
+	(*DestroyResponse)(nil),                       // 21: InternalApi.Artifacthub.DestroyResponse
+	(*ListPathRequest)(nil),                       // 22: InternalApi.Artifacthub.ListPathRequest
+	(*ListPathResponse)(nil),                      // 23: InternalApi.Artifacthub.ListPathResponse
+	(*SearchArtifactsRequest)(nil),                // 24: InternalApi.Artifacthub.SearchArtifactsRequest
+	(*SearchArtifactsResponse)(nil),               // 25: InternalApi.Artifacthub.SearchArtifactsResponse
+	(*ArtifactMetadata)(nil),                      // 26: InternalApi.Artifacthub.ArtifactMetadata
+	(*DeletePathRequest)(nil),                     // 27: InternalApi.Artifacthub.DeletePathRequest
+	(*DeletePathResponse)(nil),                    // 28: InternalApi.Artifacthub.DeletePathResponse
+	(*CleanupRequest)(nil),                        // 29: InternalApi.Artifacthub.CleanupRequest
+	(*CleanupResponse)(nil),                       // 30: InternalApi.Artifacthub.CleanupResponse
+	(*GetSignedURLRequest)(nil),                   // 31: InternalApi.Artifacthub.GetSignedURLRequest
+	(*GetSignedURLResponse)(nil),                  // 32: InternalApi.Artifacthub.GetSignedURLResponse
+	(*ListBucketsRequest)(nil),                    // 33: InternalApi.Artifacthub.ListBucketsRequest
+	(*ListBucketsResponse)(nil),                   // 34: InternalApi.Artifacthub.ListBucketsResponse
+	(*CountArtifactsRequest)(nil),                 // 35: InternalApi.Artifacthub.CountArtifactsRequest
+	(*CountArtifactsResponse)(nil),                // 36: InternalApi.Artifacthub.CountArtifactsResponse
+	(*CountBucketsRequest)(nil),                   // 37: InternalApi.Artifacthub.CountBucketsRequest
+	(*CountBucketsResponse)(nil),                  // 38: InternalApi.Artifacthub.CountBucketsResponse
+	(*UpdateCORSRequest)(nil),                     // 39: InternalApi.Artifacthub.UpdateCORSRequest
+	(*UpdateCORSResponse)(nil),                    // 40: InternalApi.Artifacthub.UpdateCORSResponse
+	(*ListItem)(nil),                              // 41: InternalApi.Artifacthub.ListItem
+	(*Artifact)(nil),                              // 42: InternalApi.Artifacthub.Artifact
+	(*GenerateTokenRequest)(nil),                  // 43: InternalApi.Artifacthub.GenerateTokenRequest
+	(*GenerateTokenResponse)(nil),                 // 44: InternalApi.Artifacthub.GenerateTokenResponse
+	(*RevokeTokenRequest)(nil),                    // 45: InternalApi.Artifacthub.RevokeTokenRequest
+	(*RevokeTokenResponse)(nil),                   // 46: InternalApi.Artifacthub.RevokeTokenResponse
+	(*CopyPathRequest)(nil),                       // 47: InternalApi.Artifacthub.CopyPathRequest
+	(*CopyPathResponse)(nil),                      // 48: InternalApi.Artifacthub.CopyPathResponse
+	(*GetUsageRequest)(nil),                       // 49: InternalApi.Artifacthub.GetUsageRequest
+	(*GetUsageResponse)(nil),                      // 50: InternalApi.Artifacthub.GetUsageResponse
+	(*Usage)(nil),                                 // 51: InternalApi.Artifacthub.Usage
+	(*Quota)(nil),                                 // 52: InternalApi.Artifacthub.Quota
+	(*SetQuotaRequest)(nil),                       // 53: InternalApi.Artifacthub.SetQuotaRequest
+	(*SetQuotaResponse)(nil),                      // 54: InternalApi.Artifacthub.SetQuotaResponse
+	(*SetOrganizationRequest)(nil),                // 55: InternalApi.Artifacthub.SetOrganizationRequest
+	(*SetOrganizationResponse)(nil),               // 56: InternalApi.Artifacthub.SetOrganizationResponse
+	(*RetentionPolicy_RetentionPolicyRule)(nil),   // 57: InternalApi.Artifacthub.RetentionPolicy.RetentionPolicyRule
+	nil,                         // 58: InternalApi.Artifacthub.ListPathRequest.LabelsEntry
+	nil,                         // 59: InternalApi.Artifacthub.SearchArtifactsRequest.LabelsEntry
+	nil,                         // 60: InternalApi.Artifacthub.ArtifactMetadata.LabelsEntry
+	nil,                         // 61: InternalApi.Artifacthub.ListBucketsResponse.BucketNamesForIdsEntry
+	(*timestamp.Timestamp)(nil), // 62: google.protobuf.Timestamp
 }
 var file_artifacthub_proto_depIdxs = []int32{
-	32, // 0: InternalApi.Artifacthub.RetentionPolicy.project_level_retention_policies:type_name -> InternalApi.Artifacthub.RetentionPolicy.RetentionPolicyRule
-	32, // 1: InternalApi.Artifacthub.RetentionPolicy.workflow_level_retention_policies:type_name -> InternalApi.Artifacthub.RetentionPolicy.RetentionPolicyRule
-	32, // 2: InternalApi.Artifacthub.RetentionPolicy.job_level_retention_policies:type_name -> InternalApi.Artifacthub.RetentionPolicy.RetentionPolicyRule
-	34, // 3: InternalApi.Artifacthub.RetentionPolicy.scheduled_for_cleaning_at:type_name -> google.protobuf.Timestamp
-	34, // 4: InternalApi.Artifacthub.RetentionPolicy.last_cleaned_at:type_name -> google.protobuf.Timestamp
-	3,  // 5: InternalApi.Artifacthub.UpdateRetentionPolicyRequest.retention_policy:type_name -> InternalApi.Artifacthub.RetentionPolicy
-	3,  // 6: InternalApi.Artifacthub.UpdateRetentionPolicyResponse.retention_policy:type_name -> InternalApi.Artifacthub.RetentionPolicy
-	3,  // 7: InternalApi.Artifacthub.CreateRequest.retention_policy:type_name -> InternalApi.Artifacthub.RetentionPolicy
-	29, // 8: InternalApi.Artifacthub.CreateResponse.artifact:type_name -> InternalApi.Artifacthub.Artifact
-	29, // 9: InternalApi.Artifacthub.DescribeResponse.artifact:type_name -> InternalApi.Artifacthub.Artifact
-	3,  // 10: InternalApi.Artifacthub.DescribeResponse.retention_policy:type_name -> InternalApi.Artifacthub.RetentionPolicy
-	28, // 11: InternalApi.Artifacthub.ListPathResponse.items:type_name -> InternalApi.Artifacthub.ListItem
-	33, // 12: InternalApi.Artifacthub.ListBucketsResponse.bucket_names_for_ids:type_name -> InternalApi.Artifacthub.ListBucketsResponse.BucketNamesForIdsEntry
-	0,  // 13: InternalApi.Artifacthub.CountArtifactsRequest.category:type_name -> InternalApi.Artifacthub.CountArtifactsRequest.Category
-	1,  // 14: InternalApi.Artifacthub.ArtifactService.HealthCheck:input_type -> InternalApi.Artifacthub.HealthCheckRequest
-	6,  // 15: InternalApi.Artifacthub.ArtifactService.Create:input_type -> InternalApi.Artifacthub.CreateRequest
-	8,  // 16: InternalApi.Artifacthub.ArtifactService.Describe:input_type -> InternalApi.Artifacthub.DescribeRequest
-	10, // 17: InternalApi.Artifacthub.ArtifactService.Destroy:input_type -> InternalApi.Artifacthub.DestroyRequest
-	12, // 18: InternalApi.Artifacthub.ArtifactService.ListPath:input_type -> InternalApi.Artifacthub.ListPathRequest
-	14, // 19: InternalApi.Artifacthub.ArtifactService.DeletePath:input_type -> InternalApi.Artifacthub.DeletePathRequest
-	4,  // 20: InternalApi.Artifacthub.ArtifactService.UpdateRetentionPolicy:input_type -> InternalApi.Artifacthub.UpdateRetentionPolicyRequest
-	30, // 21: InternalApi.Artifacthub.ArtifactService.GenerateToken:input_type -> InternalApi.Artifacthub.GenerateTokenRequest
-	16, // 22: InternalApi.Artifacthub.ArtifactService.Cleanup:input_type -> InternalApi.Artifacthub.CleanupRequest
-	18, // 23: InternalApi.Artifacthub.ArtifactService.GetSignedURL:input_type -> InternalApi.Artifacthub.GetSignedURLRequest
-	20, // 24: InternalApi.Artifacthub.ArtifactService.ListBuckets:input_type -> InternalApi.Artifacthub.ListBucketsRequest
-	22, // 25: InternalApi.Artifacthub.ArtifactService.CountArtifacts:input_type -> InternalApi.Artifacthub.CountArtifactsRequest
-	24, // 26: InternalApi.Artifacthub.ArtifactService.CountBuckets:input_type -> InternalApi.Artifacthub.CountBucketsRequest
-	26, // 27: InternalApi.Artifacthub.ArtifactService.UpdateCORS:input_type -> InternalApi.Artifacthub.UpdateCORSRequest
-	2,  // 28: InternalApi.Artifacthub.ArtifactService.HealthCheck:output_type -> InternalApi.Artifacthub.HealthCheckResponse
-	7,  // 29: InternalApi.Artifacthub.ArtifactService.Create:output_type -> InternalApi.Artifacthub.CreateResponse
-	9,  // 30: InternalApi.Artifacthub.ArtifactService.Describe:output_type -> InternalApi.Artifacthub.DescribeResponse
-	11, // 31: InternalApi.Artifacthub.ArtifactService.Destroy:output_type -> InternalApi.Artifacthub.DestroyResponse
-	13, // 32: InternalApi.Artifacthub.ArtifactService.ListPath:output_type -> InternalApi.Artifacthub.ListPathResponse
-	15, // 33: InternalApi.Artifacthub.ArtifactService.DeletePath:output_type -> InternalApi.Artifacthub.DeletePathResponse
-	5,  // 34: InternalApi.Artifacthub.ArtifactService.UpdateRetentionPolicy:output_type -> InternalApi.Artifacthub.UpdateRetentionPolicyResponse
-	31, // 35: InternalApi.Artifacthub.ArtifactService.GenerateToken:output_type -> InternalApi.Artifacthub.GenerateTokenResponse
-	17, // 36: InternalApi.Artifacthub.ArtifactService.Cleanup:output_type -> InternalApi.Artifacthub.CleanupResponse
-	19, // 37: InternalApi.Artifacthub.ArtifactService.GetSignedURL:output_type -> InternalApi.Artifacthub.GetSignedURLResponse
-	21, // 38: InternalApi.Artifacthub.ArtifactService.ListBuckets:output_type -> InternalApi.Artifacthub.ListBucketsResponse
-	23, // 39: InternalApi.Artifacthub.ArtifactService.CountArtifacts:output_type -> InternalApi.Artifacthub.CountArtifactsResponse
-	25, // 40: InternalApi.Artifacthub.ArtifactService.CountBuckets:output_type -> InternalApi.Artifacthub.CountBucketsResponse
-	27, // 41: InternalApi.Artifacthub.ArtifactService.UpdateCORS:output_type -> InternalApi.Artifacthub.UpdateCORSResponse
-	28, // [28:42] is the sub-list for method output_type
-	14, // [14:28] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	57, // 0: InternalApi.Artifacthub.RetentionPolicy.project_level_retention_policies:type_name -> InternalApi.Artifacthub.RetentionPolicy.RetentionPolicyRule
+	57, // 1: InternalApi.Artifacthub.RetentionPolicy.workflow_level_retention_policies:type_name -> InternalApi.Artifacthub.RetentionPolicy.RetentionPolicyRule
+	57, // 2: InternalApi.Artifacthub.RetentionPolicy.job_level_retention_policies:type_name -> InternalApi.Artifacthub.RetentionPolicy.RetentionPolicyRule
+	62, // 3: InternalApi.Artifacthub.RetentionPolicy.scheduled_for_cleaning_at:type_name -> google.protobuf.Timestamp
+	62, // 4: InternalApi.Artifacthub.RetentionPolicy.last_cleaned_at:type_name -> google.protobuf.Timestamp
+	5,  // 5: InternalApi.Artifacthub.UpdateRetentionPolicyRequest.retention_policy:type_name -> InternalApi.Artifacthub.RetentionPolicy
+	5,  // 6: InternalApi.Artifacthub.UpdateRetentionPolicyResponse.retention_policy:type_name -> InternalApi.Artifacthub.RetentionPolicy
+	5,  // 7: InternalApi.Artifacthub.PreviewRetentionPolicyRequest.retention_policy:type_name -> InternalApi.Artifacthub.RetentionPolicy
+	10, // 8: InternalApi.Artifacthub.PreviewRetentionPolicyResponse.objects:type_name -> InternalApi.Artifacthub.RetentionObject
+	62, // 9: InternalApi.Artifacthub.RetentionReport.started_at:type_name -> google.protobuf.Timestamp
+	62, // 10: InternalApi.Artifacthub.RetentionReport.finished_at:type_name -> google.protobuf.Timestamp
+	11, // 11: InternalApi.Artifacthub.ListRetentionReportsResponse.reports:type_name -> InternalApi.Artifacthub.RetentionReport
+	11, // 12: InternalApi.Artifacthub.DescribeRetentionReportResponse.report:type_name -> InternalApi.Artifacthub.RetentionReport
+	10, // 13: InternalApi.Artifacthub.DescribeRetentionReportResponse.deleted_objects:type_name -> InternalApi.Artifacthub.RetentionObject
+	5,  // 14: InternalApi.Artifacthub.CreateRequest.retention_policy:type_name -> InternalApi.Artifacthub.RetentionPolicy
+	42, // 15: InternalApi.Artifacthub.CreateResponse.artifact:type_name -> InternalApi.Artifacthub.Artifact
+	42, // 16: InternalApi.Artifacthub.DescribeResponse.artifact:type_name -> InternalApi.Artifacthub.Artifact
+	5,  // 17: InternalApi.Artifacthub.DescribeResponse.retention_policy:type_name -> InternalApi.Artifacthub.RetentionPolicy
+	58, // 18: InternalApi.Artifacthub.ListPathRequest.labels:type_name -> InternalApi.Artifacthub.ListPathRequest.LabelsEntry
+	41, // 19: InternalApi.Artifacthub.ListPathResponse.items:type_name -> InternalApi.Artifacthub.ListItem
+	59, // 20: InternalApi.Artifacthub.SearchArtifactsRequest.labels:type_name -> InternalApi.Artifacthub.SearchArtifactsRequest.LabelsEntry
+	26, // 21: InternalApi.Artifacthub.SearchArtifactsResponse.items:type_name -> InternalApi.Artifacthub.ArtifactMetadata
+	60, // 22: InternalApi.Artifacthub.ArtifactMetadata.labels:type_name -> InternalApi.Artifacthub.ArtifactMetadata.LabelsEntry
+	62, // 23: InternalApi.Artifacthub.ArtifactMetadata.created_at:type_name -> google.protobuf.Timestamp
+	62, // 24: InternalApi.Artifacthub.ArtifactMetadata.updated_at:type_name -> google.protobuf.Timestamp
+	61, // 25: InternalApi.Artifacthub.ListBucketsResponse.bucket_names_for_ids:type_name -> InternalApi.Artifacthub.ListBucketsResponse.BucketNamesForIdsEntry
+	1,  // 26: InternalApi.Artifacthub.CountArtifactsRequest.category:type_name -> InternalApi.Artifacthub.CountArtifactsRequest.Category
+	2,  // 27: InternalApi.Artifacthub.GenerateTokenRequest.operations:type_name -> InternalApi.Artifacthub.GenerateTokenRequest.Operation
+	51, // 28: InternalApi.Artifacthub.GetUsageResponse.usage:type_name -> InternalApi.Artifacthub.Usage
+	52, // 29: InternalApi.Artifacthub.GetUsageResponse.quota:type_name -> InternalApi.Artifacthub.Quota
+	1,  // 30: InternalApi.Artifacthub.Usage.category:type_name -> InternalApi.Artifacthub.CountArtifactsRequest.Category
+	62, // 31: InternalApi.Artifacthub.Usage.scanned_at:type_name -> google.protobuf.Timestamp
+	52, // 32: InternalApi.Artifacthub.SetQuotaRequest.quota:type_name -> InternalApi.Artifacthub.Quota
+	52, // 33: InternalApi.Artifacthub.SetQuotaResponse.quota:type_name -> InternalApi.Artifacthub.Quota
+	0,  // 34: InternalApi.Artifacthub.RetentionPolicy.RetentionPolicyRule.kind:type_name -> InternalApi.Artifacthub.RetentionPolicy.RetentionPolicyRule.Kind
+	3,  // 35: InternalApi.Artifacthub.ArtifactService.HealthCheck:input_type -> InternalApi.Artifacthub.HealthCheckRequest
+	16, // 36: InternalApi.Artifacthub.ArtifactService.Create:input_type -> InternalApi.Artifacthub.CreateRequest
+	18, // 37: InternalApi.Artifacthub.ArtifactService.Describe:input_type -> InternalApi.Artifacthub.DescribeRequest
+	20, // 38: InternalApi.Artifacthub.ArtifactService.Destroy:input_type -> InternalApi.Artifacthub.DestroyRequest
+	22, // 39: InternalApi.Artifacthub.ArtifactService.ListPath:input_type -> InternalApi.Artifacthub.ListPathRequest
+	27, // 40: InternalApi.Artifacthub.ArtifactService.DeletePath:input_type -> InternalApi.Artifacthub.DeletePathRequest
+	24, // 41: InternalApi.Artifacthub.ArtifactService.SearchArtifacts:input_type -> InternalApi.Artifacthub.SearchArtifactsRequest
+	6,  // 42: InternalApi.Artifacthub.ArtifactService.UpdateRetentionPolicy:input_type -> InternalApi.Artifacthub.UpdateRetentionPolicyRequest
+	8,  // 43: InternalApi.Artifacthub.ArtifactService.PreviewRetentionPolicy:input_type -> InternalApi.Artifacthub.PreviewRetentionPolicyRequest
+	12, // 44: InternalApi.Artifacthub.ArtifactService.ListRetentionReports:input_type -> InternalApi.Artifacthub.ListRetentionReportsRequest
+	14, // 45: InternalApi.Artifacthub.ArtifactService.DescribeRetentionReport:input_type -> InternalApi.Artifacthub.DescribeRetentionReportRequest
+	43, // 46: InternalApi.Artifacthub.ArtifactService.GenerateToken:input_type -> InternalApi.Artifacthub.GenerateTokenRequest
+	45, // 47: InternalApi.Artifacthub.ArtifactService.RevokeToken:input_type -> InternalApi.Artifacthub.RevokeTokenRequest
+	47, // 48: InternalApi.Artifacthub.ArtifactService.CopyPath:input_type -> InternalApi.Artifacthub.CopyPathRequest
+	29, // 49: InternalApi.Artifacthub.ArtifactService.Cleanup:input_type -> InternalApi.Artifacthub.CleanupRequest
+	31, // 50: InternalApi.Artifacthub.ArtifactService.GetSignedURL:input_type -> InternalApi.Artifacthub.GetSignedURLRequest
+	33, // 51: InternalApi.Artifacthub.ArtifactService.ListBuckets:input_type -> InternalApi.Artifacthub.ListBucketsRequest
+	35, // 52: InternalApi.Artifacthub.ArtifactService.CountArtifacts:input_type -> InternalApi.Artifacthub.CountArtifactsRequest
+	37, // 53: InternalApi.Artifacthub.ArtifactService.CountBuckets:input_type -> InternalApi.Artifacthub.CountBucketsRequest
+	39, // 54: InternalApi.Artifacthub.ArtifactService.UpdateCORS:input_type -> InternalApi.Artifacthub.UpdateCORSRequest
+	49, // 55: InternalApi.Artifacthub.ArtifactService.GetUsage:input_type -> InternalApi.Artifacthub.GetUsageRequest
+	53, // 56: InternalApi.Artifacthub.ArtifactService.SetQuota:input_type -> InternalApi.Artifacthub.SetQuotaRequest
+	55, // 57: InternalApi.Artifacthub.ArtifactService.SetOrganization:input_type -> InternalApi.Artifacthub.SetOrganizationRequest
+	4,  // 58: InternalApi.Artifacthub.ArtifactService.HealthCheck:output_type -> InternalApi.Artifacthub.HealthCheckResponse
+	17, // 59: InternalApi.Artifacthub.ArtifactService.Create:output_type -> InternalApi.Artifacthub.CreateResponse
+	19, // 60: InternalApi.Artifacthub.ArtifactService.Describe:output_type -> InternalApi.Artifacthub.DescribeResponse
+	21, // 61: InternalApi.Artifacthub.ArtifactService.Destroy:output_type -> InternalApi.Artifacthub.DestroyResponse
+	23, // 62: InternalApi.Artifacthub.ArtifactService.ListPath:output_type -> InternalApi.Artifacthub.ListPathResponse
+	28, // 63: InternalApi.Artifacthub.ArtifactService.DeletePath:output_type -> InternalApi.Artifacthub.DeletePathResponse
+	25, // 64: InternalApi.Artifacthub.ArtifactService.SearchArtifacts:output_type -> InternalApi.Artifacthub.SearchArtifactsResponse
+	7,  // 65: InternalApi.Artifacthub.ArtifactService.UpdateRetentionPolicy:output_type -> InternalApi.Artifacthub.UpdateRetentionPolicyResponse
+	9,  // 66: InternalApi.Artifacthub.ArtifactService.PreviewRetentionPolicy:output_type -> InternalApi.Artifacthub.PreviewRetentionPolicyResponse
+	13, // 67: InternalApi.Artifacthub.ArtifactService.ListRetentionReports:output_type -> InternalApi.Artifacthub.ListRetentionReportsResponse
+	15, // 68: InternalApi.Artifacthub.ArtifactService.DescribeRetentionReport:output_type -> InternalApi.Artifacthub.DescribeRetentionReportResponse
+	44, // 69: InternalApi.Artifacthub.ArtifactService.GenerateToken:output_type -> InternalApi.Artifacthub.GenerateTokenResponse
+	46, // 70: InternalApi.Artifacthub.ArtifactService.RevokeToken:output_type -> InternalApi.Artifacthub.RevokeTokenResponse
+	48, // 71: InternalApi.Artifacthub.ArtifactService.CopyPath:output_type -> InternalApi.Artifacthub.CopyPathResponse
+	30, // 72: InternalApi.Artifacthub.ArtifactService.Cleanup:output_type -> InternalApi.Artifacthub.CleanupResponse
+	32, // 73: InternalApi.Artifacthub.ArtifactService.GetSignedURL:output_type -> InternalApi.Artifacthub.GetSignedURLResponse
+	34, // 74: InternalApi.Artifacthub.ArtifactService.ListBuckets:output_type -> InternalApi.Artifacthub.ListBucketsResponse
+	36, // 75: InternalApi.Artifacthub.ArtifactService.CountArtifacts:output_type -> InternalApi.Artifacthub.CountArtifactsResponse
+	38, // 76: InternalApi.Artifacthub.ArtifactService.CountBuckets:output_type -> InternalApi.Artifacthub.CountBucketsResponse
+	40, // 77: InternalApi.Artifacthub.ArtifactService.UpdateCORS:output_type -> InternalApi.Artifacthub.UpdateCORSResponse
+	50, // 78: InternalApi.Artifacthub.ArtifactService.GetUsage:output_type -> InternalApi.Artifacthub.GetUsageResponse
+	54, // 79: InternalApi.Artifacthub.ArtifactService.SetQuota:output_type -> InternalApi.Artifacthub.SetQuotaResponse
+	56, // 80: InternalApi.Artifacthub.ArtifactService.SetOrganization:output_type -> InternalApi.Artifacthub.SetOrganizationResponse
+	58, // [58:81] is the sub-list for method output_type
+	35, // [35:58] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_artifacthub_proto_init() }
@@ -1898,8 +3738,8 @@ func file_artifacthub_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_artifacthub_proto_rawDesc), len(file_artifacthub_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   33,
+			NumEnums:      3,
+			NumMessages:   59,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
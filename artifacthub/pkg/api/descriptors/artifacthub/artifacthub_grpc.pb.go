@@ -19,20 +19,29 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ArtifactService_HealthCheck_FullMethodName           = "/InternalApi.Artifacthub.ArtifactService/HealthCheck"
-	ArtifactService_Create_FullMethodName                = "/InternalApi.Artifacthub.ArtifactService/Create"
-	ArtifactService_Describe_FullMethodName              = "/InternalApi.Artifacthub.ArtifactService/Describe"
-	ArtifactService_Destroy_FullMethodName               = "/InternalApi.Artifacthub.ArtifactService/Destroy"
-	ArtifactService_ListPath_FullMethodName              = "/InternalApi.Artifacthub.ArtifactService/ListPath"
-	ArtifactService_DeletePath_FullMethodName            = "/InternalApi.Artifacthub.ArtifactService/DeletePath"
-	ArtifactService_UpdateRetentionPolicy_FullMethodName = "/InternalApi.Artifacthub.ArtifactService/UpdateRetentionPolicy"
-	ArtifactService_GenerateToken_FullMethodName         = "/InternalApi.Artifacthub.ArtifactService/GenerateToken"
-	ArtifactService_Cleanup_FullMethodName               = "/InternalApi.Artifacthub.ArtifactService/Cleanup"
-	ArtifactService_GetSignedURL_FullMethodName          = "/InternalApi.Artifacthub.ArtifactService/GetSignedURL"
-	ArtifactService_ListBuckets_FullMethodName           = "/InternalApi.Artifacthub.ArtifactService/ListBuckets"
-	ArtifactService_CountArtifacts_FullMethodName        = "/InternalApi.Artifacthub.ArtifactService/CountArtifacts"
-	ArtifactService_CountBuckets_FullMethodName          = "/InternalApi.Artifacthub.ArtifactService/CountBuckets"
-	ArtifactService_UpdateCORS_FullMethodName            = "/InternalApi.Artifacthub.ArtifactService/UpdateCORS"
+	ArtifactService_HealthCheck_FullMethodName             = "/InternalApi.Artifacthub.ArtifactService/HealthCheck"
+	ArtifactService_Create_FullMethodName                  = "/InternalApi.Artifacthub.ArtifactService/Create"
+	ArtifactService_Describe_FullMethodName                = "/InternalApi.Artifacthub.ArtifactService/Describe"
+	ArtifactService_Destroy_FullMethodName                 = "/InternalApi.Artifacthub.ArtifactService/Destroy"
+	ArtifactService_ListPath_FullMethodName                = "/InternalApi.Artifacthub.ArtifactService/ListPath"
+	ArtifactService_DeletePath_FullMethodName              = "/InternalApi.Artifacthub.ArtifactService/DeletePath"
+	ArtifactService_SearchArtifacts_FullMethodName         = "/InternalApi.Artifacthub.ArtifactService/SearchArtifacts"
+	ArtifactService_UpdateRetentionPolicy_FullMethodName   = "/InternalApi.Artifacthub.ArtifactService/UpdateRetentionPolicy"
+	ArtifactService_PreviewRetentionPolicy_FullMethodName  = "/InternalApi.Artifacthub.ArtifactService/PreviewRetentionPolicy"
+	ArtifactService_ListRetentionReports_FullMethodName    = "/InternalApi.Artifacthub.ArtifactService/ListRetentionReports"
+	ArtifactService_DescribeRetentionReport_FullMethodName = "/InternalApi.Artifacthub.ArtifactService/DescribeRetentionReport"
+	ArtifactService_GenerateToken_FullMethodName           = "/InternalApi.Artifacthub.ArtifactService/GenerateToken"
+	ArtifactService_RevokeToken_FullMethodName             = "/InternalApi.Artifacthub.ArtifactService/RevokeToken"
+	ArtifactService_CopyPath_FullMethodName                = "/InternalApi.Artifacthub.ArtifactService/CopyPath"
+	ArtifactService_Cleanup_FullMethodName                 = "/InternalApi.Artifacthub.ArtifactService/Cleanup"
+	ArtifactService_GetSignedURL_FullMethodName            = "/InternalApi.Artifacthub.ArtifactService/GetSignedURL"
+	ArtifactService_ListBuckets_FullMethodName             = "/InternalApi.Artifacthub.ArtifactService/ListBuckets"
+	ArtifactService_CountArtifacts_FullMethodName          = "/InternalApi.Artifacthub.ArtifactService/CountArtifacts"
+	ArtifactService_CountBuckets_FullMethodName            = "/InternalApi.Artifacthub.ArtifactService/CountBuckets"
+	ArtifactService_UpdateCORS_FullMethodName              = "/InternalApi.Artifacthub.ArtifactService/UpdateCORS"
+	ArtifactService_GetUsage_FullMethodName                = "/InternalApi.Artifacthub.ArtifactService/GetUsage"
+	ArtifactService_SetQuota_FullMethodName                = "/InternalApi.Artifacthub.ArtifactService/SetQuota"
+	ArtifactService_SetOrganization_FullMethodName         = "/InternalApi.Artifacthub.ArtifactService/SetOrganization"
 )
 
 // ArtifactServiceClient is the client API for ArtifactService service.
@@ -48,11 +57,24 @@ type ArtifactServiceClient interface {
 	Destroy(ctx context.Context, in *DestroyRequest, opts ...grpc.CallOption) (*DestroyResponse, error)
 	ListPath(ctx context.Context, in *ListPathRequest, opts ...grpc.CallOption) (*ListPathResponse, error)
 	DeletePath(ctx context.Context, in *DeletePathRequest, opts ...grpc.CallOption) (*DeletePathResponse, error)
+	// finds the files of a project pushed with all the given labels, ordered by path
+	SearchArtifacts(ctx context.Context, in *SearchArtifactsRequest, opts ...grpc.CallOption) (*SearchArtifactsResponse, error)
 	UpdateRetentionPolicy(ctx context.Context, in *UpdateRetentionPolicyRequest, opts ...grpc.CallOption) (*UpdateRetentionPolicyResponse, error)
+	// runs a retention policy against an artifact store, and returns what it would delete without deleting anything
+	PreviewRetentionPolicy(ctx context.Context, in *PreviewRetentionPolicyRequest, opts ...grpc.CallOption) (*PreviewRetentionPolicyResponse, error)
+	// returns the reports of the last cleaning passes of an artifact store, newest first
+	ListRetentionReports(ctx context.Context, in *ListRetentionReportsRequest, opts ...grpc.CallOption) (*ListRetentionReportsResponse, error)
+	// returns a cleaning pass report, with a page of the objects deleted in it
+	DescribeRetentionReport(ctx context.Context, in *DescribeRetentionReportRequest, opts ...grpc.CallOption) (*DescribeRetentionReportResponse, error)
 	// Used to zebra to generate a short-lived JWT token, granting temporary access
 	// to a project/workflow/job artifacts for a newly created job.
 	// This is how jobs get access to the artifact API.
 	GenerateToken(ctx context.Context, in *GenerateTokenRequest, opts ...grpc.CallOption) (*GenerateTokenResponse, error)
+	// Revokes a token before it expires, e.g. when it has leaked.
+	RevokeToken(ctx context.Context, in *RevokeTokenRequest, opts ...grpc.CallOption) (*RevokeTokenResponse, error)
+	// Copies a file or a directory into another path, or into another artifact store of
+	// the same organization, without downloading it. The progress is streamed until everything is copied.
+	CopyPath(ctx context.Context, in *CopyPathRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CopyPathResponse], error)
 	// if the cleanup result has errors, that does NOT mean it has been failed: it means that there were errors
 	Cleanup(ctx context.Context, in *CleanupRequest, opts ...grpc.CallOption) (*CleanupResponse, error)
 	GetSignedURL(ctx context.Context, in *GetSignedURLRequest, opts ...grpc.CallOption) (*GetSignedURLResponse, error)
@@ -64,6 +86,13 @@ type ArtifactServiceClient interface {
 	CountBuckets(ctx context.Context, in *CountBucketsRequest, opts ...grpc.CallOption) (*CountBucketsResponse, error)
 	// updates CORS on the given bucket, and returns the next one ordered by created and bucket_name
 	UpdateCORS(ctx context.Context, in *UpdateCORSRequest, opts ...grpc.CallOption) (*UpdateCORSResponse, error)
+	// returns the bytes stored for the projects, workflows and jobs of an artifact store, or of all the artifact stores of an organization
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error)
+	// sets the storage quota of an organization
+	SetQuota(ctx context.Context, in *SetQuotaRequest, opts ...grpc.CallOption) (*SetQuotaResponse, error)
+	// sets the organization of an artifact store created before organizations were recorded,
+	// so its usage is counted towards the storage quota of the organization
+	SetOrganization(ctx context.Context, in *SetOrganizationRequest, opts ...grpc.CallOption) (*SetOrganizationResponse, error)
 }
 
 type artifactServiceClient struct {
@@ -134,6 +163,16 @@ func (c *artifactServiceClient) DeletePath(ctx context.Context, in *DeletePathRe
 	return out, nil
 }
 
+func (c *artifactServiceClient) SearchArtifacts(ctx context.Context, in *SearchArtifactsRequest, opts ...grpc.CallOption) (*SearchArtifactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchArtifactsResponse)
+	err := c.cc.Invoke(ctx, ArtifactService_SearchArtifacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *artifactServiceClient) UpdateRetentionPolicy(ctx context.Context, in *UpdateRetentionPolicyRequest, opts ...grpc.CallOption) (*UpdateRetentionPolicyResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateRetentionPolicyResponse)
@@ -144,6 +183,36 @@ func (c *artifactServiceClient) UpdateRetentionPolicy(ctx context.Context, in *U
 	return out, nil
 }
 
+func (c *artifactServiceClient) PreviewRetentionPolicy(ctx context.Context, in *PreviewRetentionPolicyRequest, opts ...grpc.CallOption) (*PreviewRetentionPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, ArtifactService_PreviewRetentionPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artifactServiceClient) ListRetentionReports(ctx context.Context, in *ListRetentionReportsRequest, opts ...grpc.CallOption) (*ListRetentionReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRetentionReportsResponse)
+	err := c.cc.Invoke(ctx, ArtifactService_ListRetentionReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artifactServiceClient) DescribeRetentionReport(ctx context.Context, in *DescribeRetentionReportRequest, opts ...grpc.CallOption) (*DescribeRetentionReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DescribeRetentionReportResponse)
+	err := c.cc.Invoke(ctx, ArtifactService_DescribeRetentionReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *artifactServiceClient) GenerateToken(ctx context.Context, in *GenerateTokenRequest, opts ...grpc.CallOption) (*GenerateTokenResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GenerateTokenResponse)
@@ -154,6 +223,35 @@ func (c *artifactServiceClient) GenerateToken(ctx context.Context, in *GenerateT
 	return out, nil
 }
 
+func (c *artifactServiceClient) RevokeToken(ctx context.Context, in *RevokeTokenRequest, opts ...grpc.CallOption) (*RevokeTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeTokenResponse)
+	err := c.cc.Invoke(ctx, ArtifactService_RevokeToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artifactServiceClient) CopyPath(ctx context.Context, in *CopyPathRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CopyPathResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ArtifactService_ServiceDesc.Streams[0], ArtifactService_CopyPath_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CopyPathRequest, CopyPathResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ArtifactService_CopyPathClient = grpc.ServerStreamingClient[CopyPathResponse]
+
 func (c *artifactServiceClient) Cleanup(ctx context.Context, in *CleanupRequest, opts ...grpc.CallOption) (*CleanupResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CleanupResponse)
@@ -214,6 +312,36 @@ func (c *artifactServiceClient) UpdateCORS(ctx context.Context, in *UpdateCORSRe
 	return out, nil
 }
 
+func (c *artifactServiceClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageResponse)
+	err := c.cc.Invoke(ctx, ArtifactService_GetUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artifactServiceClient) SetQuota(ctx context.Context, in *SetQuotaRequest, opts ...grpc.CallOption) (*SetQuotaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetQuotaResponse)
+	err := c.cc.Invoke(ctx, ArtifactService_SetQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artifactServiceClient) SetOrganization(ctx context.Context, in *SetOrganizationRequest, opts ...grpc.CallOption) (*SetOrganizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetOrganizationResponse)
+	err := c.cc.Invoke(ctx, ArtifactService_SetOrganization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ArtifactServiceServer is the server API for ArtifactService service.
 // All implementations should embed UnimplementedArtifactServiceServer
 // for forward compatibility.
@@ -227,11 +355,24 @@ type ArtifactServiceServer interface {
 	Destroy(context.Context, *DestroyRequest) (*DestroyResponse, error)
 	ListPath(context.Context, *ListPathRequest) (*ListPathResponse, error)
 	DeletePath(context.Context, *DeletePathRequest) (*DeletePathResponse, error)
+	// finds the files of a project pushed with all the given labels, ordered by path
+	SearchArtifacts(context.Context, *SearchArtifactsRequest) (*SearchArtifactsResponse, error)
 	UpdateRetentionPolicy(context.Context, *UpdateRetentionPolicyRequest) (*UpdateRetentionPolicyResponse, error)
+	// runs a retention policy against an artifact store, and returns what it would delete without deleting anything
+	PreviewRetentionPolicy(context.Context, *PreviewRetentionPolicyRequest) (*PreviewRetentionPolicyResponse, error)
+	// returns the reports of the last cleaning passes of an artifact store, newest first
+	ListRetentionReports(context.Context, *ListRetentionReportsRequest) (*ListRetentionReportsResponse, error)
+	// returns a cleaning pass report, with a page of the objects deleted in it
+	DescribeRetentionReport(context.Context, *DescribeRetentionReportRequest) (*DescribeRetentionReportResponse, error)
 	// Used to zebra to generate a short-lived JWT token, granting temporary access
 	// to a project/workflow/job artifacts for a newly created job.
 	// This is how jobs get access to the artifact API.
 	GenerateToken(context.Context, *GenerateTokenRequest) (*GenerateTokenResponse, error)
+	// Revokes a token before it expires, e.g. when it has leaked.
+	RevokeToken(context.Context, *RevokeTokenRequest) (*RevokeTokenResponse, error)
+	// Copies a file or a directory into another path, or into another artifact store of
+	// the same organization, without downloading it. The progress is streamed until everything is copied.
+	CopyPath(*CopyPathRequest, grpc.ServerStreamingServer[CopyPathResponse]) error
 	// if the cleanup result has errors, that does NOT mean it has been failed: it means that there were errors
 	Cleanup(context.Context, *CleanupRequest) (*CleanupResponse, error)
 	GetSignedURL(context.Context, *GetSignedURLRequest) (*GetSignedURLResponse, error)
@@ -243,6 +384,13 @@ type ArtifactServiceServer interface {
 	CountBuckets(context.Context, *CountBucketsRequest) (*CountBucketsResponse, error)
 	// updates CORS on the given bucket, and returns the next one ordered by created and bucket_name
 	UpdateCORS(context.Context, *UpdateCORSRequest) (*UpdateCORSResponse, error)
+	// returns the bytes stored for the projects, workflows and jobs of an artifact store, or of all the artifact stores of an organization
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error)
+	// sets the storage quota of an organization
+	SetQuota(context.Context, *SetQuotaRequest) (*SetQuotaResponse, error)
+	// sets the organization of an artifact store created before organizations were recorded,
+	// so its usage is counted towards the storage quota of the organization
+	SetOrganization(context.Context, *SetOrganizationRequest) (*SetOrganizationResponse, error)
 }
 
 // UnimplementedArtifactServiceServer should be embedded to have
@@ -270,12 +418,30 @@ func (UnimplementedArtifactServiceServer) ListPath(context.Context, *ListPathReq
 func (UnimplementedArtifactServiceServer) DeletePath(context.Context, *DeletePathRequest) (*DeletePathResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePath not implemented")
 }
+func (UnimplementedArtifactServiceServer) SearchArtifacts(context.Context, *SearchArtifactsRequest) (*SearchArtifactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchArtifacts not implemented")
+}
 func (UnimplementedArtifactServiceServer) UpdateRetentionPolicy(context.Context, *UpdateRetentionPolicyRequest) (*UpdateRetentionPolicyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateRetentionPolicy not implemented")
 }
+func (UnimplementedArtifactServiceServer) PreviewRetentionPolicy(context.Context, *PreviewRetentionPolicyRequest) (*PreviewRetentionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewRetentionPolicy not implemented")
+}
+func (UnimplementedArtifactServiceServer) ListRetentionReports(context.Context, *ListRetentionReportsRequest) (*ListRetentionReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRetentionReports not implemented")
+}
+func (UnimplementedArtifactServiceServer) DescribeRetentionReport(context.Context, *DescribeRetentionReportRequest) (*DescribeRetentionReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeRetentionReport not implemented")
+}
 func (UnimplementedArtifactServiceServer) GenerateToken(context.Context, *GenerateTokenRequest) (*GenerateTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateToken not implemented")
 }
+func (UnimplementedArtifactServiceServer) RevokeToken(context.Context, *RevokeTokenRequest) (*RevokeTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeToken not implemented")
+}
+func (UnimplementedArtifactServiceServer) CopyPath(*CopyPathRequest, grpc.ServerStreamingServer[CopyPathResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method CopyPath not implemented")
+}
 func (UnimplementedArtifactServiceServer) Cleanup(context.Context, *CleanupRequest) (*CleanupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Cleanup not implemented")
 }
@@ -294,6 +460,15 @@ func (UnimplementedArtifactServiceServer) CountBuckets(context.Context, *CountBu
 func (UnimplementedArtifactServiceServer) UpdateCORS(context.Context, *UpdateCORSRequest) (*UpdateCORSResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCORS not implemented")
 }
+func (UnimplementedArtifactServiceServer) GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (UnimplementedArtifactServiceServer) SetQuota(context.Context, *SetQuotaRequest) (*SetQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQuota not implemented")
+}
+func (UnimplementedArtifactServiceServer) SetOrganization(context.Context, *SetOrganizationRequest) (*SetOrganizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrganization not implemented")
+}
 func (UnimplementedArtifactServiceServer) testEmbeddedByValue() {}
 
 // UnsafeArtifactServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -422,6 +597,24 @@ func _ArtifactService_DeletePath_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArtifactService_SearchArtifacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchArtifactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtifactServiceServer).SearchArtifacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtifactService_SearchArtifacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtifactServiceServer).SearchArtifacts(ctx, req.(*SearchArtifactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArtifactService_UpdateRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateRetentionPolicyRequest)
 	if err := dec(in); err != nil {
@@ -440,6 +633,60 @@ func _ArtifactService_UpdateRetentionPolicy_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArtifactService_PreviewRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtifactServiceServer).PreviewRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtifactService_PreviewRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtifactServiceServer).PreviewRetentionPolicy(ctx, req.(*PreviewRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtifactService_ListRetentionReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRetentionReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtifactServiceServer).ListRetentionReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtifactService_ListRetentionReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtifactServiceServer).ListRetentionReports(ctx, req.(*ListRetentionReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtifactService_DescribeRetentionReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeRetentionReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtifactServiceServer).DescribeRetentionReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtifactService_DescribeRetentionReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtifactServiceServer).DescribeRetentionReport(ctx, req.(*DescribeRetentionReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArtifactService_GenerateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GenerateTokenRequest)
 	if err := dec(in); err != nil {
@@ -458,6 +705,35 @@ func _ArtifactService_GenerateToken_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArtifactService_RevokeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtifactServiceServer).RevokeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtifactService_RevokeToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtifactServiceServer).RevokeToken(ctx, req.(*RevokeTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtifactService_CopyPath_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CopyPathRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArtifactServiceServer).CopyPath(m, &grpc.GenericServerStream[CopyPathRequest, CopyPathResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ArtifactService_CopyPathServer = grpc.ServerStreamingServer[CopyPathResponse]
+
 func _ArtifactService_Cleanup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CleanupRequest)
 	if err := dec(in); err != nil {
@@ -566,6 +842,60 @@ func _ArtifactService_UpdateCORS_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArtifactService_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtifactServiceServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtifactService_GetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtifactServiceServer).GetUsage(ctx, req.(*GetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtifactService_SetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtifactServiceServer).SetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtifactService_SetQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtifactServiceServer).SetQuota(ctx, req.(*SetQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtifactService_SetOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtifactServiceServer).SetOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtifactService_SetOrganization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtifactServiceServer).SetOrganization(ctx, req.(*SetOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ArtifactService_ServiceDesc is the grpc.ServiceDesc for ArtifactService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -597,14 +927,34 @@ var ArtifactService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeletePath",
 			Handler:    _ArtifactService_DeletePath_Handler,
 		},
+		{
+			MethodName: "SearchArtifacts",
+			Handler:    _ArtifactService_SearchArtifacts_Handler,
+		},
 		{
 			MethodName: "UpdateRetentionPolicy",
 			Handler:    _ArtifactService_UpdateRetentionPolicy_Handler,
 		},
+		{
+			MethodName: "PreviewRetentionPolicy",
+			Handler:    _ArtifactService_PreviewRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "ListRetentionReports",
+			Handler:    _ArtifactService_ListRetentionReports_Handler,
+		},
+		{
+			MethodName: "DescribeRetentionReport",
+			Handler:    _ArtifactService_DescribeRetentionReport_Handler,
+		},
 		{
 			MethodName: "GenerateToken",
 			Handler:    _ArtifactService_GenerateToken_Handler,
 		},
+		{
+			MethodName: "RevokeToken",
+			Handler:    _ArtifactService_RevokeToken_Handler,
+		},
 		{
 			MethodName: "Cleanup",
 			Handler:    _ArtifactService_Cleanup_Handler,
@@ -629,7 +979,25 @@ var ArtifactService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCORS",
 			Handler:    _ArtifactService_UpdateCORS_Handler,
 		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _ArtifactService_GetUsage_Handler,
+		},
+		{
+			MethodName: "SetQuota",
+			Handler:    _ArtifactService_SetQuota_Handler,
+		},
+		{
+			MethodName: "SetOrganization",
+			Handler:    _ArtifactService_SetOrganization_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CopyPath",
+			Handler:       _ArtifactService_CopyPath_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "artifacthub.proto",
 }
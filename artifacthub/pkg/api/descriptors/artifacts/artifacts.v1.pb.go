@@ -128,12 +128,65 @@ func (SignedURL_Method) EnumDescriptor() ([]byte, []int) {
 	return file_artifacts_v1_proto_rawDescGZIP(), []int{2, 0}
 }
 
+type DownloadArchiveRequest_Format int32
+
+const (
+	DownloadArchiveRequest_ZIP    DownloadArchiveRequest_Format = 0
+	DownloadArchiveRequest_TAR_GZ DownloadArchiveRequest_Format = 1
+)
+
+// Enum value maps for DownloadArchiveRequest_Format.
+var (
+	DownloadArchiveRequest_Format_name = map[int32]string{
+		0: "ZIP",
+		1: "TAR_GZ",
+	}
+	DownloadArchiveRequest_Format_value = map[string]int32{
+		"ZIP":    0,
+		"TAR_GZ": 1,
+	}
+)
+
+func (x DownloadArchiveRequest_Format) Enum() *DownloadArchiveRequest_Format {
+	p := new(DownloadArchiveRequest_Format)
+	*p = x
+	return p
+}
+
+func (x DownloadArchiveRequest_Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DownloadArchiveRequest_Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_artifacts_v1_proto_enumTypes[2].Descriptor()
+}
+
+func (DownloadArchiveRequest_Format) Type() protoreflect.EnumType {
+	return &file_artifacts_v1_proto_enumTypes[2]
+}
+
+func (x DownloadArchiveRequest_Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DownloadArchiveRequest_Format.Descriptor instead.
+func (DownloadArchiveRequest_Format) EnumDescriptor() ([]byte, []int) {
+	return file_artifacts_v1_proto_rawDescGZIP(), []int{10, 0}
+}
+
 // Request for Generating signed URLs with the given type.
 // Contains a list of paths as the Google Cloud Storage destination or source based on the action type.
 type GenerateSignedURLsRequest struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Paths         []string                       `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
-	Type          GenerateSignedURLsRequest_Type `protobuf:"varint,2,opt,name=type,proto3,enum=semaphore.artifacts.v1.GenerateSignedURLsRequest_Type" json:"type,omitempty"`
+	state protoimpl.MessageState         `protogen:"open.v1"`
+	Paths []string                       `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
+	Type  GenerateSignedURLsRequest_Type `protobuf:"varint,2,opt,name=type,proto3,enum=semaphore.artifacts.v1.GenerateSignedURLsRequest_Type" json:"type,omitempty"`
+	// Optional hex encoded SHA-256 digests of the files being pushed, in the same order as the paths.
+	// An empty digest means the file is pushed without one. Only used for PUSH and PUSHFORCE.
+	// Files with a digest are stored once per digest, and their URLs point to the stored blob.
+	Digests []string `protobuf:"bytes,3,rep,name=digests,proto3" json:"digests,omitempty"`
+	// Optional labels recorded for the pushed files, e.g. release-candidate=true.
+	// Only used for PUSH and PUSHFORCE. Pushes without force keep the labels of existing files.
+	Labels        map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -182,6 +235,20 @@ func (x *GenerateSignedURLsRequest) GetType() GenerateSignedURLsRequest_Type {
 	return GenerateSignedURLsRequest_PUSH
 }
 
+func (x *GenerateSignedURLsRequest) GetDigests() []string {
+	if x != nil {
+		return x.Digests
+	}
+	return nil
+}
+
+func (x *GenerateSignedURLsRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
 // Response for GenerateSignedURLs
 // Contains a list of Signed URLs
 type GenerateSignedURLsResponse struct {
@@ -231,9 +298,18 @@ func (x *GenerateSignedURLsResponse) GetURLs() []*SignedURL {
 // Contains an URL and its method type
 // The path on the GCS can be parsed out of it, so it is not needed
 type SignedURL struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	URL           string                 `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
-	Method        SignedURL_Method       `protobuf:"varint,2,opt,name=method,proto3,enum=semaphore.artifacts.v1.SignedURL_Method" json:"method,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	URL    string                 `protobuf:"bytes,1,opt,name=URL,proto3" json:"URL,omitempty"`
+	Method SignedURL_Method       `protobuf:"varint,2,opt,name=method,proto3,enum=semaphore.artifacts.v1.SignedURL_Method" json:"method,omitempty"`
+	// Hex encoded SHA-256 digest of the object, if it was pushed with one.
+	// Clients should verify pulled content against it.
+	Digest string `protobuf:"bytes,3,opt,name=digest,proto3" json:"digest,omitempty"`
+	// Set on PUSH when content with the same digest is already stored.
+	// The URL is empty, and there is nothing to upload.
+	AlreadyPresent bool `protobuf:"varint,4,opt,name=already_present,json=alreadyPresent,proto3" json:"already_present,omitempty"`
+	// Path of the artifact. Only set when the URL points to a blob stored by digest,
+	// since the path can't be parsed out of the URL in that case.
+	Path          string `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -282,33 +358,750 @@ func (x *SignedURL) GetMethod() SignedURL_Method {
 	return SignedURL_DELETE
 }
 
+func (x *SignedURL) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *SignedURL) GetAlreadyPresent() bool {
+	if x != nil {
+		return x.AlreadyPresent
+	}
+	return false
+}
+
+func (x *SignedURL) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// Starts an upload of a single file, or resumes it if upload_id is set.
+// Resuming signs the part URLs again, and returns the parts that are already uploaded.
+type StartMultipartUploadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Path  string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Size of the file in bytes.
+	Size int64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// Overwrite the file if it exists.
+	Force bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	// Set to resume an upload started before.
+	UploadId string `protobuf:"bytes,4,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	// Optional labels recorded for the uploaded file, the same way as for GenerateSignedURLs.
+	Labels        map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartMultipartUploadRequest) Reset() {
+	*x = StartMultipartUploadRequest{}
+	mi := &file_artifacts_v1_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartMultipartUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMultipartUploadRequest) ProtoMessage() {}
+
+func (x *StartMultipartUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacts_v1_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartMultipartUploadRequest.ProtoReflect.Descriptor instead.
+func (*StartMultipartUploadRequest) Descriptor() ([]byte, []int) {
+	return file_artifacts_v1_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StartMultipartUploadRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *StartMultipartUploadRequest) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *StartMultipartUploadRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+func (x *StartMultipartUploadRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *StartMultipartUploadRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+type StartMultipartUploadResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UploadId string                 `protobuf:"bytes,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	// Size of every part, except the last one, in bytes.
+	PartSize int64 `protobuf:"varint,2,opt,name=part_size,json=partSize,proto3" json:"part_size,omitempty"`
+	// Signed PUT URLs for every part, in order. Set for S3.
+	Parts []*UploadPart `protobuf:"bytes,3,rep,name=parts,proto3" json:"parts,omitempty"`
+	// Resumable session URI, the file is uploaded to it in chunks. Set for GCS.
+	SessionUri    string `protobuf:"bytes,4,opt,name=session_uri,json=sessionUri,proto3" json:"session_uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartMultipartUploadResponse) Reset() {
+	*x = StartMultipartUploadResponse{}
+	mi := &file_artifacts_v1_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartMultipartUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartMultipartUploadResponse) ProtoMessage() {}
+
+func (x *StartMultipartUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacts_v1_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartMultipartUploadResponse.ProtoReflect.Descriptor instead.
+func (*StartMultipartUploadResponse) Descriptor() ([]byte, []int) {
+	return file_artifacts_v1_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StartMultipartUploadResponse) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *StartMultipartUploadResponse) GetPartSize() int64 {
+	if x != nil {
+		return x.PartSize
+	}
+	return 0
+}
+
+func (x *StartMultipartUploadResponse) GetParts() []*UploadPart {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *StartMultipartUploadResponse) GetSessionUri() string {
+	if x != nil {
+		return x.SessionUri
+	}
+	return ""
+}
+
+type UploadPart struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Part numbers start from 1.
+	Number int32 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// Signed PUT URL for the part. Empty if the part is already uploaded.
+	URL string `protobuf:"bytes,2,opt,name=URL,proto3" json:"URL,omitempty"`
+	// ETag returned by the storage when the part was uploaded.
+	// Set for parts that are already uploaded.
+	Etag          string `protobuf:"bytes,3,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadPart) Reset() {
+	*x = UploadPart{}
+	mi := &file_artifacts_v1_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadPart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadPart) ProtoMessage() {}
+
+func (x *UploadPart) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacts_v1_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadPart.ProtoReflect.Descriptor instead.
+func (*UploadPart) Descriptor() ([]byte, []int) {
+	return file_artifacts_v1_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UploadPart) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *UploadPart) GetURL() string {
+	if x != nil {
+		return x.URL
+	}
+	return ""
+}
+
+func (x *UploadPart) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type CompleteMultipartUploadRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Path     string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	UploadId string                 `protobuf:"bytes,2,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	// Uploaded parts with their ETags. Not needed for GCS, where the session completes with the last chunk.
+	Parts         []*UploadPart `protobuf:"bytes,3,rep,name=parts,proto3" json:"parts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteMultipartUploadRequest) Reset() {
+	*x = CompleteMultipartUploadRequest{}
+	mi := &file_artifacts_v1_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteMultipartUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteMultipartUploadRequest) ProtoMessage() {}
+
+func (x *CompleteMultipartUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacts_v1_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteMultipartUploadRequest.ProtoReflect.Descriptor instead.
+func (*CompleteMultipartUploadRequest) Descriptor() ([]byte, []int) {
+	return file_artifacts_v1_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CompleteMultipartUploadRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CompleteMultipartUploadRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *CompleteMultipartUploadRequest) GetParts() []*UploadPart {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+type CompleteMultipartUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompleteMultipartUploadResponse) Reset() {
+	*x = CompleteMultipartUploadResponse{}
+	mi := &file_artifacts_v1_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteMultipartUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteMultipartUploadResponse) ProtoMessage() {}
+
+func (x *CompleteMultipartUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacts_v1_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteMultipartUploadResponse.ProtoReflect.Descriptor instead.
+func (*CompleteMultipartUploadResponse) Descriptor() ([]byte, []int) {
+	return file_artifacts_v1_proto_rawDescGZIP(), []int{7}
+}
+
+type AbortMultipartUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          string                 `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	UploadId      string                 `protobuf:"bytes,2,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbortMultipartUploadRequest) Reset() {
+	*x = AbortMultipartUploadRequest{}
+	mi := &file_artifacts_v1_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbortMultipartUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortMultipartUploadRequest) ProtoMessage() {}
+
+func (x *AbortMultipartUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacts_v1_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortMultipartUploadRequest.ProtoReflect.Descriptor instead.
+func (*AbortMultipartUploadRequest) Descriptor() ([]byte, []int) {
+	return file_artifacts_v1_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AbortMultipartUploadRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *AbortMultipartUploadRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+type AbortMultipartUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbortMultipartUploadResponse) Reset() {
+	*x = AbortMultipartUploadResponse{}
+	mi := &file_artifacts_v1_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbortMultipartUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbortMultipartUploadResponse) ProtoMessage() {}
+
+func (x *AbortMultipartUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacts_v1_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbortMultipartUploadResponse.ProtoReflect.Descriptor instead.
+func (*AbortMultipartUploadResponse) Descriptor() ([]byte, []int) {
+	return file_artifacts_v1_proto_rawDescGZIP(), []int{9}
+}
+
+type DownloadArchiveRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Directory to archive. Files in the archive are named relative to it.
+	Path          string                        `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Format        DownloadArchiveRequest_Format `protobuf:"varint,2,opt,name=format,proto3,enum=semaphore.artifacts.v1.DownloadArchiveRequest_Format" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadArchiveRequest) Reset() {
+	*x = DownloadArchiveRequest{}
+	mi := &file_artifacts_v1_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadArchiveRequest) ProtoMessage() {}
+
+func (x *DownloadArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacts_v1_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadArchiveRequest.ProtoReflect.Descriptor instead.
+func (*DownloadArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_artifacts_v1_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DownloadArchiveRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DownloadArchiveRequest) GetFormat() DownloadArchiveRequest_Format {
+	if x != nil {
+		return x.Format
+	}
+	return DownloadArchiveRequest_ZIP
+}
+
+// A chunk of the archive. Chunks are sent in order, and put together they make up the archive.
+type DownloadArchiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadArchiveResponse) Reset() {
+	*x = DownloadArchiveResponse{}
+	mi := &file_artifacts_v1_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadArchiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadArchiveResponse) ProtoMessage() {}
+
+func (x *DownloadArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacts_v1_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadArchiveResponse.ProtoReflect.Descriptor instead.
+func (*DownloadArchiveResponse) Descriptor() ([]byte, []int) {
+	return file_artifacts_v1_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DownloadArchiveResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// A file is copied to the destination path, or into it, if it ends with a slash.
+// Files in a directory are copied into the destination directory.
+// The token needs to allow pulling the source, and pushing to the destination.
+type CopyPathRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	SourcePath      string                 `protobuf:"bytes,1,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
+	DestinationPath string                 `protobuf:"bytes,2,opt,name=destination_path,json=destinationPath,proto3" json:"destination_path,omitempty"`
+	// Overwrites the destination if it exists.
+	Force         bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyPathRequest) Reset() {
+	*x = CopyPathRequest{}
+	mi := &file_artifacts_v1_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyPathRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyPathRequest) ProtoMessage() {}
+
+func (x *CopyPathRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacts_v1_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyPathRequest.ProtoReflect.Descriptor instead.
+func (*CopyPathRequest) Descriptor() ([]byte, []int) {
+	return file_artifacts_v1_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CopyPathRequest) GetSourcePath() string {
+	if x != nil {
+		return x.SourcePath
+	}
+	return ""
+}
+
+func (x *CopyPathRequest) GetDestinationPath() string {
+	if x != nil {
+		return x.DestinationPath
+	}
+	return ""
+}
+
+func (x *CopyPathRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type CopyPathResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CopiedCount   int32                  `protobuf:"varint,1,opt,name=copied_count,json=copiedCount,proto3" json:"copied_count,omitempty"`
+	CopiedSize    int64                  `protobuf:"varint,2,opt,name=copied_size,json=copiedSize,proto3" json:"copied_size,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,3,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	TotalSize     int64                  `protobuf:"varint,4,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CopyPathResponse) Reset() {
+	*x = CopyPathResponse{}
+	mi := &file_artifacts_v1_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CopyPathResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CopyPathResponse) ProtoMessage() {}
+
+func (x *CopyPathResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_artifacts_v1_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CopyPathResponse.ProtoReflect.Descriptor instead.
+func (*CopyPathResponse) Descriptor() ([]byte, []int) {
+	return file_artifacts_v1_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CopyPathResponse) GetCopiedCount() int32 {
+	if x != nil {
+		return x.CopiedCount
+	}
+	return 0
+}
+
+func (x *CopyPathResponse) GetCopiedSize() int64 {
+	if x != nil {
+		return x.CopiedSize
+	}
+	return 0
+}
+
+func (x *CopyPathResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *CopyPathResponse) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
 var File_artifacts_v1_proto protoreflect.FileDescriptor
 
 const file_artifacts_v1_proto_rawDesc = "" +
 	"\n" +
-	"\x12artifacts.v1.proto\x12\x16semaphore.artifacts.v1\"\xb2\x01\n" +
+	"\x12artifacts.v1.proto\x12\x16semaphore.artifacts.v1\"\xde\x02\n" +
 	"\x19GenerateSignedURLsRequest\x12\x14\n" +
 	"\x05paths\x18\x01 \x03(\tR\x05paths\x12J\n" +
-	"\x04type\x18\x02 \x01(\x0e26.semaphore.artifacts.v1.GenerateSignedURLsRequest.TypeR\x04type\"3\n" +
+	"\x04type\x18\x02 \x01(\x0e26.semaphore.artifacts.v1.GenerateSignedURLsRequest.TypeR\x04type\x12\x18\n" +
+	"\adigests\x18\x03 \x03(\tR\adigests\x12U\n" +
+	"\x06labels\x18\x04 \x03(\v2=.semaphore.artifacts.v1.GenerateSignedURLsRequest.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"3\n" +
 	"\x04Type\x12\b\n" +
 	"\x04PUSH\x10\x00\x12\r\n" +
 	"\tPUSHFORCE\x10\x01\x12\b\n" +
 	"\x04PULL\x10\x02\x12\b\n" +
 	"\x04YANK\x10\x03\"S\n" +
 	"\x1aGenerateSignedURLsResponse\x125\n" +
-	"\x04URLs\x18\x01 \x03(\v2!.semaphore.artifacts.v1.SignedURLR\x04URLs\"\x9b\x01\n" +
+	"\x04URLs\x18\x01 \x03(\v2!.semaphore.artifacts.v1.SignedURLR\x04URLs\"\xf0\x01\n" +
 	"\tSignedURL\x12\x10\n" +
 	"\x03URL\x18\x01 \x01(\tR\x03URL\x12@\n" +
-	"\x06method\x18\x02 \x01(\x0e2(.semaphore.artifacts.v1.SignedURL.MethodR\x06method\":\n" +
+	"\x06method\x18\x02 \x01(\x0e2(.semaphore.artifacts.v1.SignedURL.MethodR\x06method\x12\x16\n" +
+	"\x06digest\x18\x03 \x01(\tR\x06digest\x12'\n" +
+	"\x0falready_present\x18\x04 \x01(\bR\x0ealreadyPresent\x12\x12\n" +
+	"\x04path\x18\x05 \x01(\tR\x04path\":\n" +
 	"\x06Method\x12\n" +
 	"\n" +
 	"\x06DELETE\x10\x00\x12\a\n" +
 	"\x03GET\x10\x01\x12\b\n" +
 	"\x04HEAD\x10\x02\x12\a\n" +
 	"\x03PUT\x10\x03\x12\b\n" +
-	"\x04POST\x10\x042\x8f\x01\n" +
+	"\x04POST\x10\x04\"\x8c\x02\n" +
+	"\x1bStartMultipartUploadRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\x12\x1b\n" +
+	"\tupload_id\x18\x04 \x01(\tR\buploadId\x12W\n" +
+	"\x06labels\x18\x05 \x03(\v2?.semaphore.artifacts.v1.StartMultipartUploadRequest.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb3\x01\n" +
+	"\x1cStartMultipartUploadResponse\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\x12\x1b\n" +
+	"\tpart_size\x18\x02 \x01(\x03R\bpartSize\x128\n" +
+	"\x05parts\x18\x03 \x03(\v2\".semaphore.artifacts.v1.UploadPartR\x05parts\x12\x1f\n" +
+	"\vsession_uri\x18\x04 \x01(\tR\n" +
+	"sessionUri\"J\n" +
+	"\n" +
+	"UploadPart\x12\x16\n" +
+	"\x06number\x18\x01 \x01(\x05R\x06number\x12\x10\n" +
+	"\x03URL\x18\x02 \x01(\tR\x03URL\x12\x12\n" +
+	"\x04etag\x18\x03 \x01(\tR\x04etag\"\x8b\x01\n" +
+	"\x1eCompleteMultipartUploadRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1b\n" +
+	"\tupload_id\x18\x02 \x01(\tR\buploadId\x128\n" +
+	"\x05parts\x18\x03 \x03(\v2\".semaphore.artifacts.v1.UploadPartR\x05parts\"!\n" +
+	"\x1fCompleteMultipartUploadResponse\"N\n" +
+	"\x1bAbortMultipartUploadRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1b\n" +
+	"\tupload_id\x18\x02 \x01(\tR\buploadId\"\x1e\n" +
+	"\x1cAbortMultipartUploadResponse\"\x9a\x01\n" +
+	"\x16DownloadArchiveRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12M\n" +
+	"\x06format\x18\x02 \x01(\x0e25.semaphore.artifacts.v1.DownloadArchiveRequest.FormatR\x06format\"\x1d\n" +
+	"\x06Format\x12\a\n" +
+	"\x03ZIP\x10\x00\x12\n" +
+	"\n" +
+	"\x06TAR_GZ\x10\x01\"-\n" +
+	"\x17DownloadArchiveResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"s\n" +
+	"\x0fCopyPathRequest\x12\x1f\n" +
+	"\vsource_path\x18\x01 \x01(\tR\n" +
+	"sourcePath\x12)\n" +
+	"\x10destination_path\x18\x02 \x01(\tR\x0fdestinationPath\x12\x14\n" +
+	"\x05force\x18\x03 \x01(\bR\x05force\"\x96\x01\n" +
+	"\x10CopyPathResponse\x12!\n" +
+	"\fcopied_count\x18\x01 \x01(\x05R\vcopiedCount\x12\x1f\n" +
+	"\vcopied_size\x18\x02 \x01(\x03R\n" +
+	"copiedSize\x12\x1f\n" +
+	"\vtotal_count\x18\x03 \x01(\x05R\n" +
+	"totalCount\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x04 \x01(\x03R\ttotalSize2\xfb\x05\n" +
 	"\x10ArtifactsService\x12{\n" +
-	"\x12GenerateSignedURLs\x121.semaphore.artifacts.v1.GenerateSignedURLsRequest\x1a2.semaphore.artifacts.v1.GenerateSignedURLsResponseBLZJgithub.com/semaphoreio/semaphore/artifacthub/pkg/api/descriptors/artifactsb\x06proto3"
+	"\x12GenerateSignedURLs\x121.semaphore.artifacts.v1.GenerateSignedURLsRequest\x1a2.semaphore.artifacts.v1.GenerateSignedURLsResponse\x12\x81\x01\n" +
+	"\x14StartMultipartUpload\x123.semaphore.artifacts.v1.StartMultipartUploadRequest\x1a4.semaphore.artifacts.v1.StartMultipartUploadResponse\x12\x8a\x01\n" +
+	"\x17CompleteMultipartUpload\x126.semaphore.artifacts.v1.CompleteMultipartUploadRequest\x1a7.semaphore.artifacts.v1.CompleteMultipartUploadResponse\x12\x81\x01\n" +
+	"\x14AbortMultipartUpload\x123.semaphore.artifacts.v1.AbortMultipartUploadRequest\x1a4.semaphore.artifacts.v1.AbortMultipartUploadResponse\x12t\n" +
+	"\x0fDownloadArchive\x12..semaphore.artifacts.v1.DownloadArchiveRequest\x1a/.semaphore.artifacts.v1.DownloadArchiveResponse0\x01\x12_\n" +
+	"\bCopyPath\x12'.semaphore.artifacts.v1.CopyPathRequest\x1a(.semaphore.artifacts.v1.CopyPathResponse0\x01BLZJgithub.com/semaphoreio/semaphore/artifacthub/pkg/api/descriptors/artifactsb\x06proto3"
 
 var (
 	file_artifacts_v1_proto_rawDescOnce sync.Once
@@ -322,26 +1115,55 @@ func file_artifacts_v1_proto_rawDescGZIP() []byte {
 	return file_artifacts_v1_proto_rawDescData
 }
 
-var file_artifacts_v1_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_artifacts_v1_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_artifacts_v1_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_artifacts_v1_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_artifacts_v1_proto_goTypes = []any{
-	(GenerateSignedURLsRequest_Type)(0), // 0: semaphore.artifacts.v1.GenerateSignedURLsRequest.Type
-	(SignedURL_Method)(0),               // 1: semaphore.artifacts.v1.SignedURL.Method
-	(*GenerateSignedURLsRequest)(nil),   // 2: semaphore.artifacts.v1.GenerateSignedURLsRequest
-	(*GenerateSignedURLsResponse)(nil),  // 3: semaphore.artifacts.v1.GenerateSignedURLsResponse
-	(*SignedURL)(nil),                   // 4: semaphore.artifacts.v1.SignedURL
+	(GenerateSignedURLsRequest_Type)(0),     // 0: semaphore.artifacts.v1.GenerateSignedURLsRequest.Type
+	(SignedURL_Method)(0),                   // 1: semaphore.artifacts.v1.SignedURL.Method
+	(DownloadArchiveRequest_Format)(0),      // 2: semaphore.artifacts.v1.DownloadArchiveRequest.Format
+	(*GenerateSignedURLsRequest)(nil),       // 3: semaphore.artifacts.v1.GenerateSignedURLsRequest
+	(*GenerateSignedURLsResponse)(nil),      // 4: semaphore.artifacts.v1.GenerateSignedURLsResponse
+	(*SignedURL)(nil),                       // 5: semaphore.artifacts.v1.SignedURL
+	(*StartMultipartUploadRequest)(nil),     // 6: semaphore.artifacts.v1.StartMultipartUploadRequest
+	(*StartMultipartUploadResponse)(nil),    // 7: semaphore.artifacts.v1.StartMultipartUploadResponse
+	(*UploadPart)(nil),                      // 8: semaphore.artifacts.v1.UploadPart
+	(*CompleteMultipartUploadRequest)(nil),  // 9: semaphore.artifacts.v1.CompleteMultipartUploadRequest
+	(*CompleteMultipartUploadResponse)(nil), // 10: semaphore.artifacts.v1.CompleteMultipartUploadResponse
+	(*AbortMultipartUploadRequest)(nil),     // 11: semaphore.artifacts.v1.AbortMultipartUploadRequest
+	(*AbortMultipartUploadResponse)(nil),    // 12: semaphore.artifacts.v1.AbortMultipartUploadResponse
+	(*DownloadArchiveRequest)(nil),          // 13: semaphore.artifacts.v1.DownloadArchiveRequest
+	(*DownloadArchiveResponse)(nil),         // 14: semaphore.artifacts.v1.DownloadArchiveResponse
+	(*CopyPathRequest)(nil),                 // 15: semaphore.artifacts.v1.CopyPathRequest
+	(*CopyPathResponse)(nil),                // 16: semaphore.artifacts.v1.CopyPathResponse
+	nil,                                     // 17: semaphore.artifacts.v1.GenerateSignedURLsRequest.LabelsEntry
+	nil,                                     // 18: semaphore.artifacts.v1.StartMultipartUploadRequest.LabelsEntry
 }
 var file_artifacts_v1_proto_depIdxs = []int32{
-	0, // 0: semaphore.artifacts.v1.GenerateSignedURLsRequest.type:type_name -> semaphore.artifacts.v1.GenerateSignedURLsRequest.Type
-	4, // 1: semaphore.artifacts.v1.GenerateSignedURLsResponse.URLs:type_name -> semaphore.artifacts.v1.SignedURL
-	1, // 2: semaphore.artifacts.v1.SignedURL.method:type_name -> semaphore.artifacts.v1.SignedURL.Method
-	2, // 3: semaphore.artifacts.v1.ArtifactsService.GenerateSignedURLs:input_type -> semaphore.artifacts.v1.GenerateSignedURLsRequest
-	3, // 4: semaphore.artifacts.v1.ArtifactsService.GenerateSignedURLs:output_type -> semaphore.artifacts.v1.GenerateSignedURLsResponse
-	4, // [4:5] is the sub-list for method output_type
-	3, // [3:4] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	0,  // 0: semaphore.artifacts.v1.GenerateSignedURLsRequest.type:type_name -> semaphore.artifacts.v1.GenerateSignedURLsRequest.Type
+	17, // 1: semaphore.artifacts.v1.GenerateSignedURLsRequest.labels:type_name -> semaphore.artifacts.v1.GenerateSignedURLsRequest.LabelsEntry
+	5,  // 2: semaphore.artifacts.v1.GenerateSignedURLsResponse.URLs:type_name -> semaphore.artifacts.v1.SignedURL
+	1,  // 3: semaphore.artifacts.v1.SignedURL.method:type_name -> semaphore.artifacts.v1.SignedURL.Method
+	18, // 4: semaphore.artifacts.v1.StartMultipartUploadRequest.labels:type_name -> semaphore.artifacts.v1.StartMultipartUploadRequest.LabelsEntry
+	8,  // 5: semaphore.artifacts.v1.StartMultipartUploadResponse.parts:type_name -> semaphore.artifacts.v1.UploadPart
+	8,  // 6: semaphore.artifacts.v1.CompleteMultipartUploadRequest.parts:type_name -> semaphore.artifacts.v1.UploadPart
+	2,  // 7: semaphore.artifacts.v1.DownloadArchiveRequest.format:type_name -> semaphore.artifacts.v1.DownloadArchiveRequest.Format
+	3,  // 8: semaphore.artifacts.v1.ArtifactsService.GenerateSignedURLs:input_type -> semaphore.artifacts.v1.GenerateSignedURLsRequest
+	6,  // 9: semaphore.artifacts.v1.ArtifactsService.StartMultipartUpload:input_type -> semaphore.artifacts.v1.StartMultipartUploadRequest
+	9,  // 10: semaphore.artifacts.v1.ArtifactsService.CompleteMultipartUpload:input_type -> semaphore.artifacts.v1.CompleteMultipartUploadRequest
+	11, // 11: semaphore.artifacts.v1.ArtifactsService.AbortMultipartUpload:input_type -> semaphore.artifacts.v1.AbortMultipartUploadRequest
+	13, // 12: semaphore.artifacts.v1.ArtifactsService.DownloadArchive:input_type -> semaphore.artifacts.v1.DownloadArchiveRequest
+	15, // 13: semaphore.artifacts.v1.ArtifactsService.CopyPath:input_type -> semaphore.artifacts.v1.CopyPathRequest
+	4,  // 14: semaphore.artifacts.v1.ArtifactsService.GenerateSignedURLs:output_type -> semaphore.artifacts.v1.GenerateSignedURLsResponse
+	7,  // 15: semaphore.artifacts.v1.ArtifactsService.StartMultipartUpload:output_type -> semaphore.artifacts.v1.StartMultipartUploadResponse
+	10, // 16: semaphore.artifacts.v1.ArtifactsService.CompleteMultipartUpload:output_type -> semaphore.artifacts.v1.CompleteMultipartUploadResponse
+	12, // 17: semaphore.artifacts.v1.ArtifactsService.AbortMultipartUpload:output_type -> semaphore.artifacts.v1.AbortMultipartUploadResponse
+	14, // 18: semaphore.artifacts.v1.ArtifactsService.DownloadArchive:output_type -> semaphore.artifacts.v1.DownloadArchiveResponse
+	16, // 19: semaphore.artifacts.v1.ArtifactsService.CopyPath:output_type -> semaphore.artifacts.v1.CopyPathResponse
+	14, // [14:20] is the sub-list for method output_type
+	8,  // [8:14] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_artifacts_v1_proto_init() }
@@ -354,8 +1176,8 @@ func file_artifacts_v1_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_artifacts_v1_proto_rawDesc), len(file_artifacts_v1_proto_rawDesc)),
-			NumEnums:      2,
-			NumMessages:   3,
+			NumEnums:      3,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,7 +19,12 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ArtifactsService_GenerateSignedURLs_FullMethodName = "/semaphore.artifacts.v1.ArtifactsService/GenerateSignedURLs"
+	ArtifactsService_GenerateSignedURLs_FullMethodName      = "/semaphore.artifacts.v1.ArtifactsService/GenerateSignedURLs"
+	ArtifactsService_StartMultipartUpload_FullMethodName    = "/semaphore.artifacts.v1.ArtifactsService/StartMultipartUpload"
+	ArtifactsService_CompleteMultipartUpload_FullMethodName = "/semaphore.artifacts.v1.ArtifactsService/CompleteMultipartUpload"
+	ArtifactsService_AbortMultipartUpload_FullMethodName    = "/semaphore.artifacts.v1.ArtifactsService/AbortMultipartUpload"
+	ArtifactsService_DownloadArchive_FullMethodName         = "/semaphore.artifacts.v1.ArtifactsService/DownloadArchive"
+	ArtifactsService_CopyPath_FullMethodName                = "/semaphore.artifacts.v1.ArtifactsService/CopyPath"
 )
 
 // ArtifactsServiceClient is the client API for ArtifactsService service.
@@ -29,6 +34,16 @@ const (
 // all operations are synchronous
 type ArtifactsServiceClient interface {
 	GenerateSignedURLs(ctx context.Context, in *GenerateSignedURLsRequest, opts ...grpc.CallOption) (*GenerateSignedURLsResponse, error)
+	// Large files are uploaded in parts with S3, or through a resumable session with GCS,
+	// so failed uploads can continue where they stopped.
+	StartMultipartUpload(ctx context.Context, in *StartMultipartUploadRequest, opts ...grpc.CallOption) (*StartMultipartUploadResponse, error)
+	CompleteMultipartUpload(ctx context.Context, in *CompleteMultipartUploadRequest, opts ...grpc.CallOption) (*CompleteMultipartUploadResponse, error)
+	AbortMultipartUpload(ctx context.Context, in *AbortMultipartUploadRequest, opts ...grpc.CallOption) (*AbortMultipartUploadResponse, error)
+	// Streams all the files in a directory as a single archive.
+	DownloadArchive(ctx context.Context, in *DownloadArchiveRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadArchiveResponse], error)
+	// Copies a file or a directory inside of the artifact store, e.g. from a workflow to its project,
+	// without downloading it. The progress of the copy is streamed until everything is copied.
+	CopyPath(ctx context.Context, in *CopyPathRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CopyPathResponse], error)
 }
 
 type artifactsServiceClient struct {
@@ -49,6 +64,74 @@ func (c *artifactsServiceClient) GenerateSignedURLs(ctx context.Context, in *Gen
 	return out, nil
 }
 
+func (c *artifactsServiceClient) StartMultipartUpload(ctx context.Context, in *StartMultipartUploadRequest, opts ...grpc.CallOption) (*StartMultipartUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartMultipartUploadResponse)
+	err := c.cc.Invoke(ctx, ArtifactsService_StartMultipartUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artifactsServiceClient) CompleteMultipartUpload(ctx context.Context, in *CompleteMultipartUploadRequest, opts ...grpc.CallOption) (*CompleteMultipartUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteMultipartUploadResponse)
+	err := c.cc.Invoke(ctx, ArtifactsService_CompleteMultipartUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artifactsServiceClient) AbortMultipartUpload(ctx context.Context, in *AbortMultipartUploadRequest, opts ...grpc.CallOption) (*AbortMultipartUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AbortMultipartUploadResponse)
+	err := c.cc.Invoke(ctx, ArtifactsService_AbortMultipartUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artifactsServiceClient) DownloadArchive(ctx context.Context, in *DownloadArchiveRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadArchiveResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ArtifactsService_ServiceDesc.Streams[0], ArtifactsService_DownloadArchive_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadArchiveRequest, DownloadArchiveResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ArtifactsService_DownloadArchiveClient = grpc.ServerStreamingClient[DownloadArchiveResponse]
+
+func (c *artifactsServiceClient) CopyPath(ctx context.Context, in *CopyPathRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[CopyPathResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ArtifactsService_ServiceDesc.Streams[1], ArtifactsService_CopyPath_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[CopyPathRequest, CopyPathResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ArtifactsService_CopyPathClient = grpc.ServerStreamingClient[CopyPathResponse]
+
 // ArtifactsServiceServer is the server API for ArtifactsService service.
 // All implementations should embed UnimplementedArtifactsServiceServer
 // for forward compatibility.
@@ -56,6 +139,16 @@ func (c *artifactsServiceClient) GenerateSignedURLs(ctx context.Context, in *Gen
 // all operations are synchronous
 type ArtifactsServiceServer interface {
 	GenerateSignedURLs(context.Context, *GenerateSignedURLsRequest) (*GenerateSignedURLsResponse, error)
+	// Large files are uploaded in parts with S3, or through a resumable session with GCS,
+	// so failed uploads can continue where they stopped.
+	StartMultipartUpload(context.Context, *StartMultipartUploadRequest) (*StartMultipartUploadResponse, error)
+	CompleteMultipartUpload(context.Context, *CompleteMultipartUploadRequest) (*CompleteMultipartUploadResponse, error)
+	AbortMultipartUpload(context.Context, *AbortMultipartUploadRequest) (*AbortMultipartUploadResponse, error)
+	// Streams all the files in a directory as a single archive.
+	DownloadArchive(*DownloadArchiveRequest, grpc.ServerStreamingServer[DownloadArchiveResponse]) error
+	// Copies a file or a directory inside of the artifact store, e.g. from a workflow to its project,
+	// without downloading it. The progress of the copy is streamed until everything is copied.
+	CopyPath(*CopyPathRequest, grpc.ServerStreamingServer[CopyPathResponse]) error
 }
 
 // UnimplementedArtifactsServiceServer should be embedded to have
@@ -68,6 +161,21 @@ type UnimplementedArtifactsServiceServer struct{}
 func (UnimplementedArtifactsServiceServer) GenerateSignedURLs(context.Context, *GenerateSignedURLsRequest) (*GenerateSignedURLsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateSignedURLs not implemented")
 }
+func (UnimplementedArtifactsServiceServer) StartMultipartUpload(context.Context, *StartMultipartUploadRequest) (*StartMultipartUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartMultipartUpload not implemented")
+}
+func (UnimplementedArtifactsServiceServer) CompleteMultipartUpload(context.Context, *CompleteMultipartUploadRequest) (*CompleteMultipartUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteMultipartUpload not implemented")
+}
+func (UnimplementedArtifactsServiceServer) AbortMultipartUpload(context.Context, *AbortMultipartUploadRequest) (*AbortMultipartUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortMultipartUpload not implemented")
+}
+func (UnimplementedArtifactsServiceServer) DownloadArchive(*DownloadArchiveRequest, grpc.ServerStreamingServer[DownloadArchiveResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadArchive not implemented")
+}
+func (UnimplementedArtifactsServiceServer) CopyPath(*CopyPathRequest, grpc.ServerStreamingServer[CopyPathResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method CopyPath not implemented")
+}
 func (UnimplementedArtifactsServiceServer) testEmbeddedByValue() {}
 
 // UnsafeArtifactsServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -106,6 +214,82 @@ func _ArtifactsService_GenerateSignedURLs_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArtifactsService_StartMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartMultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtifactsServiceServer).StartMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtifactsService_StartMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtifactsServiceServer).StartMultipartUpload(ctx, req.(*StartMultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtifactsService_CompleteMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteMultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtifactsServiceServer).CompleteMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtifactsService_CompleteMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtifactsServiceServer).CompleteMultipartUpload(ctx, req.(*CompleteMultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtifactsService_AbortMultipartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortMultipartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtifactsServiceServer).AbortMultipartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ArtifactsService_AbortMultipartUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtifactsServiceServer).AbortMultipartUpload(ctx, req.(*AbortMultipartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtifactsService_DownloadArchive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadArchiveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArtifactsServiceServer).DownloadArchive(m, &grpc.GenericServerStream[DownloadArchiveRequest, DownloadArchiveResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ArtifactsService_DownloadArchiveServer = grpc.ServerStreamingServer[DownloadArchiveResponse]
+
+func _ArtifactsService_CopyPath_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CopyPathRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ArtifactsServiceServer).CopyPath(m, &grpc.GenericServerStream[CopyPathRequest, CopyPathResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ArtifactsService_CopyPathServer = grpc.ServerStreamingServer[CopyPathResponse]
+
 // ArtifactsService_ServiceDesc is the grpc.ServiceDesc for ArtifactsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -117,7 +301,30 @@ var ArtifactsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateSignedURLs",
 			Handler:    _ArtifactsService_GenerateSignedURLs_Handler,
 		},
+		{
+			MethodName: "StartMultipartUpload",
+			Handler:    _ArtifactsService_StartMultipartUpload_Handler,
+		},
+		{
+			MethodName: "CompleteMultipartUpload",
+			Handler:    _ArtifactsService_CompleteMultipartUpload_Handler,
+		},
+		{
+			MethodName: "AbortMultipartUpload",
+			Handler:    _ArtifactsService_AbortMultipartUpload_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DownloadArchive",
+			Handler:       _ArtifactsService_DownloadArchive_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CopyPath",
+			Handler:       _ArtifactsService_CopyPath_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "artifacts.v1.proto",
 }
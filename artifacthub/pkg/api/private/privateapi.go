@@ -2,26 +2,44 @@ package privateapi
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
 	"time"
 
+	uuid "github.com/satori/go.uuid"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/api/descriptors/artifacthub"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/db"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/retention"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/storage"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/transfer"
 	ctxutil "github.com/semaphoreio/semaphore/artifacthub/pkg/util/context"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/util/log"
 	pathutil "github.com/semaphoreio/semaphore/artifacthub/pkg/util/path"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/util/retry"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
 	"gorm.io/gorm"
 )
 
 // CreateArtifact creates a new artifact with a bucket, service account. If the same idempotency token
 // has already entered to the database, it returns that row instead of creating a new one.
-func CreateArtifact(ctx context.Context, client storage.Client, idempotencyToken string) (*models.Artifact, error) {
+// Artifacts created before organizations were recorded get the organization set on the next call.
+func CreateArtifact(ctx context.Context, client storage.Client, idempotencyToken, orgID string) (*models.Artifact, error) {
+	var org *uuid.UUID
+	if orgID != "" {
+		id, err := uuid.FromString(orgID)
+		if err != nil {
+			return nil, log.ErrorCode(codes.InvalidArgument, "organization ID is malformed", nil)
+		}
+
+		org = &id
+	}
+
 	a, err := models.FindArtifactByIdempotencyToken(idempotencyToken)
 	if err == nil { // created already
-		return a, nil
+		return a, setArtifactOrgID(a, org)
 	}
 
 	bucketName, err := client.CreateBucket(ctx)
@@ -31,7 +49,51 @@ func CreateArtifact(ctx context.Context, client storage.Client, idempotencyToken
 		return nil, err
 	}
 
-	return models.CreateArtifact(bucketName, idempotencyToken)
+	a, err = models.CreateArtifact(bucketName, idempotencyToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return a, setArtifactOrgID(a, org)
+}
+
+func setArtifactOrgID(a *models.Artifact, orgID *uuid.UUID) error {
+	if orgID == nil || a.OrgID != nil {
+		return nil
+	}
+
+	if err := a.UpdateOrgID(*orgID); err != nil {
+		return err
+	}
+
+	a.OrgID = orgID
+	return nil
+}
+
+// SetArtifactOrganization sets the organization of an artifact created before organizations were recorded,
+// so its usage is counted towards the storage quota of the organization.
+func SetArtifactOrganization(artifactID, orgID string) error {
+	id, err := uuid.FromString(artifactID)
+	if err != nil {
+		return log.ErrorCode(codes.InvalidArgument, "artifact bucket ID is malformed", nil)
+	}
+
+	org, err := uuid.FromString(orgID)
+	if err != nil {
+		return log.ErrorCode(codes.InvalidArgument, "organization ID is malformed", nil)
+	}
+
+	a, err := models.FindArtifactByID(id.String())
+	if err != nil {
+		return err
+	}
+
+	err = a.AssignOrgID(org)
+	if errors.Is(err, models.ErrArtifactOfAnotherOrganization) {
+		return log.ErrorCode(codes.FailedPrecondition, err.Error(), nil)
+	}
+
+	return err
 }
 
 // DestroyArtifact destroys an artifact by it's id and everything connected to it.
@@ -78,11 +140,29 @@ func DeleteTransferPath(ctx context.Context, client storage.Client, artifact *mo
 	})
 
 	err := bucket.DeletePath(ctx, path)
-	if err == nil {
-		log.Debug("deleted", zap.String("path", path))
+	if err != nil {
+		return err
 	}
 
-	return err
+	log.Debug("deleted", zap.String("path", path))
+
+	// The path can be a file or a directory, so both are released.
+	err = models.ReleaseObjectRefs(artifact.ID, []string{path})
+	if err != nil {
+		return err
+	}
+
+	err = models.ReleaseObjectRefsUnder(artifact.ID, pathutil.EndsInSlash(path))
+	if err != nil {
+		return err
+	}
+
+	err = models.DeleteObjectMetadata(artifact.ID, []string{path})
+	if err != nil {
+		return err
+	}
+
+	return models.DeleteObjectMetadataUnder(artifact.ID, pathutil.EndsInSlash(path))
 }
 
 // DeleteArtifactPath deletes an object or directory in the given Artifact's bucket given by its ID.
@@ -183,6 +263,40 @@ func ListArtifactPath(ctx context.Context, client storage.Client, artifactID, p
 	return ListTransferPath(ctx, client, a, p, wrapDirectories)
 }
 
+// ListLabeledArtifactPath returns the files in a directory, and in its subdirectories,
+// which were pushed with all the given labels.
+func ListLabeledArtifactPath(ctx context.Context, client storage.Client, artifactID, p string, labels models.ObjectLabels) ([]*artifacthub.ListItem, error) {
+	a, err := models.FindArtifactByID(artifactID)
+	if err != nil {
+		return nil, err
+	}
+
+	labeled, err := models.ListObjectMetadataUnder(a.ID, pathutil.EndsInSlash(p), labels)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*artifacthub.ListItem, 0, len(labeled))
+	if len(labeled) == 0 {
+		return result, nil
+	}
+
+	// The metadata can outlive objects deleted outside of artifacthub, so only listed files are returned.
+	ctx, _ = ctxutil.SetBucketName(ctx, a.BucketName)
+	items, err := ListTransferPath(ctx, client, a, p, false)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if _, ok := labeled[item.Name]; ok && !item.IsDirectory {
+			result = append(result, item)
+		}
+	}
+
+	return result, nil
+}
+
 func GetSignedURL(ctx context.Context, client storage.Client, artifactID, p, m string) (string, error) {
 	a, err := models.FindArtifactByID(artifactID)
 	if err != nil {
@@ -194,6 +308,35 @@ func GetSignedURL(ctx context.Context, client storage.Client, artifactID, p, m s
 		method = m
 	}
 
+	// Paths pushed with a digest only point to the blob with the content.
+	if method == http.MethodGet || method == http.MethodHead {
+		refs, err := models.FindObjectRefs(a.ID, []string{p})
+		if err != nil {
+			return "", err
+		}
+
+		if digest, ok := refs[p]; ok {
+			bucket := client.GetBucket(storage.BucketOptions{Name: a.BucketName, PathPrefix: a.IdempotencyToken})
+			uploaded, err := transfer.CheckBlob(ctx, bucket, a.ID, digest)
+			if err != nil {
+				return "", err
+			}
+
+			if !uploaded {
+				return "", fmt.Errorf("%w: '%s'", transfer.ErrBlobNotUploaded, p)
+			}
+
+			return client.SignURL(ctx, storage.SignURLOptions{
+				BucketName:         a.BucketName,
+				Method:             method,
+				Path:               pathutil.BlobPath(digest),
+				PathPrefix:         a.IdempotencyToken,
+				IncludeContentType: true,
+				ContentTypePath:    p,
+			})
+		}
+	}
+
 	return client.SignURL(ctx, storage.SignURLOptions{
 		BucketName:         a.BucketName,
 		Method:             method,
@@ -202,3 +345,82 @@ func GetSignedURL(ctx context.Context, client storage.Client, artifactID, p, m s
 		IncludeContentType: true,
 	})
 }
+
+// MaxRetentionPreviewObjects limits how many objects a preview visits,
+// so previewing a policy on a huge artifact store doesn't block the request for too long.
+const MaxRetentionPreviewObjects = 100000
+
+// PreviewRetentionPolicy goes through the objects of an artifact store the same way the bucket cleaner does,
+// and returns the ones the retention policy would delete. Nothing is deleted.
+func PreviewRetentionPolicy(ctx context.Context, client storage.Client, artifact *models.Artifact, policy *models.RetentionPolicy, refs retention.WorkflowRefs, maxObjects int) (*artifacthub.PreviewRetentionPolicyResponse, error) {
+	bucket := client.GetBucket(storage.BucketOptions{
+		Name:       artifact.BucketName,
+		PathPrefix: artifact.IdempotencyToken,
+	})
+
+	evaluator := retention.NewEvaluator(policy, refs)
+	if evaluator.NeedsPlan() {
+		blobRefs, err := models.ListObjectRefs(artifact.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := evaluator.BuildPlan(ctx, bucket, blobRefs); err != nil {
+			return nil, err
+		}
+	}
+
+	pager, err := bucket.ListObjectsWithPagination(storage.ListOptions{
+		Path:    "artifacts/",
+		MaxKeys: 1000,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	response := &artifacthub.PreviewRetentionPolicyResponse{Objects: []*artifacthub.RetentionObject{}}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		objects, nextPageToken, err := pager.NextPage()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range objects {
+			response.VisitedCount++
+			response.VisitedSize += object.Size
+		}
+
+		matched, err := evaluator.Match(ctx, objects)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range matched {
+			response.MatchedCount++
+			response.MatchedSize += object.Size
+
+			if len(response.Objects) < maxObjects {
+				response.Objects = append(response.Objects, &artifacthub.RetentionObject{
+					Path: object.Path,
+					Size: object.Size,
+					Age:  int64(object.Age.Seconds()),
+				})
+			}
+		}
+
+		if nextPageToken == "" {
+			return response, nil
+		}
+
+		if response.VisitedCount >= MaxRetentionPreviewObjects {
+			response.Truncated = true
+			return response, nil
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package publicapi
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/renderedtext/go-watchman"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/storage"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/transfer"
+)
+
+const (
+	ArchiveFormatZip   = "zip"
+	ArchiveFormatTarGz = "tar.gz"
+)
+
+var ErrArchiveTooLarge = errors.New("directory is too large to be archived")
+
+// WriteArchive writes all the files in the directory into w, as a zip or a gzipped tarball.
+// Files are copied from the storage one at a time, so memory use doesn't depend on their sizes.
+// Directories with more than maxSize bytes of files are refused before anything is written.
+func WriteArchive(ctx context.Context, client storage.Client, artifact *models.Artifact, p, format string, w io.Writer, maxSize int64) error {
+	if format != ArchiveFormatZip && format != ArchiveFormatTarGz {
+		return fmt.Errorf("unknown archive format '%s'", format)
+	}
+
+	bucket := client.GetBucket(storage.BucketOptions{
+		Name:       artifact.BucketName,
+		PathPrefix: artifact.IdempotencyToken,
+	})
+
+	files, err := transfer.ListFiles(ctx, bucket, artifact.ID, p)
+	if errors.Is(err, transfer.ErrPathNotFound) || errors.Is(err, transfer.ErrBlobNotUploaded) {
+		return ErrArtifactNotFound
+	}
+
+	if err != nil {
+		return err
+	}
+
+	var size int64
+	for _, f := range files {
+		size += f.Size
+	}
+
+	if size > maxSize {
+		return fmt.Errorf("%w: '%s' has %d bytes, and archives are limited to %d bytes", ErrArchiveTooLarge, p, size, maxSize)
+	}
+
+	defer watchman.Benchmark(time.Now(), "archives.duration")
+	_ = watchman.Increment("archives." + format)
+
+	copyFile := func(w io.Writer, f transfer.File) error {
+		reader, err := bucket.ReadObject(ctx, f.ContentPath)
+		if err != nil {
+			return err
+		}
+
+		defer reader.Close()
+
+		// The header promised this many bytes, even if the object changed since it was listed.
+		_, err = io.CopyN(w, reader, f.Size)
+		return err
+	}
+
+	if format == ArchiveFormatZip {
+		return writeZip(w, files, copyFile)
+	}
+
+	return writeTarGz(w, files, copyFile)
+}
+
+func writeZip(w io.Writer, files []transfer.File, copyFile func(io.Writer, transfer.File) error) error {
+	archive := zip.NewWriter(w)
+
+	for _, f := range files {
+		header := &zip.FileHeader{Name: f.Name, Method: zip.Deflate, Modified: f.Modified}
+		header.SetMode(0644)
+
+		fw, err := archive.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+
+		if err := copyFile(fw, f); err != nil {
+			return err
+		}
+	}
+
+	return archive.Close()
+}
+
+func writeTarGz(w io.Writer, files []transfer.File, copyFile func(io.Writer, transfer.File) error) error {
+	gz := gzip.NewWriter(w)
+	archive := tar.NewWriter(gz)
+
+	for _, f := range files {
+		err := archive.WriteHeader(&tar.Header{
+			Name:     f.Name,
+			Mode:     0644,
+			Size:     f.Size,
+			ModTime:  f.Modified,
+			Typeflag: tar.TypeReg,
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if err := copyFile(archive, f); err != nil {
+			return err
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return err
+	}
+
+	return gz.Close()
+}
@@ -0,0 +1,208 @@
+package publicapi
+
+import (
+	"context"
+	"errors"
+
+	"github.com/renderedtext/go-watchman"
+	uuid "github.com/satori/go.uuid"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/api/descriptors/artifacts"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/storage"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/transfer"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/util/log"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+// MaxMultipartUploadSize is the largest object S3 and GCS can store.
+const MaxMultipartUploadSize = 5 * 1024 * 1024 * 1024 * 1024
+
+var (
+	ErrMultipartUnsupported      = errors.New("multipart uploads are not supported by the artifact storage")
+	ErrMultipartUploadNotFound   = errors.New("multipart upload not found")
+	ErrMultipartUploadIncomplete = errors.New("multipart upload is not finished")
+	ErrInvalidUploadSize         = errors.New("upload size must be positive, and at most 5TiB")
+	ErrArtifactAlreadyExists     = errors.New("artifact already exists")
+)
+
+func multipartClient(client storage.Client) (storage.MultipartClient, error) {
+	mc, ok := client.(storage.MultipartClient)
+	if !ok {
+		return nil, ErrMultipartUnsupported
+	}
+
+	return mc, nil
+}
+
+func multipartOptions(artifact *models.Artifact, p string, size int64) storage.MultipartUploadOptions {
+	return storage.MultipartUploadOptions{
+		BucketName: artifact.BucketName,
+		Path:       p,
+		PathPrefix: artifact.IdempotencyToken,
+		Size:       size,
+	}
+}
+
+func findMultipartUpload(artifact *models.Artifact, p, uploadID string) (*models.MultipartUpload, error) {
+	id, err := uuid.FromString(uploadID)
+	if err != nil {
+		return nil, ErrMultipartUploadNotFound
+	}
+
+	upload, err := models.FindMultipartUpload(artifact.ID, id, p)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, ErrMultipartUploadNotFound
+	}
+
+	return upload, err
+}
+
+// StartMultipartUpload starts an upload of a large file, or resumes it if uploadID is given.
+// Uploads that are never completed are aborted by the upload janitor.
+func StartMultipartUpload(ctx context.Context, client storage.Client, artifact *models.Artifact, p string, size int64, force bool, uploadID string) (*artifacts.StartMultipartUploadResponse, error) {
+	mc, err := multipartClient(client)
+	if err != nil {
+		return nil, err
+	}
+
+	if uploadID != "" {
+		return resumeMultipartUpload(ctx, mc, artifact, p, uploadID)
+	}
+
+	if size <= 0 || size > MaxMultipartUploadSize {
+		return nil, ErrInvalidUploadSize
+	}
+
+	if err := transfer.CheckStorageQuota(artifact); err != nil {
+		return nil, err
+	}
+
+	if force {
+		// The uploaded content replaces the blob the path pointed to.
+		if err := models.ReleaseObjectRefs(artifact.ID, []string{p}); err != nil {
+			return nil, err
+		}
+	} else {
+		bucket := client.GetBucket(storage.BucketOptions{
+			Name:       artifact.BucketName,
+			PathPrefix: artifact.IdempotencyToken,
+		})
+
+		exists, err := bucket.IsFile(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+
+		if exists {
+			return nil, ErrArtifactAlreadyExists
+		}
+	}
+
+	options := multipartOptions(artifact, p, size)
+	started, err := mc.StartMultipartUpload(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	upload, err := models.CreateMultipartUpload(artifact.ID, p, started.ID, size)
+	if err != nil {
+		if abortErr := mc.AbortMultipartUpload(ctx, options, started.ID); abortErr != nil {
+			log.Error("Failed to abort multipart upload", zap.String("path", p), zap.Error(abortErr))
+		}
+
+		return nil, err
+	}
+
+	_ = watchman.Increment("multipart.uploads.started")
+	return marshalMultipartUpload(upload, started), nil
+}
+
+func resumeMultipartUpload(ctx context.Context, mc storage.MultipartClient, artifact *models.Artifact, p, uploadID string) (*artifacts.StartMultipartUploadResponse, error) {
+	upload, err := findMultipartUpload(artifact, p, uploadID)
+	if err != nil {
+		return nil, err
+	}
+
+	resumed, err := mc.ResumeMultipartUpload(ctx, multipartOptions(artifact, p, upload.Size), upload.UploadID)
+	if errors.Is(err, storage.ErrMultipartUploadNotFound) {
+		return nil, ErrMultipartUploadNotFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	_ = watchman.Increment("multipart.uploads.resumed")
+	return marshalMultipartUpload(upload, resumed), nil
+}
+
+func marshalMultipartUpload(upload *models.MultipartUpload, m *storage.MultipartUpload) *artifacts.StartMultipartUploadResponse {
+	response := &artifacts.StartMultipartUploadResponse{
+		UploadId:   upload.ID.String(),
+		PartSize:   m.PartSize,
+		SessionUri: m.SessionURI,
+	}
+
+	for _, part := range m.Parts {
+		response.Parts = append(response.Parts, &artifacts.UploadPart{
+			Number: int32(part.Number),
+			URL:    part.URL,
+			Etag:   part.ETag,
+		})
+	}
+
+	return response
+}
+
+// CompleteMultipartUpload puts the uploaded parts together into the file.
+func CompleteMultipartUpload(ctx context.Context, client storage.Client, artifact *models.Artifact, p, uploadID string, parts []*artifacts.UploadPart) error {
+	mc, err := multipartClient(client)
+	if err != nil {
+		return err
+	}
+
+	upload, err := findMultipartUpload(artifact, p, uploadID)
+	if err != nil {
+		return err
+	}
+
+	completed := make([]storage.UploadPart, 0, len(parts))
+	for _, part := range parts {
+		completed = append(completed, storage.UploadPart{Number: int(part.Number), ETag: part.Etag})
+	}
+
+	err = mc.CompleteMultipartUpload(ctx, multipartOptions(artifact, p, upload.Size), upload.UploadID, completed)
+	switch {
+	case errors.Is(err, storage.ErrMultipartUploadIncomplete):
+		return ErrMultipartUploadIncomplete
+	case errors.Is(err, storage.ErrMultipartUploadNotFound):
+		return ErrMultipartUploadNotFound
+	case err != nil:
+		return err
+	}
+
+	_ = watchman.Increment("multipart.uploads.completed")
+	return upload.Delete()
+}
+
+// AbortMultipartUpload drops the parts uploaded so far.
+func AbortMultipartUpload(ctx context.Context, client storage.Client, artifact *models.Artifact, p, uploadID string) error {
+	mc, err := multipartClient(client)
+	if err != nil {
+		return err
+	}
+
+	upload, err := findMultipartUpload(artifact, p, uploadID)
+	if err != nil {
+		return err
+	}
+
+	err = mc.AbortMultipartUpload(ctx, multipartOptions(artifact, p, upload.Size), upload.UploadID)
+	if err != nil && !errors.Is(err, storage.ErrMultipartUploadNotFound) {
+		return err
+	}
+
+	_ = watchman.Increment("multipart.uploads.aborted")
+	return upload.Delete()
+}
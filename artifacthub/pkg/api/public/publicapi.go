@@ -6,17 +6,22 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/renderedtext/go-watchman"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/api/descriptors/artifacts"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/storage"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/transfer"
 	ctxutil "github.com/semaphoreio/semaphore/artifacthub/pkg/util/context"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/util/log"
+	pathutil "github.com/semaphoreio/semaphore/artifacthub/pkg/util/path"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/util/retry"
 	"go.uber.org/zap"
 )
 
 var (
-	ErrArtifactNotFound = errors.New("artifact not found")
+	ErrArtifactNotFound     = errors.New("artifact not found")
+	ErrStorageQuotaExceeded = transfer.ErrStorageQuotaExceeded
+	ErrInvalidDigests       = errors.New("digests must be hex encoded SHA-256 values, one for each path")
 )
 
 // signURL signs a given path with the given method, and returns it in a grpc encoded way.
@@ -38,52 +43,150 @@ func signURL(ctx context.Context, client storage.Client, artifact *models.Artifa
 }
 
 // GenerateSignedURLPush creates signed URLs for pushing to the artifact storage.
-func GenerateSignedURLPush(ctx context.Context, client storage.Client, artifact *models.Artifact, paths []string, force bool) ([]*artifacts.SignedURL, error) {
-	count := len(paths)
-	if !force {
-		count *= 2
+// Paths pushed with a digest get a single URL for uploading the blob with that digest,
+// or no URL at all if the blob is already stored.
+func GenerateSignedURLPush(ctx context.Context, client storage.Client, artifact *models.Artifact, paths, digests []string, force bool) ([]*artifacts.SignedURL, error) {
+	if err := transfer.CheckStorageQuota(artifact); err != nil {
+		return nil, err
+	}
+
+	if len(digests) > 0 && len(digests) != len(paths) {
+		return nil, ErrInvalidDigests
 	}
 
-	var err error
-	urls := make([]*artifacts.SignedURL, count)
-	j := 0
-	for _, p := range paths {
+	bucket := client.GetBucket(storage.BucketOptions{
+		Name:       artifact.BucketName,
+		PathPrefix: artifact.IdempotencyToken,
+	})
+
+	urls := make([]*artifacts.SignedURL, 0, len(paths)*2)
+	for i, p := range paths {
+		if len(digests) > 0 && digests[i] != "" {
+			digest, err := models.NormalizeDigest(digests[i])
+			if err != nil {
+				return nil, ErrInvalidDigests
+			}
+
+			url, err := signBlobPush(ctx, client, bucket, artifact, p, digest, force)
+			if err != nil {
+				return nil, err
+			}
+
+			if url != nil {
+				urls = append(urls, url)
+				continue
+			}
+		} else if force {
+			// The content uploaded to the path replaces the blob the path pointed to.
+			if err := models.ReleaseObjectRefs(artifact.ID, []string{p}); err != nil {
+				return nil, err
+			}
+		}
+
 		if !force {
-			if urls[j], err = signURL(ctx, client, artifact, p, http.MethodHead); err != nil {
+			url, err := signURL(ctx, client, artifact, p, http.MethodHead)
+			if err != nil {
 				return nil, err
 			}
-			j++
+
+			urls = append(urls, url)
 		}
 
-		if urls[j], err = signURL(ctx, client, artifact, p, http.MethodPut); err != nil {
+		url, err := signURL(ctx, client, artifact, p, http.MethodPut)
+		if err != nil {
 			return nil, err
 		}
 
-		j++
+		urls = append(urls, url)
 		log.Debug("PUT URL signed", zap.String("path", p), zap.Bool("force", force))
 	}
 
 	return urls, nil
 }
 
-func generateSignedURLsList(ctx context.Context, client storage.Client, artifact *models.Artifact, p, method string) ([]*artifacts.SignedURL, error) {
-	bucket := client.GetBucket(storage.BucketOptions{
-		Name:       artifact.BucketName,
-		PathPrefix: artifact.IdempotencyToken,
-	})
+// signBlobPush points the path to the blob with the given digest, and signs the upload of the blob
+// if it is not stored yet. Without force, existing paths are left to the HEAD check of the client,
+// so nothing is overwritten, and nil is returned for them.
+//
+// A small object pointing to the blob is written to the path itself, so listing paths,
+// retention policies and deletions keep working with paths the same way.
+// The digest comes from the client, so a blob is only matched by later pushes, or pulled,
+// once its content was checked to match the digest. Until then, the path doesn't count as a ref of the blob.
+func signBlobPush(ctx context.Context, client storage.Client, bucket storage.Bucket, artifact *models.Artifact, p, digest string, force bool) (*artifacts.SignedURL, error) {
+	if !force {
+		pushed, err := isPushed(ctx, bucket, artifact, p)
+		if err != nil || pushed {
+			return nil, err
+		}
+	}
+
+	// A blob uploaded before, but not verified yet, doesn't need to be uploaded again.
+	_, err := transfer.CheckBlob(ctx, bucket, artifact.ID, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	blobPath := pathutil.BlobPath(digest)
+	present, err := models.AddObjectRef(artifact.ID, p, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := bucket.CreateObject(ctx, p, []byte(blobPointer(digest))); err != nil {
+		return nil, err
+	}
+
+	if present {
+		_ = watchman.Increment("blobs.already_present")
+		log.Debug("Blob already present", zap.String("path", p), zap.String("digest", digest))
+		return &artifacts.SignedURL{Method: artifacts.SignedURL_PUT, Digest: digest, AlreadyPresent: true, Path: p}, nil
+	}
+
+	_ = watchman.Increment("blobs.uploads")
+	url, err := signURL(ctx, client, artifact, blobPath, http.MethodPut)
+	if err != nil {
+		return nil, err
+	}
+
+	url.Digest = digest
+	url.Path = p
+	return url, nil
+}
+
+// isPushed returns whether the path exists. Paths pointing to blobs that were never uploaded,
+// or didn't match their digest, can be pushed again without force.
+func isPushed(ctx context.Context, bucket storage.Bucket, artifact *models.Artifact, p string) (bool, error) {
+	exists, err := bucket.IsFile(ctx, p)
+	if err != nil || !exists {
+		return false, err
+	}
+
+	refs, err := models.FindObjectRefs(artifact.ID, []string{p})
+	if err != nil {
+		return false, err
+	}
+
+	digest, isBlob := refs[p]
+	if !isBlob {
+		return true, nil
+	}
+
+	return transfer.CheckBlob(ctx, bucket, artifact.ID, digest)
+}
+
+func blobPointer(digest string) string {
+	return fmt.Sprintf("sha256:%s\n", digest)
+}
 
+// listPaths returns the path itself if it points to a file, or all the files in it if it is a directory.
+func listPaths(ctx context.Context, bucket storage.Bucket, p string) ([]string, error) {
 	isFile, err := bucket.IsFile(ctx, p)
 	if err != nil {
 		return nil, err
 	}
 
-	// Path points to a file, so we generate only one signed URL
 	if isFile {
-		urls := make([]*artifacts.SignedURL, 1)
-		if urls[0], err = signURL(ctx, client, artifact, p, method); err != nil {
-			return nil, err
-		}
-		return urls, nil
+		return []string{p}, nil
 	}
 
 	isDir, err := bucket.IsDir(ctx, p)
@@ -98,10 +201,10 @@ func generateSignedURLsList(ctx context.Context, client storage.Client, artifact
 		return nil, ErrArtifactNotFound
 	}
 
-	// Path points to a directory, so we generate signed URLs
-	// for all files inside that directory
-	urls := make([]*artifacts.SignedURL, 0)
+	paths := []string{}
 	err = retry.OnFailure(ctx, "Listing Bucket path", func() error {
+		paths = []string{} // retry
+
 		iterator, err := bucket.ListPath(storage.ListOptions{Path: p})
 		if err != nil {
 			return err
@@ -117,18 +220,85 @@ func generateSignedURLsList(ctx context.Context, client storage.Client, artifact
 				return err
 			}
 
-			url, err := signURL(ctx, client, artifact, o.Path, method)
+			paths = append(paths, o.Path)
+		}
+
+		return nil
+	})
+
+	return paths, err
+}
+
+// Paths pointing to blobs are pulled from the blob itself,
+// and yanking them releases the blob. Yanking drops the metadata of the paths too.
+func generateSignedURLsList(ctx context.Context, client storage.Client, artifact *models.Artifact, p, method string) ([]*artifacts.SignedURL, error) {
+	bucket := client.GetBucket(storage.BucketOptions{
+		Name:       artifact.BucketName,
+		PathPrefix: artifact.IdempotencyToken,
+	})
+
+	paths, err := listPaths(ctx, bucket, p)
+	if err != nil {
+		return nil, err
+	}
+
+	refs, err := models.FindObjectRefs(artifact.ID, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	urls := make([]*artifacts.SignedURL, 0, len(paths))
+	for _, path := range paths {
+		digest, isBlob := refs[path]
+		if isBlob && method == http.MethodGet {
+			uploaded, err := transfer.CheckBlob(ctx, bucket, artifact.ID, digest)
 			if err != nil {
-				return err
+				return nil, err
+			}
+
+			if !uploaded {
+				log.Warn("Blob was not uploaded", zap.String("path", path), zap.String("digest", digest))
+				return nil, ErrArtifactNotFound
+			}
+
+			url, err := signURL(ctx, client, artifact, pathutil.BlobPath(digest), method)
+			if err != nil {
+				return nil, err
 			}
 
+			url.Digest = digest
+			url.Path = path
 			urls = append(urls, url)
+			continue
 		}
 
-		return nil
-	})
+		url, err := signURL(ctx, client, artifact, path, method)
+		if err != nil {
+			return nil, err
+		}
+
+		url.Digest = digest
+		urls = append(urls, url)
+	}
+
+	if method == http.MethodDelete && len(refs) > 0 {
+		yanked := make([]string, 0, len(refs))
+		for path := range refs {
+			yanked = append(yanked, path)
+		}
+
+		if err := models.ReleaseObjectRefs(artifact.ID, yanked); err != nil {
+			return nil, err
+		}
+	}
 
-	return urls, err
+	if method == http.MethodDelete {
+		if err := models.DeleteObjectMetadata(artifact.ID, paths); err != nil {
+			return nil, err
+		}
+	}
+
+	return urls, nil
 }
 
 // GenerateSignedURLsList wraps signing URLs list with error logging.
@@ -2,21 +2,69 @@ package jwt
 
 import (
 	"fmt"
+	"path"
+	"strings"
 	"time"
 
 	jwt "github.com/golang-jwt/jwt/v5"
 )
 
+const (
+	OperationPull = "pull"
+	OperationPush = "push"
+	OperationYank = "yank"
+)
+
 type Claims struct {
+	ID         string
 	ArtifactID string
 	Job        string
 	Workflow   string
 	Project    string
+
+	// Operations the token allows. Tokens without operations allow all of them.
+	Operations []string
+
+	// If set, only the paths under this prefix are allowed, relative to the resource directory.
+	PathPrefix string
+}
+
+// Allows tells if the token can be used for the operation.
+func (c *Claims) Allows(operation string) bool {
+	if len(c.Operations) == 0 {
+		return true
+	}
+
+	for _, o := range c.Operations {
+		if o == operation {
+			return true
+		}
+	}
+
+	return false
 }
 
+// AllowsPath tells if the token gives access to a path, relative to the resource directory.
+// Paths with "." or ".." elements could step out of the prefix, so they are never allowed.
+func (c *Claims) AllowsPath(p string) bool {
+	prefix := strings.Trim(c.PathPrefix, "/")
+	if prefix == "" {
+		return true
+	}
+
+	p = strings.Trim(p, "/")
+	if path.Clean(p) != p {
+		return false
+	}
+
+	return p == prefix || strings.HasPrefix(p, prefix+"/")
+}
+
+// GenerateToken signs a token with the claims. A token ID (jti) is
+// expected, so the token can be revoked before it expires.
 func GenerateToken(secret string, claims Claims, duration time.Duration) (string, error) {
 	now := time.Now()
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+	mapClaims := jwt.MapClaims{
 		"iat":      now.Unix(),
 		"nbf":      now.Unix(),
 		"exp":      now.Add(duration).Unix(),
@@ -24,7 +72,21 @@ func GenerateToken(secret string, claims Claims, duration time.Duration) (string
 		"job":      claims.Job,
 		"workflow": claims.Workflow,
 		"project":  claims.Project,
-	})
+	}
+
+	if claims.ID != "" {
+		mapClaims["jti"] = claims.ID
+	}
+
+	if len(claims.Operations) > 0 {
+		mapClaims["ops"] = claims.Operations
+	}
+
+	if claims.PathPrefix != "" {
+		mapClaims["prefix"] = claims.PathPrefix
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, mapClaims)
 
 	tokenString, err := token.SignedString([]byte(secret))
 	if err != nil {
@@ -57,13 +119,49 @@ func ValidateToken(tokenString, secret string, validate func(jwt.MapClaims) erro
 			return nil, err
 		}
 
-		return &Claims{
-			ArtifactID: claims["sub"].(string),
-			Job:        claims["job"].(string),
-			Workflow:   claims["workflow"].(string),
-			Project:    claims["project"].(string),
-		}, nil
+		return parseClaims(claims)
 	}
 
 	return nil, fmt.Errorf("invalid token")
 }
+
+// Tokens issued before scoping was added have no jti, ops and prefix claims.
+func parseClaims(claims jwt.MapClaims) (*Claims, error) {
+	c := &Claims{
+		ArtifactID: claims["sub"].(string),
+		Job:        claims["job"].(string),
+		Workflow:   claims["workflow"].(string),
+		Project:    claims["project"].(string),
+	}
+
+	var ok bool
+	if id, found := claims["jti"]; found {
+		if c.ID, ok = id.(string); !ok {
+			return nil, fmt.Errorf("invalid claim: jti")
+		}
+	}
+
+	if prefix, found := claims["prefix"]; found {
+		if c.PathPrefix, ok = prefix.(string); !ok {
+			return nil, fmt.Errorf("invalid claim: prefix")
+		}
+	}
+
+	if ops, found := claims["ops"]; found {
+		list, ok := ops.([]interface{})
+		if !ok || len(list) == 0 {
+			return nil, fmt.Errorf("invalid claim: ops")
+		}
+
+		for _, o := range list {
+			operation, ok := o.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid claim: ops")
+			}
+
+			c.Operations = append(c.Operations, operation)
+		}
+	}
+
+	return c, nil
+}
@@ -136,3 +136,68 @@ func Test__ValidateFunctionFails(t *testing.T) {
 
 	assert.ErrorContains(t, err, "workflow is invalid")
 }
+
+func Test__ScopedToken(t *testing.T) {
+	scoped := testClaims
+	scoped.ID = uuid.NewV4().String()
+	scoped.Operations = []string{OperationPull, OperationPush}
+	scoped.PathPrefix = "test-results/"
+
+	token, err := GenerateToken(testSecret, scoped, time.Minute)
+	assert.Nil(t, err)
+
+	claims, err := ValidateToken(token, testSecret, noOpValidateFn)
+	assert.Nil(t, err)
+	assert.Equal(t, scoped.ID, claims.ID)
+	assert.Equal(t, scoped.Operations, claims.Operations)
+	assert.Equal(t, scoped.PathPrefix, claims.PathPrefix)
+
+	assert.True(t, claims.Allows(OperationPull))
+	assert.True(t, claims.Allows(OperationPush))
+	assert.False(t, claims.Allows(OperationYank))
+
+	assert.True(t, claims.AllowsPath("test-results"))
+	assert.True(t, claims.AllowsPath("test-results/"))
+	assert.True(t, claims.AllowsPath("test-results/junit.xml"))
+	assert.False(t, claims.AllowsPath(""))
+	assert.False(t, claims.AllowsPath("test-results-other/junit.xml"))
+	assert.False(t, claims.AllowsPath("test-results/../secrets.txt"))
+	assert.False(t, claims.AllowsPath("test-results/./junit.xml"))
+}
+
+func Test__UnscopedTokenAllowsEverything(t *testing.T) {
+	token, err := GenerateToken(testSecret, testClaims, time.Minute)
+	assert.Nil(t, err)
+
+	claims, err := ValidateToken(token, testSecret, noOpValidateFn)
+	assert.Nil(t, err)
+	assert.Empty(t, claims.ID)
+
+	for _, operation := range []string{OperationPull, OperationPush, OperationYank} {
+		assert.True(t, claims.Allows(operation))
+	}
+
+	assert.True(t, claims.AllowsPath(""))
+	assert.True(t, claims.AllowsPath("any/path.txt"))
+}
+
+func Test__InvalidScopesFailValidation(t *testing.T) {
+	for _, invalid := range []jwt.MapClaims{{"ops": "pull"}, {"ops": []string{}}, {"prefix": 1}, {"jti": 1}} {
+		mapClaims := jwt.MapClaims{
+			"iat":      time.Now().Unix(),
+			"exp":      time.Now().Add(time.Hour).Unix(),
+			"sub":      testClaims.ArtifactID,
+			"job":      testClaims.Job,
+			"workflow": testClaims.Workflow,
+			"project":  testClaims.Project,
+		}
+
+		for k, v := range invalid {
+			mapClaims[k] = v
+		}
+
+		tokenString, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, mapClaims).SignedString([]byte(testSecret))
+		_, err := ValidateToken(tokenString, testSecret, noOpValidateFn)
+		assert.ErrorContains(t, err, "invalid claim", "%v", invalid)
+	}
+}
@@ -15,6 +15,8 @@ import (
 	"google.golang.org/grpc/codes"
 )
 
+var ErrArtifactOfAnotherOrganization = errors.New("artifact store belongs to another organization")
+
 // Artifact represents the sql orm table structure how artifacts are stored.
 type Artifact struct {
 	ID               uuid.UUID `gorm:"primary_key;default:uuid_generate_v4()"`
@@ -23,6 +25,8 @@ type Artifact struct {
 	Created          time.Time
 	LastCleanedAt    time.Time
 	DeletedAt        *time.Time
+	OrgID            *uuid.UUID
+	UsageScannedAt   *time.Time
 }
 
 // CreateArtifact inserts a new artifact object to the database given by all its values.
@@ -56,6 +60,36 @@ func (a *Artifact) UpdateLastCleanedAt(timestamp time.Time) error {
 	return nil
 }
 
+// UpdateOrgID sets the organization owning the artifact,
+// which is how the artifact usage is counted towards storage quotas.
+func (a *Artifact) UpdateOrgID(orgID uuid.UUID) error {
+	if err := db.Conn().Model(&a).Update("OrgID", orgID).Error; err != nil {
+		return log.ErrorCode(codes.Unknown, "Updating Artifact organization in the database", err)
+	}
+
+	return nil
+}
+
+// AssignOrgID sets the organization of an artifact that doesn't have one yet, e.g. when backfilling
+// artifacts created before organizations were recorded. Artifacts of other organizations are not moved.
+func (a *Artifact) AssignOrgID(orgID uuid.UUID) error {
+	result := db.Conn().
+		Model(&Artifact{}).
+		Where("id = ? AND (org_id IS NULL OR org_id = ?)", a.ID.String(), orgID.String()).
+		Update("org_id", orgID)
+
+	if result.Error != nil {
+		return log.ErrorCode(codes.Unknown, "Updating Artifact organization in the database", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrArtifactOfAnotherOrganization
+	}
+
+	a.OrgID = &orgID
+	return nil
+}
+
 func (a *Artifact) UpdateDeleteAt(tx *gorm.DB, timestamp time.Time) error {
 	return tx.Model(&a).Update("DeletedAt", timestamp).Error
 }
@@ -0,0 +1,328 @@
+package models
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/db"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrInvalidDigest = errors.New("digest must be a hex encoded SHA-256")
+
+// ArtifactBlob is content stored once per digest in an artifact bucket.
+// Paths pointing to it are recorded as ArtifactObjectRefs, and the blob
+// can be deleted once nothing references it anymore.
+//
+// Clients upload blobs straight to the storage, so the content is only trusted
+// once it was read back and found to match the digest, and VerifiedAt is set.
+// Until then, RefCount stays at zero.
+type ArtifactBlob struct {
+	ArtifactID uuid.UUID `gorm:"primary_key"`
+	Digest     string    `gorm:"primary_key"`
+	RefCount   int64
+	CreatedAt  time.Time
+	ReleasedAt *time.Time
+	VerifiedAt *time.Time
+}
+
+type ArtifactObjectRef struct {
+	ArtifactID uuid.UUID `gorm:"primary_key"`
+	Path       string    `gorm:"primary_key"`
+	Digest     string
+	CreatedAt  time.Time
+}
+
+// NormalizeDigest validates a hex encoded SHA-256 digest, and returns it in lowercase.
+func NormalizeDigest(digest string) (string, error) {
+	digest = strings.ToLower(digest)
+
+	b, err := hex.DecodeString(digest)
+	if err != nil || len(b) != 32 {
+		return "", ErrInvalidDigest
+	}
+
+	return digest, nil
+}
+
+// AddObjectRef points a path to the blob with the given digest, and releases whatever the path pointed to before.
+// Refs only count towards the blob once its content is verified, see VerifyBlob,
+// so a blob that is never uploaded doesn't keep the refs it got meanwhile. It returns whether the blob is verified.
+func AddObjectRef(artifactID uuid.UUID, path, digest string) (bool, error) {
+	verified := false
+
+	err := db.Conn().Transaction(func(tx *gorm.DB) error {
+		existing := ArtifactObjectRef{}
+		err := tx.Where("artifact_id = ? AND path = ?", artifactID.String(), path).First(&existing).Error
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+
+		if err == nil {
+			err = releaseObjectRefs(tx, artifactID, []ArtifactObjectRef{existing})
+			if err != nil {
+				return err
+			}
+		}
+
+		blob := ArtifactBlob{}
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("artifact_id = ? AND digest = ?", artifactID.String(), digest).
+			First(&blob).
+			Error
+
+		switch {
+		case err == nil:
+			updates := map[string]interface{}{"released_at": nil}
+			if blob.VerifiedAt != nil {
+				verified = true
+				updates["ref_count"] = gorm.Expr("ref_count + 1")
+			}
+
+			err = tx.Model(&ArtifactBlob{}).
+				Where("artifact_id = ? AND digest = ?", artifactID.String(), digest).
+				Updates(updates).
+				Error
+
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			err = tx.Create(&ArtifactBlob{ArtifactID: artifactID, Digest: digest, CreatedAt: time.Now()}).Error
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(&ArtifactObjectRef{ArtifactID: artifactID, Path: path, Digest: digest, CreatedAt: time.Now()}).Error
+	})
+
+	if err != nil {
+		return false, err
+	}
+
+	return verified, nil
+}
+
+// VerifyBlob returns whether the content of the blob is stored and matches its digest.
+// Blobs are only verified once, with verify, and later calls only look at the database.
+// Paths pointing to blobs that aren't verified can't be pulled yet.
+//
+// The content is read without holding any lock, and the blob is only locked
+// to mark it as verified and count the refs it got while it wasn't.
+// If the bucket cleaner deleted the blob meanwhile, it is not verified.
+func VerifyBlob(artifactID uuid.UUID, digest string, verify func() (bool, error)) (bool, error) {
+	blob := ArtifactBlob{}
+	err := db.Conn().Where("artifact_id = ? AND digest = ?", artifactID.String(), digest).First(&blob).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	if blob.VerifiedAt != nil {
+		return true, nil
+	}
+
+	verified, err := verify()
+	if err != nil || !verified {
+		return false, err
+	}
+
+	err = db.Conn().Transaction(func(tx *gorm.DB) error {
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("artifact_id = ? AND digest = ?", artifactID.String(), digest).
+			First(&blob).
+			Error
+
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			verified = false
+			return nil
+		}
+
+		if err != nil || blob.VerifiedAt != nil {
+			return err
+		}
+
+		var refCount int64
+		err = tx.Model(&ArtifactObjectRef{}).
+			Where("artifact_id = ? AND digest = ?", artifactID.String(), digest).
+			Count(&refCount).
+			Error
+
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&ArtifactBlob{}).
+			Where("artifact_id = ? AND digest = ?", artifactID.String(), digest).
+			Updates(map[string]interface{}{"verified_at": time.Now(), "ref_count": refCount}).
+			Error
+	})
+
+	if err != nil {
+		return false, err
+	}
+
+	return verified, nil
+}
+
+// FindObjectRefs returns a path => digest map for the paths pointing to blobs.
+func FindObjectRefs(artifactID uuid.UUID, paths []string) (map[string]string, error) {
+	result := map[string]string{}
+	if len(paths) == 0 {
+		return result, nil
+	}
+
+	refs := []ArtifactObjectRef{}
+	err := db.Conn().
+		Where("artifact_id = ? AND path IN (?)", artifactID.String(), paths).
+		Find(&refs).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ref := range refs {
+		result[ref.Path] = ref.Digest
+	}
+
+	return result, nil
+}
+
+// ListObjectRefs returns a path => digest map for all the paths of an artifact pointing to blobs.
+func ListObjectRefs(artifactID uuid.UUID) (map[string]string, error) {
+	refs := []ArtifactObjectRef{}
+	err := db.Conn().Where("artifact_id = ?", artifactID.String()).Find(&refs).Error
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]string{}
+	for _, ref := range refs {
+		result[ref.Path] = ref.Digest
+	}
+
+	return result, nil
+}
+
+// ReleaseObjectRefs removes the refs of the given paths, for example when they are deleted or overwritten.
+func ReleaseObjectRefs(artifactID uuid.UUID, paths []string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	return db.Conn().Transaction(func(tx *gorm.DB) error {
+		refs := []ArtifactObjectRef{}
+		err := tx.Where("artifact_id = ? AND path IN (?)", artifactID.String(), paths).Find(&refs).Error
+		if err != nil {
+			return err
+		}
+
+		return releaseObjectRefs(tx, artifactID, refs)
+	})
+}
+
+// ReleaseObjectRefsUnder removes the refs of all the paths in a directory.
+func ReleaseObjectRefsUnder(artifactID uuid.UUID, dir string) error {
+	pattern := likePrefix(dir)
+
+	return db.Conn().Transaction(func(tx *gorm.DB) error {
+		refs := []ArtifactObjectRef{}
+		err := tx.Where("artifact_id = ? AND path LIKE ?", artifactID.String(), pattern).Find(&refs).Error
+		if err != nil {
+			return err
+		}
+
+		return releaseObjectRefs(tx, artifactID, refs)
+	})
+}
+
+func releaseObjectRefs(tx *gorm.DB, artifactID uuid.UUID, refs []ArtifactObjectRef) error {
+	if len(refs) == 0 {
+		return nil
+	}
+
+	paths := []string{}
+	releases := map[string]int{}
+	for _, ref := range refs {
+		paths = append(paths, ref.Path)
+		releases[ref.Digest]++
+	}
+
+	err := tx.Where("artifact_id = ? AND path IN (?)", artifactID.String(), paths).Delete(&ArtifactObjectRef{}).Error
+	if err != nil {
+		return err
+	}
+
+	for digest, count := range releases {
+		err := tx.Model(&ArtifactBlob{}).
+			Where("artifact_id = ? AND digest = ?", artifactID.String(), digest).
+			Updates(map[string]interface{}{
+				"ref_count": gorm.Expr("GREATEST(ref_count - ?, 0)", count),
+
+				// Refs to blobs that aren't verified yet are not counted,
+				// so blobs are released once no path points to them anymore.
+				"released_at": gorm.Expr(
+					"CASE WHEN EXISTS (SELECT 1 FROM artifact_object_refs r WHERE r.artifact_id = ? AND r.digest = ?) THEN released_at ELSE now() END",
+					artifactID.String(), digest,
+				),
+			}).
+			Error
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// FindReleasedBlobs returns the blobs of an artifact that are not referenced
+// by any path since longer than the grace period.
+func FindReleasedBlobs(artifactID uuid.UUID, gracePeriod time.Duration) ([]ArtifactBlob, error) {
+	blobs := []ArtifactBlob{}
+
+	err := db.Conn().
+		Where("artifact_id = ? AND ref_count = 0", artifactID.String()).
+		Where("released_at < ?", time.Now().Add(-gracePeriod)).
+		Find(&blobs).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return blobs, nil
+}
+
+// DeleteReleasedBlob deletes a blob if it is still not referenced,
+// keeping it locked while deleteContent removes the blob from the storage.
+func DeleteReleasedBlob(artifactID uuid.UUID, digest string, deleteContent func() error) error {
+	return db.Conn().Transaction(func(tx *gorm.DB) error {
+		blob := ArtifactBlob{}
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("artifact_id = ? AND digest = ? AND ref_count = 0", artifactID.String(), digest).
+			First(&blob).
+			Error
+
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+
+			return err
+		}
+
+		if err := deleteContent(); err != nil {
+			return err
+		}
+
+		return tx.Where("artifact_id = ? AND digest = ?", artifactID.String(), digest).Delete(&ArtifactBlob{}).Error
+	})
+}
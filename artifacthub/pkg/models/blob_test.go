@@ -0,0 +1,196 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__NormalizeDigest(t *testing.T) {
+	digest := strings.Repeat("AB", 32)
+
+	normalized, err := NormalizeDigest(digest)
+	require.NoError(t, err)
+	assert.Equal(t, strings.Repeat("ab", 32), normalized)
+
+	for _, invalid := range []string{"", "abc", strings.Repeat("ab", 31), strings.Repeat("zz", 32)} {
+		_, err := NormalizeDigest(invalid)
+		assert.ErrorIs(t, err, ErrInvalidDigest)
+	}
+}
+
+func Test__ArtifactBlobs(t *testing.T) {
+	PrepareDatabaseForTests()
+
+	artifact, err := CreateArtifact(uuid.NewV4().String(), uuid.NewV4().String())
+	require.NoError(t, err)
+
+	digest := strings.Repeat("ab", 32)
+	otherDigest := strings.Repeat("cd", 32)
+	uploaded := func() (bool, error) { return true, nil }
+
+	findBlob := func(digest string) *ArtifactBlob {
+		blob := ArtifactBlob{}
+		err := db.Conn().Where("artifact_id = ? AND digest = ?", artifact.ID.String(), digest).First(&blob).Error
+		if err != nil {
+			return nil
+		}
+
+		return &blob
+	}
+
+	t.Run("first ref creates the blob", func(t *testing.T) {
+		verified, err := AddObjectRef(artifact.ID, "artifacts/jobs/1/a.txt", digest)
+		require.NoError(t, err)
+		assert.False(t, verified)
+		assert.Equal(t, int64(0), findBlob(digest).RefCount)
+		assert.Nil(t, findBlob(digest).ReleasedAt)
+	})
+
+	t.Run("next refs reuse the blob", func(t *testing.T) {
+		verified, err := AddObjectRef(artifact.ID, "artifacts/jobs/2/a.txt", digest)
+		require.NoError(t, err)
+		assert.False(t, verified)
+		assert.Equal(t, int64(0), findBlob(digest).RefCount)
+		assert.Nil(t, findBlob(digest).VerifiedAt)
+
+		refs, err := FindObjectRefs(artifact.ID, []string{"artifacts/jobs/1/a.txt", "artifacts/jobs/4/a.txt"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"artifacts/jobs/1/a.txt": digest}, refs)
+	})
+
+	t.Run("failing upload checks don't verify the blob", func(t *testing.T) {
+		_, err := VerifyBlob(artifact.ID, digest, func() (bool, error) {
+			return false, errors.New("storage is down")
+		})
+
+		require.Error(t, err)
+
+		verified, err := VerifyBlob(artifact.ID, digest, func() (bool, error) { return false, nil })
+		require.NoError(t, err)
+		assert.False(t, verified)
+		assert.Equal(t, int64(0), findBlob(digest).RefCount)
+		assert.Nil(t, findBlob(digest).VerifiedAt)
+	})
+
+	t.Run("verifying the blob counts its refs", func(t *testing.T) {
+		verified, err := VerifyBlob(artifact.ID, digest, uploaded)
+		require.NoError(t, err)
+		assert.True(t, verified)
+		assert.Equal(t, int64(2), findBlob(digest).RefCount)
+		assert.NotNil(t, findBlob(digest).VerifiedAt)
+	})
+
+	t.Run("verified blobs are not checked again", func(t *testing.T) {
+		verified, err := AddObjectRef(artifact.ID, "artifacts/jobs/3/a.txt", digest)
+		require.NoError(t, err)
+		assert.True(t, verified)
+		assert.Equal(t, int64(3), findBlob(digest).RefCount)
+
+		verified, err = VerifyBlob(artifact.ID, digest, func() (bool, error) {
+			return false, errors.New("should not be called")
+		})
+
+		require.NoError(t, err)
+		assert.True(t, verified)
+
+		verified, err = VerifyBlob(artifact.ID, otherDigest, uploaded)
+		require.NoError(t, err)
+		assert.False(t, verified)
+	})
+
+	t.Run("overwriting a path releases the previous blob", func(t *testing.T) {
+		_, err := AddObjectRef(artifact.ID, "artifacts/jobs/3/a.txt", otherDigest)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), findBlob(digest).RefCount)
+
+		verified, err := VerifyBlob(artifact.ID, otherDigest, uploaded)
+		require.NoError(t, err)
+		assert.True(t, verified)
+		assert.Equal(t, int64(1), findBlob(otherDigest).RefCount)
+
+		refs, err := ListObjectRefs(artifact.ID)
+		require.NoError(t, err)
+		assert.Len(t, refs, 3)
+		assert.Equal(t, otherDigest, refs["artifacts/jobs/3/a.txt"])
+	})
+
+	t.Run("releasing refs", func(t *testing.T) {
+		require.NoError(t, ReleaseObjectRefs(artifact.ID, []string{"artifacts/jobs/1/a.txt"}))
+		assert.Equal(t, int64(1), findBlob(digest).RefCount)
+		assert.Nil(t, findBlob(digest).ReleasedAt)
+
+		require.NoError(t, ReleaseObjectRefsUnder(artifact.ID, "artifacts/jobs/2/"))
+		blob := findBlob(digest)
+		assert.Equal(t, int64(0), blob.RefCount)
+		assert.NotNil(t, blob.ReleasedAt)
+
+		refs, err := ListObjectRefs(artifact.ID)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"artifacts/jobs/3/a.txt": otherDigest}, refs)
+	})
+
+	t.Run("released blobs are deleted after the grace period", func(t *testing.T) {
+		blobs, err := FindReleasedBlobs(artifact.ID, time.Hour)
+		require.NoError(t, err)
+		assert.Empty(t, blobs)
+
+		blobs, err = FindReleasedBlobs(artifact.ID, -time.Minute)
+		require.NoError(t, err)
+		require.Len(t, blobs, 1)
+		assert.Equal(t, digest, blobs[0].Digest)
+
+		deleted := false
+		err = DeleteReleasedBlob(artifact.ID, digest, func() error {
+			deleted = true
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.True(t, deleted)
+		assert.Nil(t, findBlob(digest))
+	})
+
+	t.Run("referenced blobs are not deleted", func(t *testing.T) {
+		err := DeleteReleasedBlob(artifact.ID, otherDigest, func() error {
+			return errors.New("should not be called")
+		})
+
+		require.NoError(t, err)
+		assert.NotNil(t, findBlob(otherDigest))
+	})
+
+	t.Run("blobs that are not verified are released once no path points to them", func(t *testing.T) {
+		pendingDigest := strings.Repeat("ef", 32)
+		_, err := AddObjectRef(artifact.ID, "artifacts/jobs/5/a.txt", pendingDigest)
+		require.NoError(t, err)
+		_, err = AddObjectRef(artifact.ID, "artifacts/jobs/6/a.txt", pendingDigest)
+		require.NoError(t, err)
+
+		require.NoError(t, ReleaseObjectRefs(artifact.ID, []string{"artifacts/jobs/5/a.txt"}))
+		assert.Nil(t, findBlob(pendingDigest).ReleasedAt)
+
+		require.NoError(t, ReleaseObjectRefs(artifact.ID, []string{"artifacts/jobs/6/a.txt"}))
+		assert.NotNil(t, findBlob(pendingDigest).ReleasedAt)
+	})
+
+	t.Run("blobs deleted while they are verified are not verified", func(t *testing.T) {
+		deletedDigest := strings.Repeat("12", 32)
+		_, err := AddObjectRef(artifact.ID, "artifacts/jobs/7/a.txt", deletedDigest)
+		require.NoError(t, err)
+
+		verified, err := VerifyBlob(artifact.ID, deletedDigest, func() (bool, error) {
+			err := db.Conn().Where("artifact_id = ? AND digest = ?", artifact.ID.String(), deletedDigest).Delete(&ArtifactBlob{}).Error
+			return true, err
+		})
+
+		require.NoError(t, err)
+		assert.False(t, verified)
+	})
+}
@@ -18,7 +18,7 @@ func PrepareDatabaseForTests() {
 		panic("trying to truncate database in non-test environment")
 	}
 
-	err := db.Conn().Exec(`truncate table artifacts, retention_policies`).Error
+	err := db.Conn().Exec(`truncate table artifacts, retention_policies, artifact_usages, storage_quotas, artifact_blobs, artifact_object_refs, retention_reports, retention_report_objects, multipart_uploads, revoked_tokens, artifact_object_metadata`).Error
 	if err != nil {
 		panic(err)
 	}
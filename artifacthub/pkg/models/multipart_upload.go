@@ -0,0 +1,75 @@
+package models
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/db"
+)
+
+// MultipartUpload is an upload of a large file in progress.
+// UploadID is the ID the storage backend gave to the upload, and it is never
+// shown to clients, so they can't complete or abort uploads of other artifacts.
+type MultipartUpload struct {
+	ID         uuid.UUID `gorm:"primary_key;default:uuid_generate_v4()"`
+	ArtifactID uuid.UUID
+	Path       string
+	UploadID   string
+	Size       int64
+	CreatedAt  time.Time
+}
+
+func CreateMultipartUpload(artifactID uuid.UUID, path, uploadID string, size int64) (*MultipartUpload, error) {
+	u := &MultipartUpload{
+		ArtifactID: artifactID,
+		Path:       path,
+		UploadID:   uploadID,
+		Size:       size,
+		CreatedAt:  time.Now(),
+	}
+
+	err := db.Conn().Create(u).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// FindMultipartUpload returns the upload of a path, if it belongs to the artifact.
+func FindMultipartUpload(artifactID, id uuid.UUID, path string) (*MultipartUpload, error) {
+	u := &MultipartUpload{}
+
+	err := db.Conn().
+		Where("id = ? AND artifact_id = ? AND path = ?", id.String(), artifactID.String(), path).
+		First(u).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// FindStaleMultipartUploads returns the oldest uploads started more than maxAge ago.
+func FindStaleMultipartUploads(maxAge time.Duration, limit int) ([]MultipartUpload, error) {
+	uploads := []MultipartUpload{}
+
+	err := db.Conn().
+		Where("created_at < ?", time.Now().Add(-maxAge)).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&uploads).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return uploads, nil
+}
+
+func (u *MultipartUpload) Delete() error {
+	return db.Conn().Delete(u).Error
+}
@@ -0,0 +1,214 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/db"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const MaxObjectLabels = 20
+const MaxObjectLabelKeyLength = 63
+const MaxObjectLabelValueLength = 256
+
+// Keys may contain slashes, so they can be namespaced like paths, e.g. git/sha.
+var objectLabelKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9._/-]*$`)
+
+var ErrObjectLabelsTooMany = fmt.Errorf("objects can't have more than %d labels", MaxObjectLabels)
+var ErrObjectLabelKeyInvalid = fmt.Errorf("object label key must start with a letter or digit, and contain only letters, digits, '.', '_', '/' or '-'")
+var ErrObjectLabelKeyTooLong = fmt.Errorf("object label key can't be longer than %d characters", MaxObjectLabelKeyLength)
+var ErrObjectLabelValueTooLong = fmt.Errorf("object label value can't be longer than %d characters", MaxObjectLabelValueLength)
+
+// ObjectLabels are user supplied key/values attached to pushed objects, e.g. release-candidate=true.
+type ObjectLabels map[string]string
+
+// ArtifactObjectMetadata records what pushed an object, and the labels it was pushed with.
+// Paths are full object paths, e.g. artifacts/jobs/<job-id>/test-results.xml.
+type ArtifactObjectMetadata struct {
+	ArtifactID uuid.UUID `gorm:"primary_key"`
+	Path       string    `gorm:"primary_key"`
+
+	ProjectID  string
+	WorkflowID string
+	JobID      string
+	Labels     ObjectLabels
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (l ObjectLabels) Value() (driver.Value, error) {
+	if l == nil {
+		return "{}", nil
+	}
+
+	return json.Marshal(l)
+}
+
+func (l *ObjectLabels) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+
+	return json.Unmarshal(b, &l)
+}
+
+func (l ObjectLabels) Validate() error {
+	if len(l) > MaxObjectLabels {
+		return ErrObjectLabelsTooMany
+	}
+
+	for key, value := range l {
+		if len(key) > MaxObjectLabelKeyLength {
+			return ErrObjectLabelKeyTooLong
+		}
+
+		if !objectLabelKeyRegex.MatchString(key) {
+			return ErrObjectLabelKeyInvalid
+		}
+
+		if len(value) > MaxObjectLabelValueLength {
+			return ErrObjectLabelValueTooLong
+		}
+	}
+
+	return nil
+}
+
+// RecordObjectMetadata records the metadata for the pushed paths. Existing metadata is
+// replaced only if overwrite is set, the same way the objects themselves are.
+func RecordObjectMetadata(artifactID uuid.UUID, paths []string, metadata ArtifactObjectMetadata, overwrite bool) error {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	rows := make([]ArtifactObjectMetadata, 0, len(paths))
+	for _, p := range paths {
+		row := metadata
+		row.ArtifactID = artifactID
+		row.Path = p
+		row.CreatedAt = now
+		row.UpdatedAt = now
+		rows = append(rows, row)
+	}
+
+	onConflict := clause.OnConflict{DoNothing: true}
+	if overwrite {
+		onConflict = clause.OnConflict{
+			Columns:   []clause.Column{{Name: "artifact_id"}, {Name: "path"}},
+			DoUpdates: clause.AssignmentColumns([]string{"project_id", "workflow_id", "job_id", "labels", "updated_at"}),
+		}
+	}
+
+	return db.Conn().Clauses(onConflict).CreateInBatches(rows, 500).Error
+}
+
+// CopyObjectMetadata gives the destination path the metadata of the source path,
+// or drops the metadata of the destination if the source has none.
+func CopyObjectMetadata(srcArtifactID uuid.UUID, srcPath string, dstArtifactID uuid.UUID, dstPath string) error {
+	return db.Conn().Transaction(func(tx *gorm.DB) error {
+		metadata := ArtifactObjectMetadata{}
+		err := tx.Where("artifact_id = ? AND path = ?", srcArtifactID.String(), srcPath).First(&metadata).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return tx.Where("artifact_id = ? AND path = ?", dstArtifactID.String(), dstPath).
+				Delete(&ArtifactObjectMetadata{}).
+				Error
+		}
+
+		if err != nil {
+			return err
+		}
+
+		metadata.ArtifactID = dstArtifactID
+		metadata.Path = dstPath
+		metadata.UpdatedAt = time.Now()
+
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "artifact_id"}, {Name: "path"}},
+			DoUpdates: clause.AssignmentColumns([]string{"project_id", "workflow_id", "job_id", "labels", "updated_at"}),
+		}).Create(&metadata).Error
+	})
+}
+
+// ListObjectMetadataUnder returns a path => metadata map for the paths in a directory having all the given labels.
+func ListObjectMetadataUnder(artifactID uuid.UUID, dir string, labels ObjectLabels) (map[string]ArtifactObjectMetadata, error) {
+	rows := []ArtifactObjectMetadata{}
+
+	err := withLabels(db.Conn(), labels).
+		Where("artifact_id = ? AND path LIKE ?", artifactID.String(), likePrefix(dir)).
+		Find(&rows).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]ArtifactObjectMetadata{}
+	for _, row := range rows {
+		result[row.Path] = row
+	}
+
+	return result, nil
+}
+
+// SearchObjectMetadata returns the objects of a project having all the given labels ordered by path,
+// starting after the given path, so the last path of a page can be used to fetch the next one.
+func SearchObjectMetadata(artifactID uuid.UUID, projectID string, labels ObjectLabels, after string, limit int) ([]ArtifactObjectMetadata, error) {
+	rows := []ArtifactObjectMetadata{}
+
+	err := withLabels(db.Conn(), labels).
+		Where("artifact_id = ? AND project_id = ? AND path > ?", artifactID.String(), projectID, after).
+		Order("path ASC").
+		Limit(limit).
+		Find(&rows).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// DeleteObjectMetadata drops the metadata of deleted paths.
+func DeleteObjectMetadata(artifactID uuid.UUID, paths []string) error {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	return db.Conn().
+		Where("artifact_id = ? AND path IN (?)", artifactID.String(), paths).
+		Delete(&ArtifactObjectMetadata{}).
+		Error
+}
+
+// DeleteObjectMetadataUnder drops the metadata of all the paths in a deleted directory.
+func DeleteObjectMetadataUnder(artifactID uuid.UUID, dir string) error {
+	return db.Conn().
+		Where("artifact_id = ? AND path LIKE ?", artifactID.String(), likePrefix(dir)).
+		Delete(&ArtifactObjectMetadata{}).
+		Error
+}
+
+func withLabels(tx *gorm.DB, labels ObjectLabels) *gorm.DB {
+	if len(labels) == 0 {
+		return tx
+	}
+
+	b, _ := json.Marshal(labels)
+	return tx.Where("labels @> ?::jsonb", string(b))
+}
+
+func likePrefix(dir string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(dir) + "%"
+}
@@ -0,0 +1,117 @@
+package models
+
+import (
+	"strings"
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__ObjectLabelsValidate(t *testing.T) {
+	assert.NoError(t, ObjectLabels{}.Validate())
+	assert.NoError(t, ObjectLabels{"release-candidate": "true", "git/sha": "abc123", "v1.2_test": ""}.Validate())
+
+	tooMany := ObjectLabels{}
+	for i := 0; i <= MaxObjectLabels; i++ {
+		tooMany[uuid.NewV4().String()] = "x"
+	}
+
+	assert.ErrorIs(t, tooMany.Validate(), ErrObjectLabelsTooMany)
+	assert.ErrorIs(t, ObjectLabels{"": "empty key"}.Validate(), ErrObjectLabelKeyInvalid)
+	assert.ErrorIs(t, ObjectLabels{"-starts-with-dash": "x"}.Validate(), ErrObjectLabelKeyInvalid)
+	assert.ErrorIs(t, ObjectLabels{"has space": "x"}.Validate(), ErrObjectLabelKeyInvalid)
+	assert.ErrorIs(t, ObjectLabels{strings.Repeat("a", MaxObjectLabelKeyLength+1): "x"}.Validate(), ErrObjectLabelKeyTooLong)
+	assert.ErrorIs(t, ObjectLabels{"value": strings.Repeat("a", MaxObjectLabelValueLength+1)}.Validate(), ErrObjectLabelValueTooLong)
+}
+
+func Test__ObjectMetadata(t *testing.T) {
+	PrepareDatabaseForTests()
+
+	artifact, err := CreateArtifact(uuid.NewV4().String(), uuid.NewV4().String())
+	require.NoError(t, err)
+
+	projectID := uuid.NewV4().String()
+	pushedBy := func(jobID string, labels ObjectLabels) ArtifactObjectMetadata {
+		return ArtifactObjectMetadata{ProjectID: projectID, JobID: jobID, Labels: labels}
+	}
+
+	t.Run("pushes record metadata, and only forced pushes overwrite it", func(t *testing.T) {
+		paths := []string{"artifacts/projects/1/app.tar", "artifacts/projects/1/app.sha"}
+		err := RecordObjectMetadata(artifact.ID, paths, pushedBy("job-1", ObjectLabels{"release-candidate": "true"}), false)
+		require.NoError(t, err)
+
+		err = RecordObjectMetadata(artifact.ID, paths[:1], pushedBy("job-2", nil), false)
+		require.NoError(t, err)
+
+		found, err := ListObjectMetadataUnder(artifact.ID, "artifacts/projects/1/", nil)
+		require.NoError(t, err)
+		require.Len(t, found, 2)
+		assert.Equal(t, "job-1", found[paths[0]].JobID)
+		assert.Equal(t, ObjectLabels{"release-candidate": "true"}, found[paths[0]].Labels)
+
+		err = RecordObjectMetadata(artifact.ID, paths[:1], pushedBy("job-2", ObjectLabels{"nightly": "true"}), true)
+		require.NoError(t, err)
+
+		found, err = ListObjectMetadataUnder(artifact.ID, "artifacts/projects/1/", nil)
+		require.NoError(t, err)
+		assert.Equal(t, "job-2", found[paths[0]].JobID)
+		assert.Equal(t, ObjectLabels{"nightly": "true"}, found[paths[0]].Labels)
+	})
+
+	t.Run("labels filter listed and searched paths", func(t *testing.T) {
+		err := RecordObjectMetadata(artifact.ID, []string{"artifacts/jobs/3/report.xml"}, pushedBy("job-3", ObjectLabels{"release-candidate": "true", "suite": "e2e"}), true)
+		require.NoError(t, err)
+
+		found, err := ListObjectMetadataUnder(artifact.ID, "artifacts/", ObjectLabels{"release-candidate": "true"})
+		require.NoError(t, err)
+		assert.Len(t, found, 2)
+		assert.Contains(t, found, "artifacts/projects/1/app.sha")
+		assert.Contains(t, found, "artifacts/jobs/3/report.xml")
+
+		found, err = ListObjectMetadataUnder(artifact.ID, "artifacts/", ObjectLabels{"release-candidate": "true", "suite": "e2e"})
+		require.NoError(t, err)
+		assert.Len(t, found, 1)
+
+		page, err := SearchObjectMetadata(artifact.ID, projectID, ObjectLabels{"release-candidate": "true"}, "", 1)
+		require.NoError(t, err)
+		require.Len(t, page, 1)
+		assert.Equal(t, "artifacts/jobs/3/report.xml", page[0].Path)
+
+		page, err = SearchObjectMetadata(artifact.ID, projectID, ObjectLabels{"release-candidate": "true"}, page[0].Path, 1)
+		require.NoError(t, err)
+		require.Len(t, page, 1)
+		assert.Equal(t, "artifacts/projects/1/app.sha", page[0].Path)
+
+		page, err = SearchObjectMetadata(artifact.ID, uuid.NewV4().String(), ObjectLabels{"release-candidate": "true"}, "", 10)
+		require.NoError(t, err)
+		assert.Empty(t, page)
+	})
+
+	t.Run("copies keep the metadata of the source", func(t *testing.T) {
+		err := CopyObjectMetadata(artifact.ID, "artifacts/jobs/3/report.xml", artifact.ID, "artifacts/projects/1/report.xml")
+		require.NoError(t, err)
+
+		found, err := ListObjectMetadataUnder(artifact.ID, "artifacts/projects/1/", ObjectLabels{"suite": "e2e"})
+		require.NoError(t, err)
+		assert.Contains(t, found, "artifacts/projects/1/report.xml")
+
+		err = CopyObjectMetadata(artifact.ID, "artifacts/jobs/3/unlabeled.xml", artifact.ID, "artifacts/projects/1/report.xml")
+		require.NoError(t, err)
+
+		found, err = ListObjectMetadataUnder(artifact.ID, "artifacts/projects/1/", nil)
+		require.NoError(t, err)
+		assert.NotContains(t, found, "artifacts/projects/1/report.xml")
+	})
+
+	t.Run("deleted paths drop their metadata", func(t *testing.T) {
+		require.NoError(t, DeleteObjectMetadata(artifact.ID, []string{"artifacts/projects/1/app.tar"}))
+		require.NoError(t, DeleteObjectMetadataUnder(artifact.ID, "artifacts/jobs/"))
+
+		found, err := ListObjectMetadataUnder(artifact.ID, "artifacts/", nil)
+		require.NoError(t, err)
+		assert.Len(t, found, 1)
+		assert.Contains(t, found, "artifacts/projects/1/app.sha")
+	})
+}
@@ -0,0 +1,95 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/db"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrStorageQuotaNegativeLimit = errors.New("storage quota limits can't be negative")
+var ErrStorageQuotaSoftLimitTooHigh = errors.New("storage quota soft limit can't be higher than the hard limit")
+
+// StorageQuota limits the number of bytes an organization can store in its artifact buckets.
+// A limit of zero means there is no limit.
+type StorageQuota struct {
+	OrgID     uuid.UUID `gorm:"primary_key"`
+	SoftLimit int64
+	HardLimit int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func SaveStorageQuota(orgID uuid.UUID, softLimit, hardLimit int64) (*StorageQuota, error) {
+	now := time.Now()
+	q := &StorageQuota{
+		OrgID:     orgID,
+		SoftLimit: softLimit,
+		HardLimit: hardLimit,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	if err := q.Validate(); err != nil {
+		return nil, err
+	}
+
+	err := db.Conn().Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "org_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"soft_limit", "hard_limit", "updated_at"}),
+	}).Create(q).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return FindStorageQuota(orgID)
+}
+
+func FindStorageQuota(orgID uuid.UUID) (*StorageQuota, error) {
+	q := &StorageQuota{}
+
+	err := db.Conn().Where("org_id = ?", orgID.String()).First(q).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+// FindStorageQuotaOrReturnNil returns nil for organizations without a quota.
+func FindStorageQuotaOrReturnNil(orgID uuid.UUID) (*StorageQuota, error) {
+	q, err := FindStorageQuota(orgID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return q, nil
+}
+
+func (q *StorageQuota) Validate() error {
+	if q.SoftLimit < 0 || q.HardLimit < 0 {
+		return ErrStorageQuotaNegativeLimit
+	}
+
+	if q.HardLimit > 0 && q.SoftLimit > q.HardLimit {
+		return ErrStorageQuotaSoftLimitTooHigh
+	}
+
+	return nil
+}
+
+func (q *StorageQuota) IsHardLimitExceeded(size int64) bool {
+	return q.HardLimit > 0 && size >= q.HardLimit
+}
+
+func (q *StorageQuota) IsSoftLimitExceeded(size int64) bool {
+	return q.SoftLimit > 0 && size >= q.SoftLimit
+}
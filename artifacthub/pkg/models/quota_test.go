@@ -0,0 +1,62 @@
+package models
+
+import (
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func Test__StorageQuotaModel(t *testing.T) {
+	PrepareDatabaseForTests()
+
+	orgID := uuid.NewV4()
+
+	t.Run("organizations without a quota", func(t *testing.T) {
+		_, err := FindStorageQuota(orgID)
+		assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+
+		q, err := FindStorageQuotaOrReturnNil(orgID)
+		assert.NoError(t, err)
+		assert.Nil(t, q)
+	})
+
+	t.Run("creating and updating a quota", func(t *testing.T) {
+		q, err := SaveStorageQuota(orgID, 100, 200)
+		require.NoError(t, err)
+		assert.Equal(t, orgID, q.OrgID)
+		assert.Equal(t, int64(100), q.SoftLimit)
+		assert.Equal(t, int64(200), q.HardLimit)
+
+		q, err = SaveStorageQuota(orgID, 0, 300)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), q.SoftLimit)
+		assert.Equal(t, int64(300), q.HardLimit)
+	})
+
+	t.Run("invalid limits", func(t *testing.T) {
+		_, err := SaveStorageQuota(orgID, -1, 200)
+		assert.ErrorIs(t, err, ErrStorageQuotaNegativeLimit)
+
+		_, err = SaveStorageQuota(orgID, 300, 200)
+		assert.ErrorIs(t, err, ErrStorageQuotaSoftLimitTooHigh)
+
+		q, err := FindStorageQuota(orgID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(300), q.HardLimit)
+	})
+
+	t.Run("checking limits", func(t *testing.T) {
+		q := &StorageQuota{SoftLimit: 100, HardLimit: 200}
+		assert.False(t, q.IsSoftLimitExceeded(99))
+		assert.True(t, q.IsSoftLimitExceeded(100))
+		assert.False(t, q.IsHardLimitExceeded(199))
+		assert.True(t, q.IsHardLimitExceeded(200))
+
+		unlimited := &StorageQuota{}
+		assert.False(t, unlimited.IsSoftLimitExceeded(1<<40))
+		assert.False(t, unlimited.IsHardLimitExceeded(1<<40))
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	doublestar "github.com/bmatcuk/doublestar/v4"
@@ -13,13 +14,27 @@ import (
 	"gorm.io/gorm"
 )
 
-const MaxRetentionPolicyRules = 10
+const MaxRetentionPolicyRules = 20
 const MaxRetentionPolicySelectorLenght = 100
 const MinRetentionPolicyAge = 24 * 3600 // one day
+const MaxRetentionPolicyKeepLast = 1000
+const MaxRetentionPolicyExclusions = 10
+
+// Rule kinds. Rules without a kind were created before kinds existed, and are age rules.
+const (
+	RetentionRuleKindAge      = "age"       // deletes objects older than Age
+	RetentionRuleKindKeepLast = "keep_last" // keeps only the newest KeepLast workflow directories
+	RetentionRuleKindMaxSize  = "max_size"  // deletes the oldest objects once they take more than MaxSize bytes
+)
 
 var ErrRetentionPolicyTooLong = fmt.Errorf("retention policy must have less than %d rules", MaxRetentionPolicyRules)
 var ErrRetentionPolicySelectorTooLong = fmt.Errorf("retention policy selector length must be less than %d long", MaxRetentionPolicySelectorLenght)
 var ErrRetentionPolicyAgeTooShort = fmt.Errorf("retention policy age can't be shorter than a day")
+var ErrRetentionPolicyUnknownKind = fmt.Errorf("retention policy rule kind must be one of %s, %s or %s", RetentionRuleKindAge, RetentionRuleKindKeepLast, RetentionRuleKindMaxSize)
+var ErrRetentionPolicyKeepLastOutOfRange = fmt.Errorf("retention policy keep last must be between 1 and %d", MaxRetentionPolicyKeepLast)
+var ErrRetentionPolicyMaxSizeTooSmall = fmt.Errorf("retention policy max size must be positive")
+var ErrRetentionPolicyTooManyExclusions = fmt.Errorf("retention policy rule can exclude at most %d branches and %d tags", MaxRetentionPolicyExclusions, MaxRetentionPolicyExclusions)
+var ErrRetentionPolicyWorkflowLevelOnly = fmt.Errorf("keep last rules and branch or tag exclusions are supported only on the workflow level")
 
 type RetentionPolicy struct {
 	ID         uuid.UUID `gorm:"primary_key;default:uuid_generate_v4()"`
@@ -127,31 +142,87 @@ func (r *RetentionPolicy) Validate() error {
 		return err
 	}
 
+	// Projects and jobs don't belong to a single workflow,
+	// so workflow directories and git refs are known only on the workflow level.
+	for _, rules := range []RetentionPolicyRules{r.ProjectLevelPolicies, r.JobLevelPolicies} {
+		for _, rule := range rules.Rules {
+			if rule.RuleKind() == RetentionRuleKindKeepLast || rule.HasExclusions() {
+				return ErrRetentionPolicyWorkflowLevelOnly
+			}
+		}
+	}
+
 	return nil
 }
 
+// IsMatching returns if the object is older than the age rule applying to it.
+// Rules depending on the other objects in the bucket, or on the workflow git refs,
+// are evaluated by the retention package.
 func (r *RetentionPolicy) IsMatching(path string, age time.Duration) bool {
-	for _, p := range r.ProjectLevelPolicies.Rules {
-		if p.isMatching("artifacts/projects/**", path) {
-			return age > time.Duration(p.Age)*time.Second
+	rule, _ := r.RuleFor(path, "")
+	if rule == nil || rule.RuleKind() != RetentionRuleKindAge {
+		return false
+	}
+
+	return age > time.Duration(rule.Age)*time.Second
+}
+
+// RuleFor returns the first rule applying to the path, and a key identifying the rule in the policy.
+// Rules excluding the git ref of the workflow the path belongs to are skipped.
+func (r *RetentionPolicy) RuleFor(path, gitRef string) (*RetentionPolicyRuleItem, string) {
+	levels := []struct {
+		name  string
+		rules []RetentionPolicyRuleItem
+	}{
+		{name: "projects", rules: r.ProjectLevelPolicies.Rules},
+		{name: "workflows", rules: r.WorkflowLevelPolicies.Rules},
+		{name: "jobs", rules: r.JobLevelPolicies.Rules},
+	}
+
+	for _, level := range levels {
+		for i := range level.rules {
+			rule := &level.rules[i]
+			if !rule.isMatching("artifacts/"+level.name+"/**", path) || rule.excludes(gitRef) {
+				continue
+			}
+
+			return rule, fmt.Sprintf("%s/%d", level.name, i)
 		}
 	}
 
-	for _, p := range r.WorkflowLevelPolicies.Rules {
-		if p.isMatching("artifacts/workflows/**", path) {
-			return age > time.Duration(p.Age)*time.Second
+	return nil, ""
+}
+
+// HasPlannedRules returns if the policy has rules that can be evaluated
+// only after going through all the objects in the bucket.
+func (r *RetentionPolicy) HasPlannedRules() bool {
+	for _, rule := range r.allRules() {
+		if rule.RuleKind() != RetentionRuleKindAge {
+			return true
 		}
 	}
 
-	for _, p := range r.JobLevelPolicies.Rules {
-		if p.isMatching("artifacts/jobs/**", path) {
-			return age > time.Duration(p.Age)*time.Second
+	return false
+}
+
+// HasExclusions returns if the policy needs the git refs of workflows to be evaluated.
+func (r *RetentionPolicy) HasExclusions() bool {
+	for _, rule := range r.allRules() {
+		if rule.HasExclusions() {
+			return true
 		}
 	}
 
 	return false
 }
 
+func (r *RetentionPolicy) allRules() []RetentionPolicyRuleItem {
+	rules := []RetentionPolicyRuleItem{}
+	rules = append(rules, r.ProjectLevelPolicies.Rules...)
+	rules = append(rules, r.WorkflowLevelPolicies.Rules...)
+	return append(rules, r.JobLevelPolicies.Rules...)
+}
+
 func (r *RetentionPolicy) Reload() error {
 	return db.Conn().Where("artifact_id = ?", r.ArtifactID.String()).First(r).Error
 }
@@ -176,8 +247,29 @@ type RetentionPolicyRules struct {
 }
 
 type RetentionPolicyRuleItem struct {
+	Kind     string `json:"kind,omitempty"`
 	Selector string `json:"selector"`
 	Age      int    `json:"age"`
+
+	KeepLast int   `json:"keep_last,omitempty"`
+	MaxSize  int64 `json:"max_size,omitempty"`
+
+	// Objects of workflows running on these branches or tags are skipped by the rule,
+	// and the next rules are evaluated for them. Patterns can use wildcards, e.g. release/*.
+	ExcludeBranches []string `json:"exclude_branches,omitempty"`
+	ExcludeTags     []string `json:"exclude_tags,omitempty"`
+}
+
+func (i *RetentionPolicyRuleItem) RuleKind() string {
+	if i.Kind == "" {
+		return RetentionRuleKindAge
+	}
+
+	return i.Kind
+}
+
+func (i *RetentionPolicyRuleItem) HasExclusions() bool {
+	return len(i.ExcludeBranches) > 0 || len(i.ExcludeTags) > 0
 }
 
 func (i *RetentionPolicyRuleItem) isMatching(rulePrefix string, path string) bool {
@@ -189,6 +281,34 @@ func (i *RetentionPolicyRuleItem) isMatching(rulePrefix string, path string) boo
 	return ismatching
 }
 
+// Tags are referenced as refs/tags/<tag>, and pull requests as refs/pull/<number>.
+// Everything else is a branch name.
+func (i *RetentionPolicyRuleItem) excludes(gitRef string) bool {
+	if gitRef == "" {
+		return false
+	}
+
+	if tag, ok := strings.CutPrefix(gitRef, "refs/tags/"); ok {
+		return matchesAny(i.ExcludeTags, tag)
+	}
+
+	if strings.HasPrefix(gitRef, "refs/pull/") {
+		return false
+	}
+
+	return matchesAny(i.ExcludeBranches, gitRef)
+}
+
+func matchesAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, err := doublestar.Match(pattern, name); err == nil && ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (r RetentionPolicyRules) Value() (driver.Value, error) {
 	return json.Marshal(r)
 }
@@ -212,8 +332,36 @@ func (r *RetentionPolicyRules) Validate() error {
 			return ErrRetentionPolicySelectorTooLong
 		}
 
-		if rule.Age < MinRetentionPolicyAge {
-			return ErrRetentionPolicyAgeTooShort
+		if len(rule.ExcludeBranches) > MaxRetentionPolicyExclusions || len(rule.ExcludeTags) > MaxRetentionPolicyExclusions {
+			return ErrRetentionPolicyTooManyExclusions
+		}
+
+		for _, patterns := range [][]string{rule.ExcludeBranches, rule.ExcludeTags} {
+			for _, pattern := range patterns {
+				if len(pattern) > MaxRetentionPolicySelectorLenght {
+					return ErrRetentionPolicySelectorTooLong
+				}
+			}
+		}
+
+		switch rule.RuleKind() {
+		case RetentionRuleKindAge:
+			if rule.Age < MinRetentionPolicyAge {
+				return ErrRetentionPolicyAgeTooShort
+			}
+
+		case RetentionRuleKindKeepLast:
+			if rule.KeepLast < 1 || rule.KeepLast > MaxRetentionPolicyKeepLast {
+				return ErrRetentionPolicyKeepLastOutOfRange
+			}
+
+		case RetentionRuleKindMaxSize:
+			if rule.MaxSize <= 0 {
+				return ErrRetentionPolicyMaxSizeTooSmall
+			}
+
+		default:
+			return ErrRetentionPolicyUnknownKind
 		}
 	}
 
@@ -2,11 +2,13 @@ package models
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func Test__RetentionPoliciesModel(t *testing.T) {
@@ -88,12 +90,16 @@ func Test__RetentionPoliciesModel(t *testing.T) {
 				{Selector: "/**", Age: 7 * 24 * 3600},
 				{Selector: "/**", Age: 7 * 24 * 3600},
 				{Selector: "/**", Age: 7 * 24 * 3600},
+				{Selector: "/**", Age: 7 * 24 * 3600},
+				{Selector: "/**", Age: 7 * 24 * 3600},
+				{Selector: "/**", Age: 7 * 24 * 3600},
+				{Selector: "/**", Age: 7 * 24 * 3600},
 			},
 		}
 
 		_, err := UpdateRetentionPolicy(a.ID, rules, workflowRules, jobRules)
 		assert.NotNil(t, err)
-		assert.Equal(t, "retention policy must have less than 10 rules", err.Error())
+		assert.Equal(t, "retention policy must have less than 20 rules", err.Error())
 	})
 
 	t.Run("creating policy with small age", func(t *testing.T) {
@@ -186,3 +192,101 @@ func Test__RetentionPolicyMatching(t *testing.T) {
 		assert.True(t, policy.IsMatching(jobPath("/job-examples/a.txt"), daysAgo(4)))
 	})
 }
+
+func Test__RetentionPolicyRuleValidation(t *testing.T) {
+	oneDay := 24 * 3600
+
+	testCases := []struct {
+		name string
+		rule RetentionPolicyRuleItem
+		err  error
+	}{
+		{name: "rules without a kind are age rules", rule: RetentionPolicyRuleItem{Selector: "/**", Age: oneDay}},
+		{name: "short age", rule: RetentionPolicyRuleItem{Kind: RetentionRuleKindAge, Selector: "/**", Age: 12}, err: ErrRetentionPolicyAgeTooShort},
+		{name: "keep last", rule: RetentionPolicyRuleItem{Kind: RetentionRuleKindKeepLast, Selector: "/**", KeepLast: 5}},
+		{name: "keep last of zero", rule: RetentionPolicyRuleItem{Kind: RetentionRuleKindKeepLast, Selector: "/**"}, err: ErrRetentionPolicyKeepLastOutOfRange},
+		{name: "keep last too high", rule: RetentionPolicyRuleItem{Kind: RetentionRuleKindKeepLast, Selector: "/**", KeepLast: 1001}, err: ErrRetentionPolicyKeepLastOutOfRange},
+		{name: "max size", rule: RetentionPolicyRuleItem{Kind: RetentionRuleKindMaxSize, Selector: "/**", MaxSize: 1024}},
+		{name: "max size of zero", rule: RetentionPolicyRuleItem{Kind: RetentionRuleKindMaxSize, Selector: "/**"}, err: ErrRetentionPolicyMaxSizeTooSmall},
+		{name: "unknown kind", rule: RetentionPolicyRuleItem{Kind: "newest", Selector: "/**"}, err: ErrRetentionPolicyUnknownKind},
+		{
+			name: "too many exclusions",
+			rule: RetentionPolicyRuleItem{Selector: "/**", Age: oneDay, ExcludeBranches: make([]string, 11)},
+			err:  ErrRetentionPolicyTooManyExclusions,
+		},
+		{
+			name: "long exclusion",
+			rule: RetentionPolicyRuleItem{Selector: "/**", Age: oneDay, ExcludeTags: []string{strings.Repeat("v", 101)}},
+			err:  ErrRetentionPolicySelectorTooLong,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policy := RetentionPolicy{WorkflowLevelPolicies: RetentionPolicyRules{Rules: []RetentionPolicyRuleItem{tc.rule}}}
+			assert.Equal(t, tc.err, policy.Validate())
+		})
+	}
+
+	t.Run("keep last rules are rejected on the project level", func(t *testing.T) {
+		policy := RetentionPolicy{ProjectLevelPolicies: RetentionPolicyRules{Rules: []RetentionPolicyRuleItem{
+			{Kind: RetentionRuleKindKeepLast, Selector: "/**", KeepLast: 5},
+		}}}
+
+		assert.Equal(t, ErrRetentionPolicyWorkflowLevelOnly, policy.Validate())
+	})
+
+	t.Run("exclusions are rejected on the job level", func(t *testing.T) {
+		policy := RetentionPolicy{JobLevelPolicies: RetentionPolicyRules{Rules: []RetentionPolicyRuleItem{
+			{Selector: "/**", Age: oneDay, ExcludeBranches: []string{"main"}},
+		}}}
+
+		assert.Equal(t, ErrRetentionPolicyWorkflowLevelOnly, policy.Validate())
+	})
+}
+
+func Test__RetentionPolicyRuleFor(t *testing.T) {
+	policy := RetentionPolicy{
+		WorkflowLevelPolicies: RetentionPolicyRules{
+			Rules: []RetentionPolicyRuleItem{
+				{Selector: "/**", Age: 24 * 3600, ExcludeBranches: []string{"main", "release/*"}, ExcludeTags: []string{"v*"}},
+				{Kind: RetentionRuleKindKeepLast, Selector: "/**", KeepLast: 10},
+			},
+		},
+	}
+
+	path := "artifacts/workflows/w1/a.txt"
+
+	testCases := []struct {
+		gitRef string
+		key    string
+	}{
+		{gitRef: "", key: "workflows/0"},
+		{gitRef: "feature", key: "workflows/0"},
+		{gitRef: "main", key: "workflows/1"},
+		{gitRef: "release/1.0", key: "workflows/1"},
+		{gitRef: "refs/tags/v1.0", key: "workflows/1"},
+		{gitRef: "refs/tags/nightly", key: "workflows/0"},
+		{gitRef: "refs/pull/12", key: "workflows/0"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.gitRef, func(t *testing.T) {
+			rule, key := policy.RuleFor(path, tc.gitRef)
+			require.NotNil(t, rule)
+			assert.Equal(t, tc.key, key)
+		})
+	}
+
+	t.Run("paths outside of the levels don't match", func(t *testing.T) {
+		rule, key := policy.RuleFor("agent/jobs/j1/job_logs.txt.gz", "main")
+		assert.Nil(t, rule)
+		assert.Empty(t, key)
+	})
+
+	t.Run("policies with keep last rules are planned", func(t *testing.T) {
+		assert.True(t, policy.HasPlannedRules())
+		assert.True(t, policy.HasExclusions())
+		assert.False(t, (&RetentionPolicy{}).HasPlannedRules())
+	})
+}
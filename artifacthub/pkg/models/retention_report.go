@@ -0,0 +1,170 @@
+package models
+
+import (
+	"errors"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/db"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// MaxRetentionReportsPerArtifact is how many cleaning pass reports are kept for every artifact store.
+const MaxRetentionReportsPerArtifact = 30
+
+// RetentionReport records what a single cleaning pass of the bucket cleaner deleted.
+// A pass can span multiple clean requests, so the report stays open until the last page is visited.
+type RetentionReport struct {
+	ID         uuid.UUID `gorm:"primary_key;default:uuid_generate_v4()"`
+	ArtifactID uuid.UUID
+
+	StartedAt  time.Time
+	FinishedAt *time.Time
+
+	VisitedCount int64
+	DeletedCount int64
+	DeletedSize  int64
+}
+
+type RetentionReportObject struct {
+	ReportID uuid.UUID `gorm:"primary_key"`
+	Path     string    `gorm:"primary_key"`
+	Size     int64
+	Age      int64 // age in seconds at the time of deletion
+}
+
+// StartRetentionReportWithTx opens a report for a new cleaning pass.
+func StartRetentionReportWithTx(tx *gorm.DB, artifactID uuid.UUID) (*RetentionReport, error) {
+	r := &RetentionReport{ArtifactID: artifactID, StartedAt: time.Now()}
+
+	err := tx.Create(r).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// FindOpenRetentionReportWithTx returns the report of the cleaning pass in progress,
+// or opens a new one if there is none.
+func FindOpenRetentionReportWithTx(tx *gorm.DB, artifactID uuid.UUID) (*RetentionReport, error) {
+	r := &RetentionReport{}
+
+	err := tx.Where("artifact_id = ? AND finished_at IS NULL", artifactID.String()).
+		Order("started_at DESC").
+		First(r).
+		Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return StartRetentionReportWithTx(tx, artifactID)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// AddPageWithTx records a page of visited objects, and the objects deleted from it.
+func (r *RetentionReport) AddPageWithTx(tx *gorm.DB, visited int, deleted []RetentionReportObject) error {
+	var deletedSize int64
+	for i := range deleted {
+		deleted[i].ReportID = r.ID
+		deletedSize += deleted[i].Size
+	}
+
+	if len(deleted) > 0 {
+		err := tx.Clauses(clause.OnConflict{DoNothing: true}).CreateInBatches(deleted, 500).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	err := tx.Model(r).Updates(map[string]interface{}{
+		"visited_count": gorm.Expr("visited_count + ?", visited),
+		"deleted_count": gorm.Expr("deleted_count + ?", len(deleted)),
+		"deleted_size":  gorm.Expr("deleted_size + ?", deletedSize),
+	}).Error
+
+	if err != nil {
+		return err
+	}
+
+	r.VisitedCount += int64(visited)
+	r.DeletedCount += int64(len(deleted))
+	r.DeletedSize += deletedSize
+	return nil
+}
+
+// FinishWithTx closes the report, and drops the oldest reports of the artifact store
+// so only the last MaxRetentionReportsPerArtifact are kept.
+func (r *RetentionReport) FinishWithTx(tx *gorm.DB) error {
+	now := time.Now()
+
+	err := tx.Model(r).Update("finished_at", now).Error
+	if err != nil {
+		return err
+	}
+
+	r.FinishedAt = &now
+
+	kept := tx.Model(&RetentionReport{}).
+		Select("id").
+		Where("artifact_id = ?", r.ArtifactID.String()).
+		Order("started_at DESC").
+		Limit(MaxRetentionReportsPerArtifact)
+
+	return tx.Where("artifact_id = ? AND id NOT IN (?)", r.ArtifactID.String(), kept).
+		Delete(&RetentionReport{}).
+		Error
+}
+
+// ListRetentionReports returns the reports of an artifact store, newest first.
+func ListRetentionReports(artifactID uuid.UUID, limit int) ([]RetentionReport, error) {
+	reports := []RetentionReport{}
+
+	err := db.Conn().
+		Where("artifact_id = ?", artifactID.String()).
+		Order("started_at DESC").
+		Limit(limit).
+		Find(&reports).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return reports, nil
+}
+
+func FindRetentionReport(reportID uuid.UUID) (*RetentionReport, error) {
+	r := &RetentionReport{}
+
+	err := db.Conn().Where("id = ?", reportID.String()).First(r).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// ListRetentionReportObjects returns the objects deleted in a cleaning pass ordered by path,
+// starting after the given path, so the last path of a page can be used to fetch the next one.
+func ListRetentionReportObjects(reportID uuid.UUID, after string, limit int) ([]RetentionReportObject, error) {
+	objects := []RetentionReportObject{}
+
+	err := db.Conn().
+		Where("report_id = ? AND path > ?", reportID.String(), after).
+		Order("path ASC").
+		Limit(limit).
+		Find(&objects).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return objects, nil
+}
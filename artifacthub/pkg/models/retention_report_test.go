@@ -0,0 +1,76 @@
+package models
+
+import (
+	"fmt"
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__RetentionReportModel(t *testing.T) {
+	PrepareDatabaseForTests()
+
+	artifact, err := CreateArtifact(uuid.NewV4().String(), uuid.NewV4().String())
+	require.NoError(t, err)
+
+	t.Run("a pass spanning multiple pages records a single report", func(t *testing.T) {
+		report, err := StartRetentionReportWithTx(db.Conn(), artifact.ID)
+		require.NoError(t, err)
+		require.NoError(t, report.AddPageWithTx(db.Conn(), 3, []RetentionReportObject{{Path: "artifacts/jobs/1/a.txt", Size: 10}}))
+
+		open, err := FindOpenRetentionReportWithTx(db.Conn(), artifact.ID)
+		require.NoError(t, err)
+		assert.Equal(t, report.ID, open.ID)
+		require.NoError(t, open.AddPageWithTx(db.Conn(), 2, []RetentionReportObject{{Path: "artifacts/jobs/2/a.txt", Size: 5}}))
+		require.NoError(t, open.FinishWithTx(db.Conn()))
+
+		found, err := FindRetentionReport(report.ID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), found.VisitedCount)
+		assert.Equal(t, int64(2), found.DeletedCount)
+		assert.Equal(t, int64(15), found.DeletedSize)
+		assert.NotNil(t, found.FinishedAt)
+
+		objects, err := ListRetentionReportObjects(report.ID, "", 10)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+		assert.Equal(t, "artifacts/jobs/1/a.txt", objects[0].Path)
+
+		objects, err = ListRetentionReportObjects(report.ID, "artifacts/jobs/1/a.txt", 10)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		assert.Equal(t, "artifacts/jobs/2/a.txt", objects[0].Path)
+	})
+
+	t.Run("a new report is opened when no pass is in progress", func(t *testing.T) {
+		open, err := FindOpenRetentionReportWithTx(db.Conn(), artifact.ID)
+		require.NoError(t, err)
+		assert.Nil(t, open.FinishedAt)
+		require.NoError(t, open.FinishWithTx(db.Conn()))
+
+		reports, err := ListRetentionReports(artifact.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, reports, 2)
+		assert.Equal(t, open.ID, reports[0].ID)
+	})
+
+	t.Run("only the last reports are kept", func(t *testing.T) {
+		for i := 0; i < MaxRetentionReportsPerArtifact+5; i++ {
+			report, err := StartRetentionReportWithTx(db.Conn(), artifact.ID)
+			require.NoError(t, err)
+			require.NoError(t, report.AddPageWithTx(db.Conn(), 1, []RetentionReportObject{{Path: fmt.Sprintf("artifacts/jobs/%d/a.txt", i)}}))
+			require.NoError(t, report.FinishWithTx(db.Conn()))
+		}
+
+		reports, err := ListRetentionReports(artifact.ID, 100)
+		require.NoError(t, err)
+		assert.Len(t, reports, MaxRetentionReportsPerArtifact)
+
+		count := int64(0)
+		require.NoError(t, db.Conn().Model(&RetentionReportObject{}).Count(&count).Error)
+		assert.Equal(t, int64(MaxRetentionReportsPerArtifact), count)
+	})
+}
@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/db"
+	"gorm.io/gorm/clause"
+)
+
+// RevokedToken is a token which can't be used anymore, even if it didn't expire yet.
+// It is kept only until the token would expire anyway.
+type RevokedToken struct {
+	ID        uuid.UUID `gorm:"primary_key"`
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// RevokeToken adds the token to the revocation list, and drops the
+// tokens from the list which expired in the meantime.
+func RevokeToken(id uuid.UUID, expiresAt time.Time) error {
+	now := time.Now()
+	t := &RevokedToken{ID: id, ExpiresAt: expiresAt, CreatedAt: now}
+
+	err := db.Conn().Clauses(clause.OnConflict{DoNothing: true}).Create(t).Error
+	if err != nil {
+		return err
+	}
+
+	return db.Conn().Where("expires_at < ?", now).Delete(&RevokedToken{}).Error
+}
+
+func IsTokenRevoked(id uuid.UUID) (bool, error) {
+	var count int64
+
+	err := db.Conn().Model(&RevokedToken{}).Where("id = ?", id.String()).Count(&count).Error
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,142 @@
+package models
+
+import (
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/db"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	UsageCategoryProject  = "projects"
+	UsageCategoryWorkflow = "workflows"
+	UsageCategoryJob      = "jobs"
+)
+
+// ArtifactUsage is the number of bytes and objects stored
+// for a project, workflow or job in an artifact bucket.
+// The rows are refreshed periodically by the usage scanner.
+type ArtifactUsage struct {
+	ID          uuid.UUID `gorm:"primary_key;default:uuid_generate_v4()"`
+	ArtifactID  uuid.UUID
+	Category    string
+	CategoryID  string
+	Size        int64
+	ObjectCount int64
+	ScannedAt   time.Time
+}
+
+// ReplaceArtifactUsage replaces the usage of an artifact with the result of its latest scan.
+func ReplaceArtifactUsage(artifactID uuid.UUID, usage []ArtifactUsage, scannedAt time.Time) error {
+	return db.Conn().Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("artifact_id = ?", artifactID.String()).Delete(&ArtifactUsage{}).Error
+		if err != nil {
+			return err
+		}
+
+		for i := range usage {
+			usage[i].ArtifactID = artifactID
+			usage[i].ScannedAt = scannedAt
+		}
+
+		if len(usage) > 0 {
+			err = tx.CreateInBatches(usage, 100).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(&Artifact{}).
+			Where("id = ?", artifactID.String()).
+			Update("usage_scanned_at", scannedAt).
+			Error
+	})
+}
+
+func ListArtifactUsage(artifactID uuid.UUID) ([]ArtifactUsage, error) {
+	usage := []ArtifactUsage{}
+
+	err := db.Conn().
+		Where("artifact_id = ?", artifactID.String()).
+		Order("category, category_id").
+		Find(&usage).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+func ListOrganizationUsage(orgID uuid.UUID) ([]ArtifactUsage, error) {
+	usage := []ArtifactUsage{}
+
+	err := db.Conn().
+		Joins("JOIN artifacts ON artifacts.id = artifact_usages.artifact_id").
+		Where("artifacts.org_id = ?", orgID.String()).
+		Order("artifact_usages.artifact_id, artifact_usages.category, artifact_usages.category_id").
+		Find(&usage).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+// OrganizationUsageSize returns the number of bytes stored in all the artifact buckets of an organization.
+func OrganizationUsageSize(orgID uuid.UUID) (int64, error) {
+	var size int64
+
+	err := db.Conn().
+		Table("artifact_usages").
+		Joins("JOIN artifacts ON artifacts.id = artifact_usages.artifact_id").
+		Where("artifacts.org_id = ?", orgID.String()).
+		Select("COALESCE(SUM(artifact_usages.size), 0)").
+		Row().
+		Scan(&size)
+
+	return size, err
+}
+
+// FetchForUsageScan locks and returns the artifacts whose usage was not scanned in the given interval,
+// starting with the ones that were never scanned. The artifacts are marked as scanned,
+// so the other scanners running in parallel are not picking them up.
+func FetchForUsageScan(batchSize int, interval time.Duration) ([]Artifact, error) {
+	artifacts := []Artifact{}
+
+	err := db.Conn().Transaction(func(tx *gorm.DB) error {
+		err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("deleted_at IS NULL").
+			Where("usage_scanned_at IS NULL OR usage_scanned_at < ?", time.Now().Add(-interval)).
+			Order("usage_scanned_at NULLS FIRST").
+			Limit(batchSize).
+			Find(&artifacts).
+			Error
+
+		if err != nil || len(artifacts) == 0 {
+			return err
+		}
+
+		ids := []string{}
+		for _, a := range artifacts {
+			ids = append(ids, a.ID.String())
+		}
+
+		return tx.Model(&Artifact{}).
+			Where("id IN (?)", ids).
+			Update("usage_scanned_at", gorm.Expr("now()")).
+			Error
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return artifacts, nil
+}
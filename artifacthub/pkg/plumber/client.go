@@ -0,0 +1,73 @@
+package plumber
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	plumber_wf "github.com/semaphoreio/semaphore/artifacthub/pkg/api/descriptors/plumber_w_f.workflow"
+	"google.golang.org/genproto/googleapis/rpc/code"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// describeBatchSize is how many workflows are described in a single call.
+const describeBatchSize = 100
+
+const callTimeout = 30 * time.Second
+
+// WorkflowClient looks up workflows in the plumber workflow service.
+type WorkflowClient struct {
+	conn   *grpc.ClientConn
+	client plumber_wf.WorkflowServiceClient
+}
+
+func NewWorkflowClient(endpoint string) (*WorkflowClient, error) {
+	conn, err := grpc.NewClient(endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &WorkflowClient{conn: conn, client: plumber_wf.NewWorkflowServiceClient(conn)}, nil
+}
+
+func (c *WorkflowClient) Close() error {
+	return c.conn.Close()
+}
+
+// FindGitRefs returns the branch names of the given workflows.
+// Tags are named refs/tags/<tag>, and pull requests refs/pull/<number>.
+func (c *WorkflowClient) FindGitRefs(ctx context.Context, workflowIDs []string) (map[string]string, error) {
+	refs := map[string]string{}
+
+	for start := 0; start < len(workflowIDs); start += describeBatchSize {
+		end := min(start+describeBatchSize, len(workflowIDs))
+
+		workflows, err := c.describeMany(ctx, workflowIDs[start:end])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, workflow := range workflows {
+			refs[workflow.WfId] = workflow.BranchName
+		}
+	}
+
+	return refs, nil
+}
+
+func (c *WorkflowClient) describeMany(ctx context.Context, workflowIDs []string) ([]*plumber_wf.WorkflowDetails, error) {
+	ctx, cancel := context.WithTimeout(ctx, callTimeout)
+	defer cancel()
+
+	response, err := c.client.DescribeMany(ctx, &plumber_wf.DescribeManyRequest{WfIds: workflowIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Status != nil && response.Status.Code != code.Code_OK {
+		return nil, fmt.Errorf("failed to describe workflows: %s", response.Status.Message)
+	}
+
+	return response.Workflows, nil
+}
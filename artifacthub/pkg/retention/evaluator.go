@@ -0,0 +1,409 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/storage"
+	pathutil "github.com/semaphoreio/semaphore/artifacthub/pkg/util/path"
+)
+
+const workflowsPrefix = "artifacts/workflows/"
+
+var ErrWorkflowRefsUnavailable = errors.New("retention policy excludes branches or tags, but workflow git refs can't be looked up")
+
+// WorkflowRefs looks up the git refs workflows are running on.
+// Branches are returned by name, tags as refs/tags/<tag>, and pull requests as refs/pull/<number>.
+// Unknown workflows are left out of the result.
+type WorkflowRefs interface {
+	FindGitRefs(ctx context.Context, workflowIDs []string) (map[string]string, error)
+}
+
+// Plan records the decisions of the rules that depend on all the objects in a bucket.
+// It is built once at the start of a cleaning pass, and carried over to its next clean requests.
+type Plan struct {
+	PlannedAt time.Time `json:"planned_at"`
+
+	// Workflow directories kept by keep_last rules, by rule key.
+	KeptWorkflows map[string][]string `json:"kept_workflows,omitempty"`
+
+	// Objects modified at or before the cutoff are evicted by max_size rules, by rule key.
+	Cutoffs map[string]time.Time `json:"cutoffs,omitempty"`
+}
+
+// Evaluator decides which objects a retention policy deletes.
+type Evaluator struct {
+	policy *models.RetentionPolicy
+	refs   WorkflowRefs
+	plan   *Plan
+	now    func() time.Time
+
+	gitRefs      map[string]string
+	keptSets     map[string]map[string]bool
+	lookedUpRefs map[string]bool
+}
+
+func NewEvaluator(policy *models.RetentionPolicy, refs WorkflowRefs) *Evaluator {
+	return &Evaluator{
+		policy:       policy,
+		refs:         refs,
+		now:          time.Now,
+		gitRefs:      map[string]string{},
+		lookedUpRefs: map[string]bool{},
+	}
+}
+
+func (e *Evaluator) NeedsPlan() bool {
+	return e.policy.HasPlannedRules()
+}
+
+func (e *Evaluator) Plan() *Plan {
+	return e.plan
+}
+
+func (e *Evaluator) SetPlan(plan *Plan) {
+	e.plan = plan
+	e.keptSets = nil
+}
+
+// BuildPlan goes through all the objects in the bucket, and decides
+// which workflow directories and objects the keep_last and max_size rules keep.
+// Paths pointing to blobs, given as a path => digest map, count with the size of the blob.
+func (e *Evaluator) BuildPlan(ctx context.Context, bucket storage.Bucket, blobRefs map[string]string) error {
+	now := e.now()
+
+	blobSizes, err := listBlobSizes(bucket, blobRefs)
+	if err != nil {
+		return err
+	}
+
+	rules := map[string]*models.RetentionPolicyRuleItem{}
+	newest := map[string]map[string]time.Time{}
+	sized := map[string][]sizedObject{}
+
+	err = listObjects(bucket, func(objects []*storage.Object) error {
+		if err := e.lookupGitRefs(ctx, objects); err != nil {
+			return err
+		}
+
+		for _, object := range objects {
+			rule, key := e.policy.RuleFor(object.Path, e.gitRef(object.Path))
+			if rule == nil {
+				continue
+			}
+
+			rules[key] = rule
+			modified := now.Add(-*object.Age)
+
+			switch rule.RuleKind() {
+			case models.RetentionRuleKindKeepLast:
+				workflowID, ok := workflowIDFromPath(object.Path)
+				if !ok {
+					continue
+				}
+
+				if newest[key] == nil {
+					newest[key] = map[string]time.Time{}
+				}
+
+				if modified.After(newest[key][workflowID]) {
+					newest[key][workflowID] = modified
+				}
+
+			case models.RetentionRuleKindMaxSize:
+				sized[key] = append(sized[key], sizedObject{modified: modified, size: object.Size, digest: blobRefs[object.Path]})
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	plan := &Plan{PlannedAt: now, KeptWorkflows: map[string][]string{}, Cutoffs: map[string]time.Time{}}
+
+	for key, workflows := range newest {
+		plan.KeptWorkflows[key] = newestWorkflows(workflows, rules[key].KeepLast)
+	}
+
+	for key, objects := range sized {
+		objects = resolveBlobSizes(objects, blobSizes)
+		if cutoff, ok := evictionCutoff(objects, rules[key].MaxSize, now); ok {
+			plan.Cutoffs[key] = cutoff
+		}
+	}
+
+	e.SetPlan(plan)
+	return nil
+}
+
+// Match returns the objects the retention policy deletes.
+func (e *Evaluator) Match(ctx context.Context, objects []*storage.Object) ([]*storage.Object, error) {
+	if err := e.lookupGitRefs(ctx, objects); err != nil {
+		return nil, err
+	}
+
+	now := e.now()
+	matched := []*storage.Object{}
+
+	for _, object := range objects {
+		rule, key := e.policy.RuleFor(object.Path, e.gitRef(object.Path))
+		if rule == nil {
+			continue
+		}
+
+		if e.isDeleted(rule, key, object, now.Add(-*object.Age)) {
+			matched = append(matched, object)
+		}
+	}
+
+	return matched, nil
+}
+
+// Objects created after the plan was built are never deleted by planned rules,
+// since the plan didn't account for them.
+func (e *Evaluator) isDeleted(rule *models.RetentionPolicyRuleItem, key string, object *storage.Object, modified time.Time) bool {
+	switch rule.RuleKind() {
+	case models.RetentionRuleKindAge:
+		return *object.Age > time.Duration(rule.Age)*time.Second
+
+	case models.RetentionRuleKindKeepLast:
+		if e.plan == nil || modified.After(e.plan.PlannedAt) {
+			return false
+		}
+
+		workflowID, ok := workflowIDFromPath(object.Path)
+		if !ok {
+			return false
+		}
+
+		return !e.keptWorkflows(key)[workflowID]
+
+	case models.RetentionRuleKindMaxSize:
+		if e.plan == nil {
+			return false
+		}
+
+		cutoff, ok := e.plan.Cutoffs[key]
+		return ok && !modified.After(cutoff)
+	}
+
+	return false
+}
+
+func (e *Evaluator) keptWorkflows(key string) map[string]bool {
+	if e.keptSets == nil {
+		e.keptSets = map[string]map[string]bool{}
+	}
+
+	if set, ok := e.keptSets[key]; ok {
+		return set
+	}
+
+	set := map[string]bool{}
+	for _, id := range e.plan.KeptWorkflows[key] {
+		set[id] = true
+	}
+
+	e.keptSets[key] = set
+	return set
+}
+
+func (e *Evaluator) gitRef(path string) string {
+	workflowID, ok := workflowIDFromPath(path)
+	if !ok {
+		return ""
+	}
+
+	return e.gitRefs[workflowID]
+}
+
+// Git refs are looked up only for policies with exclusions, and only once per workflow.
+func (e *Evaluator) lookupGitRefs(ctx context.Context, objects []*storage.Object) error {
+	if !e.policy.HasExclusions() {
+		return nil
+	}
+
+	if e.refs == nil {
+		return ErrWorkflowRefsUnavailable
+	}
+
+	ids := []string{}
+	for _, object := range objects {
+		workflowID, ok := workflowIDFromPath(object.Path)
+		if !ok || e.lookedUpRefs[workflowID] {
+			continue
+		}
+
+		e.lookedUpRefs[workflowID] = true
+		ids = append(ids, workflowID)
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	refs, err := e.refs.FindGitRefs(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	for id, ref := range refs {
+		e.gitRefs[id] = ref
+	}
+
+	return nil
+}
+
+type sizedObject struct {
+	modified time.Time
+	size     int64
+	digest   string
+}
+
+// Paths pointing to the same blob share its content, which is only freed once all of them are deleted.
+// Objects are evicted oldest first, so the blob size is counted once, for the newest path pointing to it.
+// Paths pointing to blobs that are not stored keep their own size.
+func resolveBlobSizes(objects []sizedObject, blobSizes map[string]int64) []sizedObject {
+	newest := map[string]int{}
+	for i, o := range objects {
+		if _, ok := blobSizes[o.digest]; !ok {
+			continue
+		}
+
+		if j, ok := newest[o.digest]; !ok || o.modified.After(objects[j].modified) {
+			newest[o.digest] = i
+		}
+	}
+
+	for i, o := range objects {
+		j, ok := newest[o.digest]
+		if !ok {
+			continue
+		}
+
+		if i == j {
+			objects[i].size = blobSizes[o.digest]
+		} else {
+			objects[i].size = 0
+		}
+	}
+
+	return objects
+}
+
+// Blobs are only listed if some paths point to them.
+func listBlobSizes(bucket storage.Bucket, blobRefs map[string]string) (map[string]int64, error) {
+	sizes := map[string]int64{}
+	if len(blobRefs) == 0 {
+		return sizes, nil
+	}
+
+	err := listObjectsUnder(bucket, pathutil.BlobPrefix, func(objects []*storage.Object) error {
+		for _, object := range objects {
+			sizes[strings.TrimPrefix(object.Path, pathutil.BlobPrefix)] = object.Size
+		}
+
+		return nil
+	})
+
+	return sizes, err
+}
+
+// Objects are kept newest first until they fit into maxSize.
+// The first object that doesn't fit, and everything older, is evicted.
+//
+// Modification times are derived from object ages, so they shift slightly
+// between listings. The cutoff is put halfway between the last kept object
+// and the first evicted one, so the shift doesn't move objects across it.
+func evictionCutoff(objects []sizedObject, maxSize int64, plannedAt time.Time) (time.Time, bool) {
+	sort.Slice(objects, func(i, j int) bool { return objects[i].modified.After(objects[j].modified) })
+
+	var total int64
+	for i, o := range objects {
+		total += o.size
+		if total <= maxSize {
+			continue
+		}
+
+		if i == 0 {
+			return plannedAt, true
+		}
+
+		kept := objects[i-1].modified
+		return o.modified.Add(kept.Sub(o.modified) / 2), true
+	}
+
+	return time.Time{}, false
+}
+
+func newestWorkflows(workflows map[string]time.Time, keep int) []string {
+	ids := make([]string, 0, len(workflows))
+	for id := range workflows {
+		ids = append(ids, id)
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		if workflows[ids[i]].Equal(workflows[ids[j]]) {
+			return ids[i] < ids[j]
+		}
+
+		return workflows[ids[i]].After(workflows[ids[j]])
+	})
+
+	if len(ids) > keep {
+		ids = ids[:keep]
+	}
+
+	return ids
+}
+
+func workflowIDFromPath(path string) (string, bool) {
+	rest, ok := strings.CutPrefix(path, workflowsPrefix)
+	if !ok {
+		return "", false
+	}
+
+	workflowID, _, ok := strings.Cut(rest, "/")
+	if !ok || workflowID == "" {
+		return "", false
+	}
+
+	return workflowID, true
+}
+
+func listObjects(bucket storage.Bucket, fn func([]*storage.Object) error) error {
+	return listObjectsUnder(bucket, "artifacts/", fn)
+}
+
+func listObjectsUnder(bucket storage.Bucket, path string, fn func([]*storage.Object) error) error {
+	pager, err := bucket.ListObjectsWithPagination(storage.ListOptions{
+		Path:    path,
+		MaxKeys: 1000,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for {
+		objects, nextPageToken, err := pager.NextPage()
+		if err != nil {
+			return err
+		}
+
+		if err := fn(objects); err != nil {
+			return err
+		}
+
+		if nextPageToken == "" {
+			return nil
+		}
+	}
+}
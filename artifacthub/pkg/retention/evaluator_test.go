@@ -0,0 +1,256 @@
+package retention
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeWorkflowRefs struct {
+	refs  map[string]string
+	calls int
+}
+
+func (f *fakeWorkflowRefs) FindGitRefs(ctx context.Context, workflowIDs []string) (map[string]string, error) {
+	f.calls++
+
+	result := map[string]string{}
+	for _, id := range workflowIDs {
+		if ref, ok := f.refs[id]; ok {
+			result[id] = ref
+		}
+	}
+
+	return result, nil
+}
+
+func daysAgo(d int) time.Time {
+	return time.Now().Add(-time.Duration(d*24) * time.Hour)
+}
+
+func newBucket(t *testing.T, objects map[string]time.Time) *storage.InMemoryBucket {
+	client := storage.NewInMemoryStorage()
+	bucket := client.GetBucket(storage.BucketOptions{Name: "retention-bucket"}).(*storage.InMemoryBucket)
+
+	for path, modified := range objects {
+		require.NoError(t, bucket.Add(path, modified))
+	}
+
+	return bucket
+}
+
+func workflowPolicy(rules ...models.RetentionPolicyRuleItem) *models.RetentionPolicy {
+	return &models.RetentionPolicy{WorkflowLevelPolicies: models.RetentionPolicyRules{Rules: rules}}
+}
+
+func matchedPaths(t *testing.T, e *Evaluator, bucket storage.Bucket) []string {
+	paths := []string{}
+
+	err := listObjects(bucket, func(objects []*storage.Object) error {
+		matched, err := e.Match(context.Background(), objects)
+		if err != nil {
+			return err
+		}
+
+		for _, object := range matched {
+			paths = append(paths, object.Path)
+		}
+
+		return nil
+	})
+
+	require.NoError(t, err)
+	sort.Strings(paths)
+	return paths
+}
+
+func Test__KeepLast(t *testing.T) {
+	bucket := newBucket(t, map[string]time.Time{
+		"/workflows/w1/a.txt":     daysAgo(1),
+		"/workflows/w2/a.txt":     daysAgo(2),
+		"/workflows/w2/dir/b.txt": daysAgo(5),
+		"/workflows/w3/a.txt":     daysAgo(3),
+		"/workflows/w4/a.txt":     daysAgo(4),
+		"/projects/p1/a.txt":      daysAgo(10),
+	})
+
+	e := NewEvaluator(workflowPolicy(
+		models.RetentionPolicyRuleItem{Kind: models.RetentionRuleKindKeepLast, Selector: "/**/*", KeepLast: 2},
+	), nil)
+
+	require.True(t, e.NeedsPlan())
+	require.NoError(t, e.BuildPlan(context.Background(), bucket, nil))
+	assert.Equal(t, []string{"w1", "w2"}, e.Plan().KeptWorkflows["workflows/0"])
+
+	t.Run("older workflows are deleted", func(t *testing.T) {
+		assert.Equal(t, []string{
+			"artifacts/workflows/w3/a.txt",
+			"artifacts/workflows/w4/a.txt",
+		}, matchedPaths(t, e, bucket))
+	})
+
+	t.Run("workflows created after the plan are kept", func(t *testing.T) {
+		require.NoError(t, bucket.Add("/workflows/w5/a.txt", time.Now().Add(time.Minute)))
+
+		assert.Equal(t, []string{
+			"artifacts/workflows/w3/a.txt",
+			"artifacts/workflows/w4/a.txt",
+		}, matchedPaths(t, e, bucket))
+	})
+
+	t.Run("nothing is deleted without a plan", func(t *testing.T) {
+		e := NewEvaluator(e.policy, nil)
+		assert.Empty(t, matchedPaths(t, e, bucket))
+	})
+}
+
+func Test__MaxSize(t *testing.T) {
+	bucket := newBucket(t, map[string]time.Time{
+		"/workflows/w1/a.txt": daysAgo(1),
+		"/workflows/w1/b.txt": daysAgo(2),
+		"/workflows/w2/a.txt": daysAgo(3),
+		"/workflows/w3/a.txt": daysAgo(4),
+		"/workflows/w4/a.txt": daysAgo(5),
+	})
+
+	t.Run("oldest objects over the limit are deleted", func(t *testing.T) {
+		e := NewEvaluator(workflowPolicy(
+			models.RetentionPolicyRuleItem{Kind: models.RetentionRuleKindMaxSize, Selector: "/**/*", MaxSize: 3 * 1024},
+		), nil)
+
+		require.NoError(t, e.BuildPlan(context.Background(), bucket, nil))
+
+		assert.Equal(t, []string{
+			"artifacts/workflows/w3/a.txt",
+			"artifacts/workflows/w4/a.txt",
+		}, matchedPaths(t, e, bucket))
+	})
+
+	t.Run("nothing is deleted under the limit", func(t *testing.T) {
+		e := NewEvaluator(workflowPolicy(
+			models.RetentionPolicyRuleItem{Kind: models.RetentionRuleKindMaxSize, Selector: "/**/*", MaxSize: 10 * 1024},
+		), nil)
+
+		require.NoError(t, e.BuildPlan(context.Background(), bucket, nil))
+		assert.Empty(t, e.Plan().Cutoffs)
+		assert.Empty(t, matchedPaths(t, e, bucket))
+	})
+}
+
+func Test__MaxSizeWithBlobs(t *testing.T) {
+	bucket := newBucket(t, map[string]time.Time{
+		"/workflows/w3/a.txt": daysAgo(3),
+	})
+
+	// Paths pushed with a digest are small pointer objects, and the content is stored once as a blob.
+	digest := "3a6eb0790f39ac87c94f3856b2dd2c5d110e6811602261a9a923d3bb23adc8b7"
+	for path, modified := range map[string]time.Time{
+		"artifacts/workflows/w1/a.txt": daysAgo(1),
+		"artifacts/workflows/w2/a.txt": daysAgo(2),
+		"blobs/sha256/" + digest:       daysAgo(2),
+	} {
+		age := time.Since(modified)
+		size := int64(72)
+		if path == "blobs/sha256/"+digest {
+			size = 3 * 1024
+		}
+
+		bucket.Objects = append(bucket.Objects, &storage.PathItem{Path: path, Age: &age, Size: size})
+	}
+
+	blobRefs := map[string]string{
+		"artifacts/workflows/w1/a.txt": digest,
+		"artifacts/workflows/w2/a.txt": digest,
+	}
+
+	e := NewEvaluator(workflowPolicy(
+		models.RetentionPolicyRuleItem{Kind: models.RetentionRuleKindMaxSize, Selector: "/**/*", MaxSize: 3 * 1024},
+	), nil)
+
+	require.NoError(t, e.BuildPlan(context.Background(), bucket, blobRefs))
+
+	// The blob counts once, for the newest path pointing to it.
+	assert.Equal(t, []string{"artifacts/workflows/w3/a.txt"}, matchedPaths(t, e, bucket))
+}
+
+func Test__Exclusions(t *testing.T) {
+	bucket := newBucket(t, map[string]time.Time{
+		"/workflows/w1/a.txt": daysAgo(10),
+		"/workflows/w2/a.txt": daysAgo(10),
+		"/workflows/w3/a.txt": daysAgo(10),
+		"/workflows/w4/a.txt": daysAgo(10),
+	})
+
+	policy := workflowPolicy(
+		models.RetentionPolicyRuleItem{Selector: "/**/*", Age: 24 * 3600, ExcludeBranches: []string{"main"}, ExcludeTags: []string{"v*"}},
+		models.RetentionPolicyRuleItem{Selector: "/**/*", Age: 30 * 24 * 3600},
+	)
+
+	t.Run("workflows on excluded branches and tags fall through to the next rule", func(t *testing.T) {
+		refs := &fakeWorkflowRefs{refs: map[string]string{
+			"w1": "main",
+			"w2": "feature",
+			"w3": "refs/tags/v1.0",
+		}}
+
+		e := NewEvaluator(policy, refs)
+		assert.False(t, e.NeedsPlan())
+
+		assert.Equal(t, []string{
+			"artifacts/workflows/w2/a.txt",
+			"artifacts/workflows/w4/a.txt",
+		}, matchedPaths(t, e, bucket))
+
+		// refs are looked up once per workflow
+		matchedPaths(t, e, bucket)
+		assert.Equal(t, 1, refs.calls)
+	})
+
+	t.Run("policies can't be evaluated without git refs", func(t *testing.T) {
+		e := NewEvaluator(policy, nil)
+
+		objects, _, err := mustPager(t, bucket).NextPage()
+		require.NoError(t, err)
+
+		_, err = e.Match(context.Background(), objects)
+		assert.ErrorIs(t, err, ErrWorkflowRefsUnavailable)
+	})
+}
+
+func Test__PlanSerialization(t *testing.T) {
+	bucket := newBucket(t, map[string]time.Time{
+		"/workflows/w1/a.txt": daysAgo(1),
+		"/workflows/w2/a.txt": daysAgo(2),
+	})
+
+	policy := workflowPolicy(
+		models.RetentionPolicyRuleItem{Kind: models.RetentionRuleKindKeepLast, Selector: "/**/*", KeepLast: 1},
+	)
+
+	e := NewEvaluator(policy, nil)
+	require.NoError(t, e.BuildPlan(context.Background(), bucket, nil))
+
+	raw, err := json.Marshal(e.Plan())
+	require.NoError(t, err)
+
+	plan := &Plan{}
+	require.NoError(t, json.Unmarshal(raw, plan))
+
+	restored := NewEvaluator(policy, nil)
+	restored.SetPlan(plan)
+
+	assert.Equal(t, []string{"artifacts/workflows/w2/a.txt"}, matchedPaths(t, restored, bucket))
+}
+
+func mustPager(t *testing.T, bucket storage.Bucket) storage.ObjectPager {
+	pager, err := bucket.ListObjectsWithPagination(storage.ListOptions{Path: "artifacts/"})
+	require.NoError(t, err)
+	return pager
+}
@@ -0,0 +1,25 @@
+package privateserver
+
+import (
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/api/descriptors/artifacthub"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func marshalObjectMetadataToAPIModel(rows []models.ArtifactObjectMetadata) []*artifacthub.ArtifactMetadata {
+	r := []*artifacthub.ArtifactMetadata{}
+
+	for _, m := range rows {
+		r = append(r, &artifacthub.ArtifactMetadata{
+			Path:       m.Path,
+			ProjectId:  m.ProjectID,
+			WorkflowId: m.WorkflowID,
+			JobId:      m.JobID,
+			Labels:     m.Labels,
+			CreatedAt:  timestamppb.New(m.CreatedAt),
+			UpdatedAt:  timestamppb.New(m.UpdatedAt),
+		})
+	}
+
+	return r
+}
@@ -2,10 +2,13 @@ package privateserver
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"os"
+	"path"
 	"strconv"
+	"strings"
 	"time"
 
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
@@ -14,16 +17,25 @@ import (
 	privateapi "github.com/semaphoreio/semaphore/artifacthub/pkg/api/private"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/jwt"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/retention"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/storage"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/transfer"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/util/log"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
 )
 
 const (
 	defaultMaxReceiveMsgSize = 15 * 1024 * 1024 // 15MB
 	maxTokenDuration         = 24 * time.Hour
+
+	defaultPreviewObjects         = 100
+	defaultRetentionReports       = 10
+	defaultRetentionReportObjects = 100
+	defaultSearchResults          = 100
+	maxPageSize                   = 1000
 )
 
 // Server is a GRPC server that will handle incoming calls.
@@ -31,6 +43,7 @@ type Server struct {
 	Port            int
 	recoveryHandler recovery.RecoveryHandlerFunc
 	StorageClient   storage.Client
+	WorkflowRefs    retention.WorkflowRefs
 	jwtSecret       string
 }
 
@@ -70,7 +83,7 @@ func (s *Server) Create(ctx context.Context,
 	request *artifacthub.CreateRequest) (*artifacthub.CreateResponse, error) {
 	log.Info("[Create] Received", zap.Reflect("request", request))
 
-	a, err := privateapi.CreateArtifact(ctx, s.StorageClient, request.RequestToken)
+	a, err := privateapi.CreateArtifact(ctx, s.StorageClient, request.RequestToken, request.OrgId)
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +147,15 @@ func (s *Server) ListPath(ctx context.Context,
 	log.Debug("[ListPath] Received", zap.Reflect("request", request))
 
 	response := &artifacthub.ListPathResponse{}
-	is, err := privateapi.ListArtifactPath(ctx, s.StorageClient, request.ArtifactId, request.Path, !request.UnwrapDirectories)
+
+	var is []*artifacthub.ListItem
+	var err error
+	if len(request.Labels) > 0 {
+		is, err = privateapi.ListLabeledArtifactPath(ctx, s.StorageClient, request.ArtifactId, request.Path, request.Labels)
+	} else {
+		is, err = privateapi.ListArtifactPath(ctx, s.StorageClient, request.ArtifactId, request.Path, !request.UnwrapDirectories)
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -144,6 +165,39 @@ func (s *Server) ListPath(ctx context.Context,
 	return response, nil
 }
 
+// SearchArtifacts finds the files of a project pushed with all the given labels, ordered by path.
+func (s *Server) SearchArtifacts(ctx context.Context, request *artifacthub.SearchArtifactsRequest) (*artifacthub.SearchArtifactsResponse, error) {
+	log.Info("[SearchArtifacts] Received", zap.Reflect("request", request))
+
+	artifactID, err := uuid.FromString(request.ArtifactId)
+	if err != nil {
+		return nil, log.ErrorCode(codes.InvalidArgument, "artifact bucket ID is malformed", nil)
+	}
+
+	if request.ProjectId == "" {
+		return nil, log.ErrorCode(codes.InvalidArgument, "project ID is required", nil)
+	}
+
+	a, err := models.FindArtifactByID(artifactID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	limit := pageSize(request.PageSize, defaultSearchResults)
+	rows, err := models.SearchObjectMetadata(a.ID, request.ProjectId, request.Labels, request.PageToken, limit)
+	if err != nil {
+		return nil, log.ErrorCode(codes.Internal, "failed to search artifacts", err)
+	}
+
+	response := &artifacthub.SearchArtifactsResponse{Items: marshalObjectMetadataToAPIModel(rows)}
+	if len(rows) == limit {
+		response.NextPageToken = rows[len(rows)-1].Path
+	}
+
+	log.Debug("[SearchArtifacts] Sending", zap.Int("items", len(response.Items)))
+	return response, nil
+}
+
 // DeletePath deletes an object or directory in the given Artifact's bucket given by its ID.
 func (s *Server) DeletePath(ctx context.Context,
 	request *artifacthub.DeletePathRequest) (*artifacthub.DeletePathResponse, error) {
@@ -159,6 +213,68 @@ func (s *Server) DeletePath(ctx context.Context,
 	return response, nil
 }
 
+// CopyPath copies a file or a directory into another path, or into another
+// artifact store of the same organization, and streams the progress of the copy.
+func (s *Server) CopyPath(request *artifacthub.CopyPathRequest, stream artifacthub.ArtifactService_CopyPathServer) error {
+	log.Info("[CopyPath] Received", zap.Reflect("request", request))
+
+	src, err := models.FindArtifactByID(request.SourceArtifactId)
+	if err != nil {
+		return err
+	}
+
+	dst := src
+	if request.DestinationArtifactId != request.SourceArtifactId {
+		dst, err = models.FindArtifactByID(request.DestinationArtifactId)
+		if err != nil {
+			return err
+		}
+	}
+
+	options := transfer.CopyOptions{
+		Source:          src,
+		SourcePath:      request.SourcePath,
+		Destination:     dst,
+		DestinationPath: request.DestinationPath,
+		Force:           request.Force,
+	}
+
+	err = transfer.Copy(stream.Context(), s.StorageClient, options, func(p transfer.Progress) error {
+		return stream.Send(&artifacthub.CopyPathResponse{
+			CopiedCount: int32(p.CopiedCount),
+			CopiedSize:  p.CopiedSize,
+			TotalCount:  int32(p.TotalCount),
+			TotalSize:   p.TotalSize,
+		})
+	})
+
+	if err != nil {
+		return marshalCopyError(err)
+	}
+
+	log.Info("[CopyPath] Finished", zap.String("source", request.SourcePath), zap.String("destination", request.DestinationPath))
+	return nil
+}
+
+func marshalCopyError(err error) error {
+	switch {
+	case errors.Is(err, transfer.ErrPathNotFound), errors.Is(err, transfer.ErrBlobNotUploaded):
+		return log.ErrorCode(codes.NotFound, err.Error(), nil)
+	case errors.Is(err, transfer.ErrInvalidCopyPath):
+		return log.ErrorCode(codes.InvalidArgument, err.Error(), nil)
+	case errors.Is(err, transfer.ErrDestinationExists):
+		return log.ErrorCode(codes.AlreadyExists, err.Error(), nil)
+	case errors.Is(err, transfer.ErrDifferentOrganizations):
+		return log.ErrorCode(codes.PermissionDenied, err.Error(), nil)
+	case errors.Is(err, transfer.ErrNoOrganization):
+		return log.ErrorCode(codes.FailedPrecondition, err.Error(), nil)
+	case errors.Is(err, transfer.ErrStorageQuotaExceeded):
+		return log.ErrorCode(codes.ResourceExhausted, err.Error(), nil)
+	default:
+		return log.ErrorCode(codes.Internal, "failed to copy path", err)
+	}
+}
+
 // Cleanup deletes all expired paths for all Buckets.
 func (s *Server) Cleanup(ctx context.Context,
 	request *artifacthub.CleanupRequest) (*artifacthub.CleanupResponse, error) {
@@ -172,6 +288,10 @@ func (s *Server) GetSignedURL(ctx context.Context,
 
 	response := &artifacthub.GetSignedURLResponse{}
 	url, err := privateapi.GetSignedURL(ctx, s.StorageClient, request.ArtifactId, request.Path, request.Method)
+	if errors.Is(err, transfer.ErrBlobNotUploaded) {
+		return nil, log.WarnCode(codes.NotFound, err.Error(), nil)
+	}
+
 	if err != nil {
 		return nil, err
 	}
@@ -296,6 +416,209 @@ func (s *Server) UpdateRetentionPolicy(ctx context.Context, request *artifacthub
 	return response, nil
 }
 
+// PreviewRetentionPolicy returns what the given retention policy, or the stored one, would delete.
+func (s *Server) PreviewRetentionPolicy(ctx context.Context, request *artifacthub.PreviewRetentionPolicyRequest) (*artifacthub.PreviewRetentionPolicyResponse, error) {
+	log.Info("[PreviewRetentionPolicy] Received", zap.Reflect("request", request))
+
+	artifactID, err := uuid.FromString(request.ArtifactId)
+	if err != nil {
+		return nil, log.ErrorCode(codes.FailedPrecondition, "artifact bucket ID is malformed", nil)
+	}
+
+	a, err := models.FindArtifactByID(artifactID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var policy *models.RetentionPolicy
+	if request.RetentionPolicy != nil {
+		policy = &models.RetentionPolicy{
+			ArtifactID:            a.ID,
+			ProjectLevelPolicies:  marshalRetentionPolicyRuleToModel(request.RetentionPolicy.ProjectLevelRetentionPolicies),
+			WorkflowLevelPolicies: marshalRetentionPolicyRuleToModel(request.RetentionPolicy.WorkflowLevelRetentionPolicies),
+			JobLevelPolicies:      marshalRetentionPolicyRuleToModel(request.RetentionPolicy.JobLevelRetentionPolicies),
+		}
+
+		if err := policy.Validate(); err != nil {
+			return nil, marshalRetentionPolicyUpdateError(err)
+		}
+	} else {
+		policy, err = models.FindRetentionPolicyOrReturnEmpty(a.ID)
+		if err != nil {
+			return nil, log.ErrorCode(codes.Internal, "failed to find retention policy", err)
+		}
+	}
+
+	maxObjects := pageSize(request.MaxObjects, defaultPreviewObjects)
+	if policy.HasExclusions() && s.WorkflowRefs == nil {
+		return nil, log.ErrorCode(codes.FailedPrecondition, retention.ErrWorkflowRefsUnavailable.Error(), nil)
+	}
+
+	response, err := privateapi.PreviewRetentionPolicy(ctx, s.StorageClient, a, policy, s.WorkflowRefs, maxObjects)
+	if err != nil {
+		return nil, log.ErrorCode(codes.Internal, "failed to preview retention policy", err)
+	}
+
+	log.Debug("[PreviewRetentionPolicy] Sending", zap.Reflect("response", response))
+	return response, nil
+}
+
+// ListRetentionReports returns the reports of the last cleaning passes of an artifact store.
+func (s *Server) ListRetentionReports(ctx context.Context, request *artifacthub.ListRetentionReportsRequest) (*artifacthub.ListRetentionReportsResponse, error) {
+	log.Info("[ListRetentionReports] Received", zap.Reflect("request", request))
+
+	artifactID, err := uuid.FromString(request.ArtifactId)
+	if err != nil {
+		return nil, log.ErrorCode(codes.FailedPrecondition, "artifact bucket ID is malformed", nil)
+	}
+
+	reports, err := models.ListRetentionReports(artifactID, pageSize(request.Limit, defaultRetentionReports))
+	if err != nil {
+		return nil, log.ErrorCode(codes.Internal, "failed to list retention reports", err)
+	}
+
+	response := &artifacthub.ListRetentionReportsResponse{Reports: []*artifacthub.RetentionReport{}}
+	for i := range reports {
+		response.Reports = append(response.Reports, marshalRetentionReportModelToAPIModel(&reports[i]))
+	}
+
+	log.Debug("[ListRetentionReports] Sending", zap.Reflect("response", response))
+	return response, nil
+}
+
+// DescribeRetentionReport returns a cleaning pass report, and a page of the objects deleted in it.
+func (s *Server) DescribeRetentionReport(ctx context.Context, request *artifacthub.DescribeRetentionReportRequest) (*artifacthub.DescribeRetentionReportResponse, error) {
+	log.Info("[DescribeRetentionReport] Received", zap.Reflect("request", request))
+
+	reportID, err := uuid.FromString(request.ReportId)
+	if err != nil {
+		return nil, log.ErrorCode(codes.InvalidArgument, "report ID is malformed", nil)
+	}
+
+	report, err := models.FindRetentionReport(reportID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, log.ErrorCode(codes.NotFound, "report not found", nil)
+		}
+
+		return nil, log.ErrorCode(codes.Internal, "failed to find retention report", err)
+	}
+
+	limit := pageSize(request.PageSize, defaultRetentionReportObjects)
+	objects, err := models.ListRetentionReportObjects(report.ID, request.PageToken, limit)
+	if err != nil {
+		return nil, log.ErrorCode(codes.Internal, "failed to list deleted objects", err)
+	}
+
+	response := &artifacthub.DescribeRetentionReportResponse{
+		Report:         marshalRetentionReportModelToAPIModel(report),
+		DeletedObjects: marshalRetentionReportObjectsToAPIModel(objects),
+	}
+
+	if len(objects) == limit {
+		response.NextPageToken = objects[len(objects)-1].Path
+	}
+
+	log.Debug("[DescribeRetentionReport] Sending", zap.Reflect("response", response))
+	return response, nil
+}
+
+// GetUsage returns the bytes stored per project, workflow and job,
+// for an artifact store or for all the artifact stores of an organization.
+func (s *Server) GetUsage(ctx context.Context, request *artifacthub.GetUsageRequest) (*artifacthub.GetUsageResponse, error) {
+	log.Info("[GetUsage] Received", zap.Reflect("request", request))
+
+	var orgID *uuid.UUID
+	var usage []models.ArtifactUsage
+
+	switch {
+	case request.ArtifactId != "":
+		artifactID, err := uuid.FromString(request.ArtifactId)
+		if err != nil {
+			return nil, log.ErrorCode(codes.InvalidArgument, "artifact bucket ID is malformed", nil)
+		}
+
+		a, err := models.FindArtifactByID(artifactID.String())
+		if err != nil {
+			return nil, err
+		}
+
+		usage, err = models.ListArtifactUsage(a.ID)
+		if err != nil {
+			return nil, log.ErrorCode(codes.Internal, "failed to list usage", err)
+		}
+
+		orgID = a.OrgID
+
+	case request.OrgId != "":
+		id, err := uuid.FromString(request.OrgId)
+		if err != nil {
+			return nil, log.ErrorCode(codes.InvalidArgument, "organization ID is malformed", nil)
+		}
+
+		usage, err = models.ListOrganizationUsage(id)
+		if err != nil {
+			return nil, log.ErrorCode(codes.Internal, "failed to list usage", err)
+		}
+
+		orgID = &id
+
+	default:
+		return nil, log.ErrorCode(codes.InvalidArgument, "artifact ID or organization ID is required", nil)
+	}
+
+	var quota *models.StorageQuota
+	if orgID != nil {
+		var err error
+		quota, err = models.FindStorageQuotaOrReturnNil(*orgID)
+		if err != nil {
+			return nil, log.ErrorCode(codes.Internal, "failed to find storage quota", err)
+		}
+	}
+
+	response := marshalUsageModelToAPIModel(usage, quota)
+
+	log.Debug("[GetUsage] Sending", zap.Reflect("response", response))
+	return response, nil
+}
+
+// SetQuota creates or updates the storage quota of an organization.
+func (s *Server) SetQuota(ctx context.Context, request *artifacthub.SetQuotaRequest) (*artifacthub.SetQuotaResponse, error) {
+	log.Info("[SetQuota] Received", zap.Reflect("request", request))
+
+	if request.Quota == nil {
+		return nil, log.ErrorCode(codes.InvalidArgument, "quota is required", nil)
+	}
+
+	orgID, err := uuid.FromString(request.Quota.OrgId)
+	if err != nil {
+		return nil, log.ErrorCode(codes.InvalidArgument, "organization ID is malformed", nil)
+	}
+
+	quota, err := models.SaveStorageQuota(orgID, request.Quota.SoftLimit, request.Quota.HardLimit)
+	if err != nil {
+		return nil, marshalQuotaUpdateError(err)
+	}
+
+	response := &artifacthub.SetQuotaResponse{Quota: marshalQuotaModelToAPIModel(quota)}
+
+	log.Info("[SetQuota] Sending", zap.Reflect("response", response))
+	return response, nil
+}
+
+func (s *Server) SetOrganization(ctx context.Context, request *artifacthub.SetOrganizationRequest) (*artifacthub.SetOrganizationResponse, error) {
+	log.Info("[SetOrganization] Received", zap.Reflect("request", request))
+
+	if err := privateapi.SetArtifactOrganization(request.ArtifactId, request.OrgId); err != nil {
+		return nil, err
+	}
+
+	response := &artifacthub.SetOrganizationResponse{}
+
+	log.Info("[SetOrganization] Sending", zap.Reflect("response", response))
+	return response, nil
+}
+
 func (s *Server) GenerateToken(ctx context.Context, req *artifacthub.GenerateTokenRequest) (*artifacthub.GenerateTokenResponse, error) {
 	log.Info("[GenerateToken] Received", zap.Reflect("request", req))
 
@@ -321,11 +644,24 @@ func (s *Server) GenerateToken(ctx context.Context, req *artifacthub.GenerateTok
 		return nil, log.ErrorCode(codes.InvalidArgument, "invalid duration", nil)
 	}
 
+	operations, err := marshalTokenOperations(req.Operations)
+	if err != nil {
+		return nil, log.ErrorCode(codes.InvalidArgument, "invalid operations", nil)
+	}
+
+	prefix := strings.Trim(req.PathPrefix, "/")
+	if prefix != "" && (path.Clean(prefix) != prefix || strings.HasPrefix(prefix, "..")) {
+		return nil, log.ErrorCode(codes.InvalidArgument, "invalid path prefix", nil)
+	}
+
 	claims := jwt.Claims{
+		ID:         uuid.NewV4().String(),
 		ArtifactID: req.ArtifactId,
 		Job:        req.JobId,
 		Workflow:   req.WorkflowId,
 		Project:    req.ProjectId,
+		Operations: operations,
+		PathPrefix: req.PathPrefix,
 	}
 
 	token, err := jwt.GenerateToken(s.jwtSecret, claims, duration)
@@ -333,7 +669,43 @@ func (s *Server) GenerateToken(ctx context.Context, req *artifacthub.GenerateTok
 		return nil, err
 	}
 
-	return &artifacthub.GenerateTokenResponse{Token: token}, nil
+	return &artifacthub.GenerateTokenResponse{Token: token, TokenId: claims.ID}, nil
+}
+
+// RevokeToken adds the token to the revocation list. Tokens live
+// for maxTokenDuration at most, so it is kept only for that long.
+func (s *Server) RevokeToken(ctx context.Context, req *artifacthub.RevokeTokenRequest) (*artifacthub.RevokeTokenResponse, error) {
+	log.Info("[RevokeToken] Received", zap.Reflect("request", req))
+
+	id, err := uuid.FromString(req.TokenId)
+	if err != nil {
+		return nil, log.ErrorCode(codes.InvalidArgument, "invalid token id", nil)
+	}
+
+	err = models.RevokeToken(id, time.Now().Add(maxTokenDuration))
+	if err != nil {
+		return nil, log.ErrorCode(codes.Internal, "failed to revoke token", err)
+	}
+
+	return &artifacthub.RevokeTokenResponse{}, nil
+}
+
+func marshalTokenOperations(operations []artifacthub.GenerateTokenRequest_Operation) ([]string, error) {
+	marshaled := []string{}
+	for _, o := range operations {
+		switch o {
+		case artifacthub.GenerateTokenRequest_PULL:
+			marshaled = append(marshaled, jwt.OperationPull)
+		case artifacthub.GenerateTokenRequest_PUSH:
+			marshaled = append(marshaled, jwt.OperationPush)
+		case artifacthub.GenerateTokenRequest_YANK:
+			marshaled = append(marshaled, jwt.OperationYank)
+		default:
+			return nil, fmt.Errorf("unknown operation %d", o)
+		}
+	}
+
+	return marshaled, nil
 }
 
 func (s *Server) validateUUIDs(values []string) error {
@@ -383,6 +755,19 @@ func (s *Server) serveWithListener(lis net.Listener) error {
 	return grpcServer.Serve(lis)
 }
 
+// pageSize returns the default for missing sizes, and caps the requested ones.
+func pageSize(requested int32, defaultSize int) int {
+	if requested <= 0 {
+		return defaultSize
+	}
+
+	if requested > maxPageSize {
+		return maxPageSize
+	}
+
+	return int(requested)
+}
+
 func getMaxReceiveMessageSize() int {
 	maxReceiveMsgSizeStr := os.Getenv("MAX_PRIVATE_RECEIVE_MSG_SIZE")
 	maxReceiveMsgSize, err := strconv.Atoi(maxReceiveMsgSizeStr)
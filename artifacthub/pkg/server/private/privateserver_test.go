@@ -13,6 +13,8 @@ import (
 	gojwt "github.com/golang-jwt/jwt/v5"
 	uuid "github.com/satori/go.uuid"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/api/descriptors/artifacthub"
+	privateapi "github.com/semaphoreio/semaphore/artifacthub/pkg/api/private"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/db"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/jwt"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/storage"
@@ -516,6 +518,516 @@ func Test__UpdateRetentionPolicy(t *testing.T) {
 	})
 }
 
+func Test__CreateWithOrganization(t *testing.T) {
+	models.PrepareDatabaseForTests()
+	server := Server{StorageClient: storage.NewInMemoryStorage()}
+	orgID := uuid.NewV4()
+
+	t.Run("malformed organization ID", func(t *testing.T) {
+		_, err := server.Create(context.TODO(), &artifacthub.CreateRequest{RequestToken: "request-token-1", OrgId: "not-a-uuid"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("existing artifacts get the organization", func(t *testing.T) {
+		response, err := server.Create(context.TODO(), &artifacthub.CreateRequest{RequestToken: "request-token-1"})
+		require.NoError(t, err)
+
+		a, err := models.FindArtifactByID(response.Artifact.Id)
+		require.NoError(t, err)
+		assert.Nil(t, a.OrgID)
+
+		_, err = server.Create(context.TODO(), &artifacthub.CreateRequest{RequestToken: "request-token-1", OrgId: orgID.String()})
+		require.NoError(t, err)
+
+		a, err = models.FindArtifactByID(response.Artifact.Id)
+		require.NoError(t, err)
+		require.NotNil(t, a.OrgID)
+		assert.Equal(t, orgID, *a.OrgID)
+	})
+}
+
+func Test__CopyPath(t *testing.T) {
+	storage.RunTestForAllBackends(t, func(backend string, client storage.Client) {
+		models.PrepareDatabaseForTests()
+		server := Server{StorageClient: client}
+		orgID := uuid.NewV4()
+
+		create := func(token, org string) *models.Artifact {
+			a, err := privateapi.CreateArtifact(context.TODO(), client, token, org)
+			require.NoError(t, err)
+			return a
+		}
+
+		src := create("request-token-1", orgID.String())
+		sameOrg := create("request-token-2", orgID.String())
+		otherOrg := create("request-token-3", uuid.NewV4().String())
+		noOrg := create("request-token-4", "")
+
+		srcBucket := client.GetBucket(storage.BucketOptions{Name: src.BucketName, PathPrefix: src.IdempotencyToken})
+		require.NoError(t, storage.SeedBucket(srcBucket, []storage.SeedObject{{Name: "artifacts/projects/1/app.bin", Content: "app"}}))
+
+		for _, a := range []*models.Artifact{sameOrg, otherOrg, noOrg} {
+			bucket := client.GetBucket(storage.BucketOptions{Name: a.BucketName, PathPrefix: a.IdempotencyToken})
+			require.NoError(t, storage.SeedBucket(bucket, []storage.SeedObject{}))
+		}
+
+		t.Run(backend+" copy into a store of the same organization", func(t *testing.T) {
+			stream := &copyPathStream{ctx: context.TODO()}
+			err := server.CopyPath(&artifacthub.CopyPathRequest{
+				SourceArtifactId:      src.ID.String(),
+				SourcePath:            "artifacts/projects/1/app.bin",
+				DestinationArtifactId: sameOrg.ID.String(),
+				DestinationPath:       "artifacts/projects/2/",
+			}, stream)
+
+			require.NoError(t, err)
+			require.NotEmpty(t, stream.responses)
+			assert.Equal(t, int32(1), stream.responses[len(stream.responses)-1].CopiedCount)
+			assert.Equal(t, int64(3), stream.responses[len(stream.responses)-1].CopiedSize)
+
+			items, err := privateapi.ListArtifactPath(context.TODO(), client, sameOrg.ID.String(), "artifacts/projects/2/", false)
+			require.NoError(t, err)
+			require.Len(t, items, 1)
+			assert.Equal(t, "artifacts/projects/2/app.bin", items[0].Name)
+		})
+
+		t.Run(backend+" stores of other organizations are refused", func(t *testing.T) {
+			err := server.CopyPath(&artifacthub.CopyPathRequest{
+				SourceArtifactId:      src.ID.String(),
+				SourcePath:            "artifacts/projects/1/app.bin",
+				DestinationArtifactId: otherOrg.ID.String(),
+				DestinationPath:       "artifacts/projects/2/app.bin",
+			}, &copyPathStream{ctx: context.TODO()})
+
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		})
+
+		t.Run(backend+" stores without an organization are refused until it is set", func(t *testing.T) {
+			request := &artifacthub.CopyPathRequest{
+				SourceArtifactId:      src.ID.String(),
+				SourcePath:            "artifacts/projects/1/app.bin",
+				DestinationArtifactId: noOrg.ID.String(),
+				DestinationPath:       "artifacts/projects/2/app.bin",
+			}
+
+			err := server.CopyPath(request, &copyPathStream{ctx: context.TODO()})
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+			_, err = server.SetOrganization(context.TODO(), &artifacthub.SetOrganizationRequest{ArtifactId: noOrg.ID.String(), OrgId: orgID.String()})
+			require.NoError(t, err)
+
+			err = server.CopyPath(request, &copyPathStream{ctx: context.TODO()})
+			require.NoError(t, err)
+		})
+
+		t.Run(backend+" blobs can't be copied", func(t *testing.T) {
+			err := server.CopyPath(&artifacthub.CopyPathRequest{
+				SourceArtifactId:      src.ID.String(),
+				SourcePath:            "blobs/sha256/",
+				DestinationArtifactId: src.ID.String(),
+				DestinationPath:       "artifacts/projects/1/blobs/",
+			}, &copyPathStream{ctx: context.TODO()})
+
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	})
+}
+
+type copyPathStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*artifacthub.CopyPathResponse
+}
+
+func (s *copyPathStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *copyPathStream) Send(response *artifacthub.CopyPathResponse) error {
+	s.responses = append(s.responses, response)
+	return nil
+}
+
+func Test__ArtifactLabels(t *testing.T) {
+	storage.RunTestForAllBackends(t, func(backend string, client storage.Client) {
+		models.PrepareDatabaseForTests()
+		server := Server{StorageClient: client}
+
+		a, err := privateapi.CreateArtifact(context.TODO(), client, "request-token-1", "")
+		require.NoError(t, err)
+
+		bucket := client.GetBucket(storage.BucketOptions{Name: a.BucketName, PathPrefix: a.IdempotencyToken})
+		require.NoError(t, storage.SeedBucket(bucket, []storage.SeedObject{
+			{Name: "artifacts/projects/1/app.tar", Content: "app"},
+			{Name: "artifacts/projects/1/nested/app.sha", Content: "sha"},
+			{Name: "artifacts/projects/1/notes.txt", Content: "notes"},
+		}))
+
+		rc := models.ObjectLabels{"release-candidate": "true"}
+		metadata := models.ArtifactObjectMetadata{ProjectID: "1", Labels: rc}
+		paths := []string{"artifacts/projects/1/app.tar", "artifacts/projects/1/nested/app.sha", "artifacts/projects/1/deleted.txt"}
+		require.NoError(t, models.RecordObjectMetadata(a.ID, paths, metadata, false))
+
+		t.Run(backend+" list path with labels returns only labeled files", func(t *testing.T) {
+			response, err := server.ListPath(context.TODO(), &artifacthub.ListPathRequest{
+				ArtifactId: a.ID.String(),
+				Path:       "artifacts/projects/1/",
+				Labels:     rc,
+			})
+
+			require.NoError(t, err)
+
+			names := []string{}
+			for _, item := range response.Items {
+				names = append(names, item.Name)
+			}
+
+			assert.ElementsMatch(t, paths[:2], names)
+		})
+
+		t.Run(backend+" search pages through the labeled files of a project", func(t *testing.T) {
+			response, err := server.SearchArtifacts(context.TODO(), &artifacthub.SearchArtifactsRequest{
+				ArtifactId: a.ID.String(),
+				ProjectId:  "1",
+				Labels:     rc,
+				PageSize:   2,
+			})
+
+			require.NoError(t, err)
+			require.Len(t, response.Items, 2)
+			assert.Equal(t, "artifacts/projects/1/app.tar", response.Items[0].Path)
+			assert.Equal(t, map[string]string(rc), response.Items[0].Labels)
+			assert.NotEmpty(t, response.NextPageToken)
+
+			response, err = server.SearchArtifacts(context.TODO(), &artifacthub.SearchArtifactsRequest{
+				ArtifactId: a.ID.String(),
+				ProjectId:  "1",
+				Labels:     rc,
+				PageSize:   2,
+				PageToken:  response.NextPageToken,
+			})
+
+			require.NoError(t, err)
+			require.Len(t, response.Items, 1)
+			assert.Equal(t, "artifacts/projects/1/nested/app.sha", response.Items[0].Path)
+			assert.Empty(t, response.NextPageToken)
+		})
+
+		t.Run(backend+" deleting a path drops its labels", func(t *testing.T) {
+			_, err := server.DeletePath(context.TODO(), &artifacthub.DeletePathRequest{
+				ArtifactId: a.ID.String(),
+				Path:       "artifacts/projects/1/nested",
+			})
+
+			require.NoError(t, err)
+
+			response, err := server.SearchArtifacts(context.TODO(), &artifacthub.SearchArtifactsRequest{
+				ArtifactId: a.ID.String(),
+				ProjectId:  "1",
+				Labels:     rc,
+			})
+
+			require.NoError(t, err)
+			assert.Len(t, response.Items, 2)
+		})
+
+		t.Run(backend+" search needs a project", func(t *testing.T) {
+			_, err := server.SearchArtifacts(context.TODO(), &artifacthub.SearchArtifactsRequest{ArtifactId: a.ID.String()})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	})
+}
+
+func Test__GetUsage(t *testing.T) {
+	models.PrepareDatabaseForTests()
+	server := Server{}
+	orgID := uuid.NewV4()
+
+	a, err := models.CreateArtifact("test-bucket-1", uuid.NewV4().String())
+	require.NoError(t, err)
+	require.NoError(t, a.UpdateOrgID(orgID))
+
+	b, err := models.CreateArtifact("test-bucket-2", uuid.NewV4().String())
+	require.NoError(t, err)
+	require.NoError(t, b.UpdateOrgID(orgID))
+
+	require.NoError(t, models.ReplaceArtifactUsage(a.ID, []models.ArtifactUsage{
+		{Category: models.UsageCategoryProject, CategoryID: "p1", Size: 100, ObjectCount: 2},
+		{Category: models.UsageCategoryJob, CategoryID: "j1", Size: 10, ObjectCount: 1},
+	}, time.Now()))
+
+	require.NoError(t, models.ReplaceArtifactUsage(b.ID, []models.ArtifactUsage{
+		{Category: models.UsageCategoryWorkflow, CategoryID: "w1", Size: 1000, ObjectCount: 5},
+	}, time.Now()))
+
+	t.Run("no IDs => error", func(t *testing.T) {
+		_, err := server.GetUsage(context.TODO(), &artifacthub.GetUsageRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("usage of an artifact", func(t *testing.T) {
+		response, err := server.GetUsage(context.TODO(), &artifacthub.GetUsageRequest{ArtifactId: a.ID.String()})
+		require.NoError(t, err)
+		require.Len(t, response.Usage, 2)
+		assert.Equal(t, int64(110), response.TotalSize)
+		assert.Nil(t, response.Quota)
+
+		assert.Equal(t, artifacthub.CountArtifactsRequest_JOB, response.Usage[0].Category)
+		assert.Equal(t, "j1", response.Usage[0].CategoryId)
+		assert.Equal(t, int64(10), response.Usage[0].Size)
+		assert.Equal(t, artifacthub.CountArtifactsRequest_PROJECT, response.Usage[1].Category)
+		assert.Equal(t, int64(2), response.Usage[1].ObjectCount)
+	})
+
+	t.Run("usage of an organization", func(t *testing.T) {
+		_, err := models.SaveStorageQuota(orgID, 1000, 2000)
+		require.NoError(t, err)
+
+		response, err := server.GetUsage(context.TODO(), &artifacthub.GetUsageRequest{OrgId: orgID.String()})
+		require.NoError(t, err)
+		assert.Len(t, response.Usage, 3)
+		assert.Equal(t, int64(1110), response.TotalSize)
+		require.NotNil(t, response.Quota)
+		assert.Equal(t, int64(2000), response.Quota.HardLimit)
+	})
+
+	t.Run("organization without usage", func(t *testing.T) {
+		response, err := server.GetUsage(context.TODO(), &artifacthub.GetUsageRequest{OrgId: uuid.NewV4().String()})
+		require.NoError(t, err)
+		assert.Empty(t, response.Usage)
+		assert.Equal(t, int64(0), response.TotalSize)
+	})
+}
+
+func Test__SetQuota(t *testing.T) {
+	models.PrepareDatabaseForTests()
+	server := Server{}
+	orgID := uuid.NewV4()
+
+	t.Run("missing quota => error", func(t *testing.T) {
+		_, err := server.SetQuota(context.TODO(), &artifacthub.SetQuotaRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("soft limit over hard limit => error", func(t *testing.T) {
+		_, err := server.SetQuota(context.TODO(), &artifacthub.SetQuotaRequest{
+			Quota: &artifacthub.Quota{OrgId: orgID.String(), SoftLimit: 200, HardLimit: 100},
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("sets the quota", func(t *testing.T) {
+		response, err := server.SetQuota(context.TODO(), &artifacthub.SetQuotaRequest{
+			Quota: &artifacthub.Quota{OrgId: orgID.String(), SoftLimit: 100, HardLimit: 200},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, orgID.String(), response.Quota.OrgId)
+		assert.Equal(t, int64(100), response.Quota.SoftLimit)
+		assert.Equal(t, int64(200), response.Quota.HardLimit)
+	})
+}
+
+func Test__SetOrganization(t *testing.T) {
+	models.PrepareDatabaseForTests()
+	server := Server{}
+	orgID := uuid.NewV4()
+
+	// created before organizations were recorded
+	a, err := models.CreateArtifact("test-bucket-1", uuid.NewV4().String())
+	require.NoError(t, err)
+
+	require.NoError(t, models.ReplaceArtifactUsage(a.ID, []models.ArtifactUsage{
+		{Category: models.UsageCategoryProject, CategoryID: "p1", Size: 100, ObjectCount: 2},
+	}, time.Now()))
+
+	t.Run("malformed IDs => error", func(t *testing.T) {
+		_, err := server.SetOrganization(context.TODO(), &artifacthub.SetOrganizationRequest{ArtifactId: "not-a-uuid", OrgId: orgID.String()})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = server.SetOrganization(context.TODO(), &artifacthub.SetOrganizationRequest{ArtifactId: a.ID.String(), OrgId: "not-a-uuid"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("unknown artifact => not found", func(t *testing.T) {
+		_, err := server.SetOrganization(context.TODO(), &artifacthub.SetOrganizationRequest{ArtifactId: uuid.NewV4().String(), OrgId: orgID.String()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("usage of the artifact is counted for the organization", func(t *testing.T) {
+		response, err := server.GetUsage(context.TODO(), &artifacthub.GetUsageRequest{OrgId: orgID.String()})
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), response.TotalSize)
+
+		_, err = server.SetOrganization(context.TODO(), &artifacthub.SetOrganizationRequest{ArtifactId: a.ID.String(), OrgId: orgID.String()})
+		require.NoError(t, err)
+
+		response, err = server.GetUsage(context.TODO(), &artifacthub.GetUsageRequest{OrgId: orgID.String()})
+		require.NoError(t, err)
+		assert.Equal(t, int64(100), response.TotalSize)
+	})
+
+	t.Run("setting the same organization again is a no-op", func(t *testing.T) {
+		_, err := server.SetOrganization(context.TODO(), &artifacthub.SetOrganizationRequest{ArtifactId: a.ID.String(), OrgId: orgID.String()})
+		require.NoError(t, err)
+	})
+
+	t.Run("artifacts of another organization are not moved", func(t *testing.T) {
+		_, err := server.SetOrganization(context.TODO(), &artifacthub.SetOrganizationRequest{ArtifactId: a.ID.String(), OrgId: uuid.NewV4().String()})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		a, err := models.FindArtifactByID(a.ID.String())
+		require.NoError(t, err)
+		require.NotNil(t, a.OrgID)
+		assert.Equal(t, orgID, *a.OrgID)
+	})
+}
+
+func Test__PreviewRetentionPolicy(t *testing.T) {
+	models.PrepareDatabaseForTests()
+	client := storage.NewInMemoryStorage()
+	server := Server{StorageClient: client}
+
+	a, err := models.CreateArtifact(uuid.NewV4().String(), uuid.NewV4().String())
+	require.NoError(t, err)
+
+	bucket := client.GetBucket(storage.BucketOptions{Name: a.BucketName}).(*storage.InMemoryBucket)
+	require.NoError(t, bucket.Add("/projects/p1/test-results/a.xml", time.Now().Add(-10*24*time.Hour)))
+	require.NoError(t, bucket.Add("/projects/p1/test-results/b.xml", time.Now().Add(-12*time.Hour)))
+	require.NoError(t, bucket.Add("/jobs/j1/logs/c.txt", time.Now().Add(-10*24*time.Hour)))
+
+	weekOldTestResults := &artifacthub.RetentionPolicy{
+		ProjectLevelRetentionPolicies: []*artifacthub.RetentionPolicy_RetentionPolicyRule{
+			{Selector: "/**/test-results/*", Age: 7 * 24 * 3600},
+		},
+	}
+
+	t.Run("when the artifact bucket ID is not a valid UUID", func(t *testing.T) {
+		_, err := server.PreviewRetentionPolicy(context.TODO(), &artifacthub.PreviewRetentionPolicyRequest{ArtifactId: "haha-im-invalid"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("invalid candidate rules => error", func(t *testing.T) {
+		_, err := server.PreviewRetentionPolicy(context.TODO(), &artifacthub.PreviewRetentionPolicyRequest{
+			ArtifactId: a.ID.String(),
+			RetentionPolicy: &artifacthub.RetentionPolicy{
+				JobLevelRetentionPolicies: []*artifacthub.RetentionPolicy_RetentionPolicyRule{{Selector: "/*", Age: 60}},
+			},
+		})
+
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("candidate rules are previewed without deleting anything", func(t *testing.T) {
+		response, err := server.PreviewRetentionPolicy(context.TODO(), &artifacthub.PreviewRetentionPolicyRequest{
+			ArtifactId:      a.ID.String(),
+			RetentionPolicy: weekOldTestResults,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, response.Objects, 1)
+		assert.Equal(t, "artifacts/projects/p1/test-results/a.xml", response.Objects[0].Path)
+		assert.Equal(t, int64(1024), response.Objects[0].Size)
+		assert.GreaterOrEqual(t, response.Objects[0].Age, int64(10*24*3600))
+		assert.Equal(t, int64(1), response.MatchedCount)
+		assert.Equal(t, int64(1024), response.MatchedSize)
+		assert.Equal(t, int64(3), response.VisitedCount)
+		assert.Equal(t, int64(3*1024), response.VisitedSize)
+		assert.False(t, response.Truncated)
+		assert.Equal(t, 3, bucket.Size())
+	})
+
+	t.Run("without candidate rules, the stored policy is previewed", func(t *testing.T) {
+		response, err := server.PreviewRetentionPolicy(context.TODO(), &artifacthub.PreviewRetentionPolicyRequest{ArtifactId: a.ID.String()})
+		require.NoError(t, err)
+		assert.Empty(t, response.Objects)
+		assert.Equal(t, int64(3), response.VisitedCount)
+
+		_, err = models.CreateRetentionPolicy(a.ID, models.RetentionPolicyRules{}, models.RetentionPolicyRules{}, models.RetentionPolicyRules{
+			Rules: []models.RetentionPolicyRuleItem{{Selector: "/**/*", Age: 7 * 24 * 3600}},
+		})
+		require.NoError(t, err)
+
+		response, err = server.PreviewRetentionPolicy(context.TODO(), &artifacthub.PreviewRetentionPolicyRequest{ArtifactId: a.ID.String()})
+		require.NoError(t, err)
+		require.Len(t, response.Objects, 1)
+		assert.Equal(t, "artifacts/jobs/j1/logs/c.txt", response.Objects[0].Path)
+	})
+
+	t.Run("matched objects are limited, totals are not", func(t *testing.T) {
+		response, err := server.PreviewRetentionPolicy(context.TODO(), &artifacthub.PreviewRetentionPolicyRequest{
+			ArtifactId: a.ID.String(),
+			RetentionPolicy: &artifacthub.RetentionPolicy{
+				ProjectLevelRetentionPolicies: []*artifacthub.RetentionPolicy_RetentionPolicyRule{{Selector: "/**/*", Age: 24 * 3600}},
+				JobLevelRetentionPolicies:     []*artifacthub.RetentionPolicy_RetentionPolicyRule{{Selector: "/**/*", Age: 24 * 3600}},
+			},
+			MaxObjects: 1,
+		})
+
+		require.NoError(t, err)
+		assert.Len(t, response.Objects, 1)
+		assert.Equal(t, int64(2), response.MatchedCount)
+	})
+}
+
+func Test__RetentionReports(t *testing.T) {
+	models.PrepareDatabaseForTests()
+	server := Server{}
+
+	a, err := models.CreateArtifact(uuid.NewV4().String(), uuid.NewV4().String())
+	require.NoError(t, err)
+
+	report, err := models.StartRetentionReportWithTx(db.Conn(), a.ID)
+	require.NoError(t, err)
+	require.NoError(t, report.AddPageWithTx(db.Conn(), 5, []models.RetentionReportObject{
+		{Path: "artifacts/jobs/j1/a.txt", Size: 10, Age: 100},
+		{Path: "artifacts/jobs/j1/b.txt", Size: 20, Age: 100},
+		{Path: "artifacts/jobs/j1/c.txt", Size: 30, Age: 100},
+	}))
+	require.NoError(t, report.FinishWithTx(db.Conn()))
+
+	t.Run("listing reports", func(t *testing.T) {
+		response, err := server.ListRetentionReports(context.TODO(), &artifacthub.ListRetentionReportsRequest{ArtifactId: a.ID.String()})
+		require.NoError(t, err)
+		require.Len(t, response.Reports, 1)
+		assert.Equal(t, report.ID.String(), response.Reports[0].Id)
+		assert.Equal(t, int64(5), response.Reports[0].VisitedCount)
+		assert.Equal(t, int64(3), response.Reports[0].DeletedCount)
+		assert.Equal(t, int64(60), response.Reports[0].DeletedSize)
+		assert.NotNil(t, response.Reports[0].FinishedAt)
+	})
+
+	t.Run("describing a report pages through the deleted objects", func(t *testing.T) {
+		response, err := server.DescribeRetentionReport(context.TODO(), &artifacthub.DescribeRetentionReportRequest{
+			ReportId: report.ID.String(),
+			PageSize: 2,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, report.ID.String(), response.Report.Id)
+		require.Len(t, response.DeletedObjects, 2)
+		assert.Equal(t, "artifacts/jobs/j1/a.txt", response.DeletedObjects[0].Path)
+		assert.Equal(t, "artifacts/jobs/j1/b.txt", response.NextPageToken)
+
+		response, err = server.DescribeRetentionReport(context.TODO(), &artifacthub.DescribeRetentionReportRequest{
+			ReportId:  report.ID.String(),
+			PageSize:  2,
+			PageToken: response.NextPageToken,
+		})
+
+		require.NoError(t, err)
+		require.Len(t, response.DeletedObjects, 1)
+		assert.Equal(t, "artifacts/jobs/j1/c.txt", response.DeletedObjects[0].Path)
+		assert.Empty(t, response.NextPageToken)
+	})
+
+	t.Run("unknown report => not found", func(t *testing.T) {
+		_, err := server.DescribeRetentionReport(context.TODO(), &artifacthub.DescribeRetentionReportRequest{ReportId: uuid.NewV4().String()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
 func Test__GenerateToken(t *testing.T) {
 	models.PrepareDatabaseForTests()
 	jwtSecret := "hello"
@@ -602,6 +1114,74 @@ func Test__GenerateToken(t *testing.T) {
 		assert.Equal(t, claims.Project, request.ProjectId)
 		assert.Equal(t, claims.Workflow, request.WorkflowId)
 		assert.Equal(t, claims.Job, request.JobId)
+		assert.Equal(t, claims.ID, response.TokenId)
+		assert.Empty(t, claims.Operations)
+		assert.Empty(t, claims.PathPrefix)
+	})
+
+	t.Run("scoped request generates scoped token", func(t *testing.T) {
+		v := uuid.NewV4().String()
+		request := &artifacthub.GenerateTokenRequest{
+			ArtifactId: v,
+			ProjectId:  v,
+			JobId:      v,
+			Operations: []artifacthub.GenerateTokenRequest_Operation{artifacthub.GenerateTokenRequest_PULL},
+			PathPrefix: "test-results/",
+		}
+
+		response, err := server.GenerateToken(context.Background(), request)
+		require.NoError(t, err)
+
+		claims, err := jwt.ValidateToken(response.Token, jwtSecret, func(claims gojwt.MapClaims) error { return nil })
+		require.NoError(t, err)
+		assert.Equal(t, []string{jwt.OperationPull}, claims.Operations)
+		assert.Equal(t, "test-results/", claims.PathPrefix)
+	})
+
+	t.Run("invalid scopes -> error", func(t *testing.T) {
+		v := uuid.NewV4().String()
+		requests := []*artifacthub.GenerateTokenRequest{
+			{ArtifactId: v, ProjectId: v, JobId: v, Operations: []artifacthub.GenerateTokenRequest_Operation{42}},
+			{ArtifactId: v, ProjectId: v, JobId: v, PathPrefix: "../other"},
+			{ArtifactId: v, ProjectId: v, JobId: v, PathPrefix: "results/../../other"},
+		}
+
+		for _, request := range requests {
+			_, err := server.GenerateToken(context.Background(), request)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		}
+	})
+}
+
+func Test__RevokeToken(t *testing.T) {
+	models.PrepareDatabaseForTests()
+	server := Server{jwtSecret: "hello"}
+
+	t.Run("invalid token id -> error", func(t *testing.T) {
+		_, err := server.RevokeToken(context.Background(), &artifacthub.RevokeTokenRequest{TokenId: "not-valid-uuid"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("token is revoked", func(t *testing.T) {
+		v := uuid.NewV4().String()
+		response, err := server.GenerateToken(context.Background(), &artifacthub.GenerateTokenRequest{ArtifactId: v, ProjectId: v, JobId: v})
+		require.NoError(t, err)
+
+		id := uuid.FromStringOrNil(response.TokenId)
+		revoked, err := models.IsTokenRevoked(id)
+		require.NoError(t, err)
+		assert.False(t, revoked)
+
+		_, err = server.RevokeToken(context.Background(), &artifacthub.RevokeTokenRequest{TokenId: response.TokenId})
+		require.NoError(t, err)
+
+		// revoking twice is fine
+		_, err = server.RevokeToken(context.Background(), &artifacthub.RevokeTokenRequest{TokenId: response.TokenId})
+		require.NoError(t, err)
+
+		revoked, err = models.IsTokenRevoked(id)
+		require.NoError(t, err)
+		assert.True(t, revoked)
 	})
 }
 
@@ -11,13 +11,29 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+var retentionRuleKinds = map[artifacthub.RetentionPolicy_RetentionPolicyRule_Kind]string{
+	artifacthub.RetentionPolicy_RetentionPolicyRule_AGE:       models.RetentionRuleKindAge,
+	artifacthub.RetentionPolicy_RetentionPolicyRule_KEEP_LAST: models.RetentionRuleKindKeepLast,
+	artifacthub.RetentionPolicy_RetentionPolicyRule_MAX_SIZE:  models.RetentionRuleKindMaxSize,
+}
+
 func marshalRetentionPolicyRuleToModel(rules []*artifacthub.RetentionPolicy_RetentionPolicyRule) models.RetentionPolicyRules {
 	r := models.RetentionPolicyRules{}
 
 	for _, rule := range rules {
+		kind, ok := retentionRuleKinds[rule.Kind]
+		if !ok {
+			kind = rule.Kind.String()
+		}
+
 		r.Rules = append(r.Rules, models.RetentionPolicyRuleItem{
-			Selector: rule.Selector,
-			Age:      int(rule.Age),
+			Kind:            kind,
+			Selector:        rule.Selector,
+			Age:             int(rule.Age),
+			KeepLast:        int(rule.KeepLast),
+			MaxSize:         rule.MaxSize,
+			ExcludeBranches: rule.ExcludeBranches,
+			ExcludeTags:     rule.ExcludeTags,
 		})
 	}
 
@@ -46,10 +62,22 @@ func marshalRetentionPolicyRulesModelToAPIModel(m *models.RetentionPolicyRules)
 	r := []*artifacthub.RetentionPolicy_RetentionPolicyRule{}
 
 	for _, rule := range m.Rules {
-		r = append(r, &artifacthub.RetentionPolicy_RetentionPolicyRule{
-			Selector: rule.Selector,
-			Age:      int64(rule.Age),
-		})
+		marshaled := &artifacthub.RetentionPolicy_RetentionPolicyRule{
+			Selector:        rule.Selector,
+			Age:             int64(rule.Age),
+			KeepLast:        int32(rule.KeepLast),
+			MaxSize:         rule.MaxSize,
+			ExcludeBranches: rule.ExcludeBranches,
+			ExcludeTags:     rule.ExcludeTags,
+		}
+
+		for kind, name := range retentionRuleKinds {
+			if name == rule.RuleKind() {
+				marshaled.Kind = kind
+			}
+		}
+
+		r = append(r, marshaled)
 	}
 
 	return r
@@ -68,6 +96,14 @@ func marshalRetentionPolicyUpdateError(err error) error {
 		return log.ErrorCode(codes.FailedPrecondition, err.Error(), nil)
 	}
 
+	if errors.Is(err, models.ErrRetentionPolicyUnknownKind) ||
+		errors.Is(err, models.ErrRetentionPolicyKeepLastOutOfRange) ||
+		errors.Is(err, models.ErrRetentionPolicyMaxSizeTooSmall) ||
+		errors.Is(err, models.ErrRetentionPolicyTooManyExclusions) ||
+		errors.Is(err, models.ErrRetentionPolicyWorkflowLevelOnly) {
+		return log.ErrorCode(codes.FailedPrecondition, err.Error(), nil)
+	}
+
 	log.Error("(err) unhandled error while updating retention policy", zap.Error(err))
 
 	return log.ErrorCode(codes.Internal, "internal error while updating retention policy", nil)
@@ -7,6 +7,7 @@ import (
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/api/descriptors/artifacthub"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -88,3 +89,44 @@ func Test__MarshalingRetentionPolicyToModelToApiModel(t *testing.T) {
 		assert.Equal(t, int64(45*24*3600), marshaled.JobLevelRetentionPolicies[1].Age)
 	})
 }
+
+func Test__MarshalingRetentionPolicyRuleKinds(t *testing.T) {
+	rules := []*artifacthub.RetentionPolicy_RetentionPolicyRule{
+		{Selector: "/**/*", Age: 7 * 24 * 3600, ExcludeBranches: []string{"main"}, ExcludeTags: []string{"v*"}},
+		{Selector: "/**/*", Kind: artifacthub.RetentionPolicy_RetentionPolicyRule_KEEP_LAST, KeepLast: 10},
+		{Selector: "/**/*", Kind: artifacthub.RetentionPolicy_RetentionPolicyRule_MAX_SIZE, MaxSize: 1024},
+	}
+
+	marshaled := marshalRetentionPolicyRuleToModel(rules)
+	require.Len(t, marshaled.Rules, 3)
+
+	assert.Equal(t, models.RetentionRuleKindAge, marshaled.Rules[0].Kind)
+	assert.Equal(t, []string{"main"}, marshaled.Rules[0].ExcludeBranches)
+	assert.Equal(t, []string{"v*"}, marshaled.Rules[0].ExcludeTags)
+	assert.Equal(t, models.RetentionRuleKindKeepLast, marshaled.Rules[1].Kind)
+	assert.Equal(t, 10, marshaled.Rules[1].KeepLast)
+	assert.Equal(t, models.RetentionRuleKindMaxSize, marshaled.Rules[2].Kind)
+	assert.Equal(t, int64(1024), marshaled.Rules[2].MaxSize)
+
+	t.Run("rules without a kind are marshaled as age rules", func(t *testing.T) {
+		back := marshalRetentionPolicyRulesModelToAPIModel(&models.RetentionPolicyRules{
+			Rules: []models.RetentionPolicyRuleItem{{Selector: "/**/*", Age: 7 * 24 * 3600}},
+		})
+
+		require.Len(t, back, 1)
+		assert.Equal(t, artifacthub.RetentionPolicy_RetentionPolicyRule_AGE, back[0].Kind)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		back := marshalRetentionPolicyRulesModelToAPIModel(&marshaled)
+		require.Len(t, back, 3)
+
+		for i := range rules {
+			assert.Equal(t, rules[i].Kind, back[i].Kind)
+			assert.Equal(t, rules[i].KeepLast, back[i].KeepLast)
+			assert.Equal(t, rules[i].MaxSize, back[i].MaxSize)
+			assert.Equal(t, rules[i].ExcludeBranches, back[i].ExcludeBranches)
+			assert.Equal(t, rules[i].ExcludeTags, back[i].ExcludeTags)
+		}
+	})
+}
@@ -0,0 +1,38 @@
+package privateserver
+
+import (
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/api/descriptors/artifacthub"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func marshalRetentionReportModelToAPIModel(r *models.RetentionReport) *artifacthub.RetentionReport {
+	marshaled := &artifacthub.RetentionReport{
+		Id:           r.ID.String(),
+		ArtifactId:   r.ArtifactID.String(),
+		StartedAt:    timestamppb.New(r.StartedAt),
+		VisitedCount: r.VisitedCount,
+		DeletedCount: r.DeletedCount,
+		DeletedSize:  r.DeletedSize,
+	}
+
+	if r.FinishedAt != nil {
+		marshaled.FinishedAt = timestamppb.New(*r.FinishedAt)
+	}
+
+	return marshaled
+}
+
+func marshalRetentionReportObjectsToAPIModel(objects []models.RetentionReportObject) []*artifacthub.RetentionObject {
+	r := []*artifacthub.RetentionObject{}
+
+	for _, o := range objects {
+		r = append(r, &artifacthub.RetentionObject{
+			Path: o.Path,
+			Size: o.Size,
+			Age:  o.Age,
+		})
+	}
+
+	return r
+}
@@ -0,0 +1,58 @@
+package privateserver
+
+import (
+	"errors"
+
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/api/descriptors/artifacthub"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/util/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var usageCategories = map[string]artifacthub.CountArtifactsRequest_Category{
+	models.UsageCategoryProject:  artifacthub.CountArtifactsRequest_PROJECT,
+	models.UsageCategoryWorkflow: artifacthub.CountArtifactsRequest_WORKFLOW,
+	models.UsageCategoryJob:      artifacthub.CountArtifactsRequest_JOB,
+}
+
+func marshalUsageModelToAPIModel(usage []models.ArtifactUsage, quota *models.StorageQuota) *artifacthub.GetUsageResponse {
+	response := &artifacthub.GetUsageResponse{Usage: []*artifacthub.Usage{}}
+
+	for _, u := range usage {
+		response.Usage = append(response.Usage, &artifacthub.Usage{
+			ArtifactId:  u.ArtifactID.String(),
+			Category:    usageCategories[u.Category],
+			CategoryId:  u.CategoryID,
+			Size:        u.Size,
+			ObjectCount: u.ObjectCount,
+			ScannedAt:   timestamppb.New(u.ScannedAt),
+		})
+
+		response.TotalSize += u.Size
+	}
+
+	if quota != nil {
+		response.Quota = marshalQuotaModelToAPIModel(quota)
+	}
+
+	return response
+}
+
+func marshalQuotaModelToAPIModel(q *models.StorageQuota) *artifacthub.Quota {
+	return &artifacthub.Quota{
+		OrgId:     q.OrgID.String(),
+		SoftLimit: q.SoftLimit,
+		HardLimit: q.HardLimit,
+	}
+}
+
+func marshalQuotaUpdateError(err error) error {
+	if errors.Is(err, models.ErrStorageQuotaNegativeLimit) || errors.Is(err, models.ErrStorageQuotaSoftLimitTooHigh) {
+		return log.ErrorCode(codes.InvalidArgument, err.Error(), nil)
+	}
+
+	log.Error("Error while updating storage quota", zap.Error(err))
+	return log.ErrorCode(codes.Internal, "failed to update storage quota", nil)
+}
@@ -0,0 +1,85 @@
+package publicserver
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"strconv"
+
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/api/descriptors/artifacts"
+	publicapi "github.com/semaphoreio/semaphore/artifacthub/pkg/api/public"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/jwt"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/util/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultMaxArchiveSize = 2 * 1024 * 1024 * 1024 // 2GB
+	archiveChunkSize      = 256 * 1024
+)
+
+var archiveFormats = map[artifacts.DownloadArchiveRequest_Format]string{
+	artifacts.DownloadArchiveRequest_ZIP:    publicapi.ArchiveFormatZip,
+	artifacts.DownloadArchiveRequest_TAR_GZ: publicapi.ArchiveFormatTarGz,
+}
+
+// DownloadArchive streams a directory as a single zip or tar.gz archive.
+func (s *Server) DownloadArchive(q *artifacts.DownloadArchiveRequest, stream artifacts.ArtifactsService_DownloadArchiveServer) error {
+	artifact, _, err := s.authenticatePath(stream.Context(), q.Path, jwt.OperationPull)
+	if err != nil {
+		return err
+	}
+
+	log.Info("[DownloadArchive] Received", zap.String("path", q.Path), zap.String("format", q.Format.String()))
+
+	format, ok := archiveFormats[q.Format]
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown archive format %s", q.Format.String())
+	}
+
+	w := bufio.NewWriterSize(&archiveStreamWriter{stream: stream}, archiveChunkSize)
+
+	err = publicapi.WriteArchive(stream.Context(), s.StorageClient, artifact, q.Path, format, w, getMaxArchiveSize())
+	if err == nil {
+		err = w.Flush()
+	}
+
+	switch {
+	case err == nil:
+		log.Debug("[DownloadArchive] Sent", zap.String("path", q.Path))
+		return nil
+	case errors.Is(err, publicapi.ErrArtifactNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, publicapi.ErrArchiveTooLarge):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		log.Error("[DownloadArchive] Unknown error", zap.Error(err))
+		return err
+	}
+}
+
+func getMaxArchiveSize() int64 {
+	maxArchiveSize, err := strconv.ParseInt(os.Getenv("MAX_ARCHIVE_SIZE"), 10, 64)
+	if err != nil {
+		return defaultMaxArchiveSize
+	}
+	return maxArchiveSize
+}
+
+// archiveStreamWriter sends every write as a separate message, so it is
+// wrapped with a buffer to keep the messages at the size of a chunk.
+// The data is copied, since the buffer is reused after the write.
+type archiveStreamWriter struct {
+	stream artifacts.ArtifactsService_DownloadArchiveServer
+}
+
+func (w *archiveStreamWriter) Write(p []byte) (int, error) {
+	err := w.stream.Send(&artifacts.DownloadArchiveResponse{Data: append([]byte(nil), p...)})
+	if err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
@@ -0,0 +1,156 @@
+package publicserver
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/api/descriptors/artifacts"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__DownloadArchive(t *testing.T) {
+	storage.RunTestForAllBackends(t, func(backend string, client storage.Client) {
+		t.Run(backend+"/zip", func(t *testing.T) {
+			server, claims, ctx := prepareTest(t, ResourceTypeJobs, client)
+			stream := &archiveStream{ctx: ctx}
+
+			err := server.DownloadArchive(&artifacts.DownloadArchiveRequest{
+				Path:   getPath(ResourceTypeJobs, claims, "first"),
+				Format: artifacts.DownloadArchiveRequest_ZIP,
+			}, stream)
+
+			require.NoError(t, err)
+
+			reader, err := zip.NewReader(bytes.NewReader(stream.data.Bytes()), int64(stream.data.Len()))
+			require.NoError(t, err)
+
+			files := map[string]string{}
+			for _, f := range reader.File {
+				r, err := f.Open()
+				require.NoError(t, err)
+
+				content, err := io.ReadAll(r)
+				require.NoError(t, err)
+				files[f.Name] = string(content)
+			}
+
+			assert.Equal(t, map[string]string{
+				"file1.txt": "first folder, first file",
+				"file2.txt": "first folder, second file",
+			}, files)
+		})
+
+		t.Run(backend+"/tar.gz", func(t *testing.T) {
+			server, claims, ctx := prepareTest(t, ResourceTypeJobs, client)
+			stream := &archiveStream{ctx: ctx}
+
+			err := server.DownloadArchive(&artifacts.DownloadArchiveRequest{
+				Path:   getPath(ResourceTypeJobs, claims, "second/"),
+				Format: artifacts.DownloadArchiveRequest_TAR_GZ,
+			}, stream)
+
+			require.NoError(t, err)
+
+			gz, err := gzip.NewReader(&stream.data)
+			require.NoError(t, err)
+
+			files := map[string]string{}
+			reader := tar.NewReader(gz)
+			for {
+				header, err := reader.Next()
+				if err == io.EOF {
+					break
+				}
+
+				require.NoError(t, err)
+
+				content, err := io.ReadAll(reader)
+				require.NoError(t, err)
+				files[header.Name] = string(content)
+			}
+
+			assert.Equal(t, map[string]string{
+				"file1.txt": "second folder, first file",
+				"file2.txt": "second folder, second file",
+			}, files)
+		})
+
+		t.Run(backend+"/single file", func(t *testing.T) {
+			server, claims, ctx := prepareTest(t, ResourceTypeJobs, client)
+			stream := &archiveStream{ctx: ctx}
+
+			err := server.DownloadArchive(&artifacts.DownloadArchiveRequest{
+				Path:   getPath(ResourceTypeJobs, claims, "third/file2.txt"),
+				Format: artifacts.DownloadArchiveRequest_ZIP,
+			}, stream)
+
+			require.NoError(t, err)
+
+			reader, err := zip.NewReader(bytes.NewReader(stream.data.Bytes()), int64(stream.data.Len()))
+			require.NoError(t, err)
+			require.Len(t, reader.File, 1)
+			assert.Equal(t, "file2.txt", reader.File[0].Name)
+		})
+
+		t.Run(backend+"/directory is too large", func(t *testing.T) {
+			t.Setenv("MAX_ARCHIVE_SIZE", "30")
+
+			server, claims, ctx := prepareTest(t, ResourceTypeJobs, client)
+			stream := &archiveStream{ctx: ctx}
+
+			err := server.DownloadArchive(&artifacts.DownloadArchiveRequest{
+				Path: getPath(ResourceTypeJobs, claims, "first"),
+			}, stream)
+
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			assert.Contains(t, err.Error(), "limited to 30 bytes")
+			assert.Zero(t, stream.data.Len())
+		})
+
+		t.Run(backend+"/directory is not found", func(t *testing.T) {
+			server, claims, ctx := prepareTest(t, ResourceTypeJobs, client)
+
+			err := server.DownloadArchive(&artifacts.DownloadArchiveRequest{
+				Path: getPath(ResourceTypeJobs, claims, "fourth"),
+			}, &archiveStream{ctx: ctx})
+
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		})
+
+		t.Run(backend+"/other jobs are not allowed", func(t *testing.T) {
+			server, _, ctx := prepareTest(t, ResourceTypeJobs, client)
+			_, otherClaims, _ := prepareTest(t, ResourceTypeJobs, client)
+
+			err := server.DownloadArchive(&artifacts.DownloadArchiveRequest{
+				Path: getPath(ResourceTypeJobs, otherClaims, "first"),
+			}, &archiveStream{ctx: ctx})
+
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		})
+	})
+}
+
+type archiveStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	data bytes.Buffer
+}
+
+func (s *archiveStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *archiveStream) Send(response *artifacts.DownloadArchiveResponse) error {
+	_, err := s.data.Write(response.Data)
+	return err
+}
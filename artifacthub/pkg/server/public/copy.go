@@ -0,0 +1,67 @@
+package publicserver
+
+import (
+	"errors"
+
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/api/descriptors/artifacts"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/jwt"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/transfer"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/util/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CopyPath copies a file or a directory inside of the artifact store, and streams the progress.
+// The paths are authenticated one by one, since promoting e.g. a workflow
+// output to its project copies between paths of different resources.
+func (s *Server) CopyPath(q *artifacts.CopyPathRequest, stream artifacts.ArtifactsService_CopyPathServer) error {
+	artifact, _, err := s.authenticatePath(stream.Context(), q.SourcePath, jwt.OperationPull)
+	if err != nil {
+		return err
+	}
+
+	_, _, err = s.authenticatePath(stream.Context(), q.DestinationPath, jwt.OperationPush)
+	if err != nil {
+		return err
+	}
+
+	log.Info("[CopyPath] Received",
+		zap.String("source", q.SourcePath),
+		zap.String("destination", q.DestinationPath),
+		zap.Bool("force", q.Force),
+	)
+
+	options := transfer.CopyOptions{
+		Source:          artifact,
+		SourcePath:      q.SourcePath,
+		Destination:     artifact,
+		DestinationPath: q.DestinationPath,
+		Force:           q.Force,
+	}
+
+	err = transfer.Copy(stream.Context(), s.StorageClient, options, func(p transfer.Progress) error {
+		return stream.Send(&artifacts.CopyPathResponse{
+			CopiedCount: int32(p.CopiedCount),
+			CopiedSize:  p.CopiedSize,
+			TotalCount:  int32(p.TotalCount),
+			TotalSize:   p.TotalSize,
+		})
+	})
+
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, transfer.ErrPathNotFound), errors.Is(err, transfer.ErrBlobNotUploaded):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, transfer.ErrInvalidCopyPath):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, transfer.ErrDestinationExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, transfer.ErrStorageQuotaExceeded):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		log.Error("[CopyPath] Unknown error", zap.Error(err))
+		return err
+	}
+}
@@ -0,0 +1,176 @@
+package publicserver
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/api/descriptors/artifacts"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/jwt"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__CopyPath(t *testing.T) {
+	storage.RunTestForAllBackends(t, func(backend string, client storage.Client) {
+		pull := func(ctx context.Context, server *Server, p string) error {
+			_, err := server.GenerateSignedURLs(ctx, &artifacts.GenerateSignedURLsRequest{
+				Type:  artifacts.GenerateSignedURLsRequest_PULL,
+				Paths: []string{p},
+			})
+
+			return err
+		}
+
+		t.Run(backend+"/directory is promoted from the workflow to the project", func(t *testing.T) {
+			server, claims, ctx := prepareTest(t, ResourceTypeWorkflows, client)
+			stream := &copyStream{ctx: ctx}
+
+			err := server.CopyPath(&artifacts.CopyPathRequest{
+				SourcePath:      getPath(ResourceTypeWorkflows, claims, "first"),
+				DestinationPath: getPath(ResourceTypeProjects, claims, "releases/first"),
+			}, stream)
+
+			require.NoError(t, err)
+			require.NotEmpty(t, stream.responses)
+
+			last := stream.responses[len(stream.responses)-1]
+			assert.Equal(t, int32(2), last.CopiedCount)
+			assert.Equal(t, int32(2), last.TotalCount)
+			assert.Equal(t, last.TotalSize, last.CopiedSize)
+
+			assert.NoError(t, pull(ctx, server, getPath(ResourceTypeProjects, claims, "releases/first/file1.txt")))
+			assert.NoError(t, pull(ctx, server, getPath(ResourceTypeProjects, claims, "releases/first/file2.txt")))
+		})
+
+		t.Run(backend+"/file is copied to a path or into a directory", func(t *testing.T) {
+			server, claims, ctx := prepareTest(t, ResourceTypeJobs, client)
+
+			for dst, expected := range map[string]string{
+				"renamed.txt": "renamed.txt",
+				"copies/":     "copies/file1.txt",
+			} {
+				err := server.CopyPath(&artifacts.CopyPathRequest{
+					SourcePath:      getPath(ResourceTypeJobs, claims, "second/file1.txt"),
+					DestinationPath: getPath(ResourceTypeJobs, claims, dst),
+				}, &copyStream{ctx: ctx})
+
+				require.NoError(t, err)
+				assert.NoError(t, pull(ctx, server, getPath(ResourceTypeJobs, claims, expected)))
+			}
+		})
+
+		t.Run(backend+"/paths pushed with a digest are copied without reading their blob again", func(t *testing.T) {
+			server, claims, ctx := prepareTest(t, ResourceTypeJobs, client)
+			content := sha256.Sum256([]byte("content"))
+			digest := hex.EncodeToString(content[:])
+
+			_, err := server.GenerateSignedURLs(ctx, &artifacts.GenerateSignedURLsRequest{
+				Type:    artifacts.GenerateSignedURLsRequest_PUSH,
+				Paths:   []string{getPath(ResourceTypeJobs, claims, "blobs/a.txt"), getPath(ResourceTypeJobs, claims, "blobs/b.txt")},
+				Digests: []string{digest, digest},
+			})
+
+			require.NoError(t, err)
+
+			artifact, err := models.FindArtifactByID(claims.ArtifactID)
+			require.NoError(t, err)
+
+			bucket := client.GetBucket(storage.BucketOptions{Name: artifact.BucketName, PathPrefix: artifact.IdempotencyToken})
+			require.NoError(t, bucket.CreateObject(context.Background(), "blobs/sha256/"+digest, []byte("content")))
+			require.NoError(t, pull(ctx, server, getPath(ResourceTypeJobs, claims, "blobs/a.txt")))
+
+			// If the blob was read again, it wouldn't match its digest anymore, and it would be deleted.
+			require.NoError(t, bucket.CreateObject(context.Background(), "blobs/sha256/"+digest, []byte("changed")))
+
+			err = server.CopyPath(&artifacts.CopyPathRequest{
+				SourcePath:      getPath(ResourceTypeJobs, claims, "blobs"),
+				DestinationPath: getPath(ResourceTypeJobs, claims, "copies"),
+			}, &copyStream{ctx: ctx})
+
+			require.NoError(t, err)
+
+			refs, err := models.ListObjectRefs(artifact.ID)
+			require.NoError(t, err)
+			assert.Equal(t, digest, refs[getPath(ResourceTypeJobs, claims, "copies/a.txt")])
+			assert.Equal(t, digest, refs[getPath(ResourceTypeJobs, claims, "copies/b.txt")])
+
+			exists, err := bucket.IsFile(context.Background(), "blobs/sha256/"+digest)
+			require.NoError(t, err)
+			assert.True(t, exists)
+		})
+
+		t.Run(backend+"/existing destination is overwritten only with force", func(t *testing.T) {
+			server, claims, ctx := prepareTest(t, ResourceTypeJobs, client)
+			request := &artifacts.CopyPathRequest{
+				SourcePath:      getPath(ResourceTypeJobs, claims, "first"),
+				DestinationPath: getPath(ResourceTypeJobs, claims, "second"),
+			}
+
+			err := server.CopyPath(request, &copyStream{ctx: ctx})
+			assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+			request.Force = true
+			err = server.CopyPath(request, &copyStream{ctx: ctx})
+			assert.NoError(t, err)
+		})
+
+		t.Run(backend+"/invalid paths", func(t *testing.T) {
+			server, claims, ctx := prepareTest(t, ResourceTypeJobs, client)
+
+			err := server.CopyPath(&artifacts.CopyPathRequest{
+				SourcePath:      getPath(ResourceTypeJobs, claims, "fourth"),
+				DestinationPath: getPath(ResourceTypeJobs, claims, "fifth"),
+			}, &copyStream{ctx: ctx})
+
+			assert.Equal(t, codes.NotFound, status.Code(err))
+
+			err = server.CopyPath(&artifacts.CopyPathRequest{
+				SourcePath:      getPath(ResourceTypeJobs, claims, "first"),
+				DestinationPath: getPath(ResourceTypeJobs, claims, "first/nested"),
+			}, &copyStream{ctx: ctx})
+
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+
+		t.Run(backend+"/read-only token can't copy", func(t *testing.T) {
+			baseClaims := jwt.Claims{
+				Project:    uuid.NewV4().String(),
+				Workflow:   uuid.NewV4().String(),
+				Job:        uuid.NewV4().String(),
+				Operations: []string{jwt.OperationPull},
+			}
+
+			server, claims, ctx := prepareTestWithClaims(t, ResourceTypeJobs, client, baseClaims)
+
+			err := server.CopyPath(&artifacts.CopyPathRequest{
+				SourcePath:      getPath(ResourceTypeJobs, claims, "first"),
+				DestinationPath: getPath(ResourceTypeJobs, claims, "copies"),
+			}, &copyStream{ctx: ctx})
+
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		})
+	})
+}
+
+type copyStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*artifacts.CopyPathResponse
+}
+
+func (s *copyStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *copyStream) Send(response *artifacts.CopyPathResponse) error {
+	s.responses = append(s.responses, response)
+	return nil
+}
@@ -0,0 +1,26 @@
+package publicserver
+
+import (
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/jwt"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/util/log"
+	"google.golang.org/grpc/codes"
+)
+
+// recordMetadata records which project, workflow and job pushed the paths, with the labels given
+// by the client, so release tooling can find the files by their labels instead of by their paths.
+func recordMetadata(artifact *models.Artifact, claims *jwt.Claims, paths []string, labels map[string]string, force bool) error {
+	metadata := models.ArtifactObjectMetadata{
+		ProjectID:  claims.Project,
+		WorkflowID: claims.Workflow,
+		JobID:      claims.Job,
+		Labels:     labels,
+	}
+
+	err := models.RecordObjectMetadata(artifact.ID, paths, metadata, force)
+	if err != nil {
+		return log.ErrorCode(codes.Internal, "failed to record metadata", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package publicserver
+
+import (
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/api/descriptors/artifacts"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__PushMetadata(t *testing.T) {
+	storage.RunTestForAllBackends(t, func(backend string, client storage.Client) {
+		t.Run(backend+"/push records the claims and labels", func(t *testing.T) {
+			server, claims, ctx := prepareTest(t, ResourceTypeJobs, client)
+			p := getPath(ResourceTypeJobs, claims, "release/app.tar")
+
+			_, err := server.GenerateSignedURLs(ctx, &artifacts.GenerateSignedURLsRequest{
+				Type:   artifacts.GenerateSignedURLsRequest_PUSH,
+				Paths:  []string{p},
+				Labels: map[string]string{"release-candidate": "true"},
+			})
+
+			require.NoError(t, err)
+
+			artifactID := uuid.FromStringOrNil(claims.ArtifactID)
+			found, err := models.ListObjectMetadataUnder(artifactID, getPath(ResourceTypeJobs, claims, ""), models.ObjectLabels{"release-candidate": "true"})
+			require.NoError(t, err)
+			require.Contains(t, found, p)
+			assert.Equal(t, claims.Project, found[p].ProjectID)
+			assert.Equal(t, claims.Workflow, found[p].WorkflowID)
+			assert.Equal(t, claims.Job, found[p].JobID)
+		})
+
+		t.Run(backend+"/yank drops the metadata", func(t *testing.T) {
+			server, claims, ctx := prepareTest(t, ResourceTypeJobs, client)
+			p := getPath(ResourceTypeJobs, claims, "first/file1.txt")
+
+			_, err := server.GenerateSignedURLs(ctx, &artifacts.GenerateSignedURLsRequest{
+				Type:   artifacts.GenerateSignedURLsRequest_PUSHFORCE,
+				Paths:  []string{p},
+				Labels: map[string]string{"nightly": "true"},
+			})
+
+			require.NoError(t, err)
+
+			_, err = server.GenerateSignedURLs(ctx, &artifacts.GenerateSignedURLsRequest{
+				Type:  artifacts.GenerateSignedURLsRequest_YANK,
+				Paths: []string{getPath(ResourceTypeJobs, claims, "first")},
+			})
+
+			require.NoError(t, err)
+
+			found, err := models.ListObjectMetadataUnder(uuid.FromStringOrNil(claims.ArtifactID), getPath(ResourceTypeJobs, claims, ""), nil)
+			require.NoError(t, err)
+			assert.Empty(t, found)
+		})
+
+		t.Run(backend+"/invalid labels are refused", func(t *testing.T) {
+			server, claims, ctx := prepareTest(t, ResourceTypeJobs, client)
+			p := getPath(ResourceTypeJobs, claims, "release/app.tar")
+			labels := map[string]string{"has space": "true"}
+
+			_, err := server.GenerateSignedURLs(ctx, &artifacts.GenerateSignedURLsRequest{
+				Type:   artifacts.GenerateSignedURLsRequest_PUSH,
+				Paths:  []string{p},
+				Labels: labels,
+			})
+
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+			_, err = server.StartMultipartUpload(ctx, &artifacts.StartMultipartUploadRequest{Path: p, Size: 1, Labels: labels})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	})
+}
@@ -0,0 +1,119 @@
+package publicserver
+
+import (
+	"context"
+
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/api/descriptors/artifacts"
+	publicapi "github.com/semaphoreio/semaphore/artifacthub/pkg/api/public"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/jwt"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/util/log"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StartMultipartUpload starts or resumes an upload of a large file.
+func (s *Server) StartMultipartUpload(ctx context.Context,
+	q *artifacts.StartMultipartUploadRequest) (*artifacts.StartMultipartUploadResponse, error) {
+	artifact, claims, err := s.authenticatePath(ctx, q.Path, jwt.OperationPush)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := models.ObjectLabels(q.Labels).Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Info("[StartMultipartUpload] Received",
+		zap.String("path", q.Path),
+		zap.Int64("size", q.Size),
+		zap.Bool("force", q.Force),
+		zap.String("upload_id", q.UploadId),
+	)
+
+	response, err := publicapi.StartMultipartUpload(ctx, s.StorageClient, artifact, q.Path, q.Size, q.Force, q.UploadId)
+	if err != nil {
+		return nil, marshalMultipartError("StartMultipartUpload", err)
+	}
+
+	if q.UploadId == "" {
+		if err := recordMetadata(artifact, claims, []string{q.Path}, q.Labels, q.Force); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Debug("[StartMultipartUpload] Sending", zap.String("upload_id", response.UploadId), zap.Int("parts", len(response.Parts)))
+	return response, nil
+}
+
+// CompleteMultipartUpload puts the uploaded parts together into the file.
+func (s *Server) CompleteMultipartUpload(ctx context.Context,
+	q *artifacts.CompleteMultipartUploadRequest) (*artifacts.CompleteMultipartUploadResponse, error) {
+	artifact, _, err := s.authenticatePath(ctx, q.Path, jwt.OperationPush)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("[CompleteMultipartUpload] Received", zap.String("path", q.Path), zap.String("upload_id", q.UploadId))
+
+	err = publicapi.CompleteMultipartUpload(ctx, s.StorageClient, artifact, q.Path, q.UploadId, q.Parts)
+	if err != nil {
+		return nil, marshalMultipartError("CompleteMultipartUpload", err)
+	}
+
+	return &artifacts.CompleteMultipartUploadResponse{}, nil
+}
+
+// AbortMultipartUpload drops the parts uploaded so far.
+func (s *Server) AbortMultipartUpload(ctx context.Context,
+	q *artifacts.AbortMultipartUploadRequest) (*artifacts.AbortMultipartUploadResponse, error) {
+	artifact, _, err := s.authenticatePath(ctx, q.Path, jwt.OperationPush)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("[AbortMultipartUpload] Received", zap.String("path", q.Path), zap.String("upload_id", q.UploadId))
+
+	err = publicapi.AbortMultipartUpload(ctx, s.StorageClient, artifact, q.Path, q.UploadId)
+	if err != nil {
+		return nil, marshalMultipartError("AbortMultipartUpload", err)
+	}
+
+	return &artifacts.AbortMultipartUploadResponse{}, nil
+}
+
+func (s *Server) authenticatePath(ctx context.Context, path, operation string) (*models.Artifact, *jwt.Claims, error) {
+	token, err := getAuthTokenFromContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	artifact, claims, err := s.authenticateAndGetClaims(token, []string{path}, operation)
+	if err != nil {
+		log.Error("Error authenticating request", zap.Error(err))
+		return nil, nil, err
+	}
+
+	return artifact, claims, nil
+}
+
+func marshalMultipartError(method string, err error) error {
+	switch err {
+	case publicapi.ErrMultipartUnsupported:
+		return status.Error(codes.Unimplemented, err.Error())
+	case publicapi.ErrMultipartUploadNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	case publicapi.ErrMultipartUploadIncomplete:
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case publicapi.ErrInvalidUploadSize:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case publicapi.ErrArtifactAlreadyExists:
+		return status.Error(codes.AlreadyExists, err.Error())
+	case publicapi.ErrStorageQuotaExceeded:
+		return status.Error(codes.ResourceExhausted, err.Error())
+	default:
+		log.Error("["+method+"] Unknown error", zap.Error(err))
+		return err
+	}
+}
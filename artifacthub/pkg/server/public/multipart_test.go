@@ -0,0 +1,157 @@
+package publicserver
+
+import (
+	"bytes"
+	"testing"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/api/descriptors/artifacts"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func Test__MultipartUpload(t *testing.T) {
+	content := bytes.Repeat([]byte("a"), storage.MinUploadPartSize+1024)
+
+	storage.RunTestForAllBackends(t, func(backend string, client storage.Client) {
+		if _, ok := client.(storage.MultipartClient); !ok {
+			t.Run(backend+"/not supported", func(t *testing.T) {
+				server, claims, ctx := prepareTest(t, ResourceTypeJobs, client)
+
+				_, err := server.StartMultipartUpload(ctx, &artifacts.StartMultipartUploadRequest{
+					Path: getPath(ResourceTypeJobs, claims, "large.bin"),
+					Size: int64(len(content)),
+				})
+
+				assert.Equal(t, codes.Unimplemented, status.Code(err))
+			})
+
+			return
+		}
+
+		t.Run(backend+"/upload is completed", func(t *testing.T) {
+			server, claims, ctx := prepareTest(t, ResourceTypeJobs, client)
+			p := getPath(ResourceTypeJobs, claims, "large.bin")
+
+			started, err := server.StartMultipartUpload(ctx, &artifacts.StartMultipartUploadRequest{Path: p, Size: int64(len(content))})
+			require.NoError(t, err)
+			assert.Equal(t, int64(storage.MinUploadPartSize), started.PartSize)
+
+			resumed, err := server.StartMultipartUpload(ctx, &artifacts.StartMultipartUploadRequest{Path: p, UploadId: started.UploadId})
+			require.NoError(t, err)
+			assert.Equal(t, started.UploadId, resumed.UploadId)
+
+			parts, err := storage.UploadMultipart(unmarshalUpload(resumed), content)
+			require.NoError(t, err)
+
+			_, err = server.CompleteMultipartUpload(ctx, &artifacts.CompleteMultipartUploadRequest{
+				Path:     p,
+				UploadId: started.UploadId,
+				Parts:    marshalParts(parts),
+			})
+
+			require.NoError(t, err)
+
+			response, err := server.GenerateSignedURLs(ctx, &artifacts.GenerateSignedURLsRequest{
+				Type:  artifacts.GenerateSignedURLsRequest_PULL,
+				Paths: []string{p},
+			})
+
+			require.NoError(t, err)
+			assert.Len(t, response.URLs, 1)
+
+			_, err = server.CompleteMultipartUpload(ctx, &artifacts.CompleteMultipartUploadRequest{Path: p, UploadId: started.UploadId})
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		})
+
+		t.Run(backend+"/upload is aborted", func(t *testing.T) {
+			server, claims, ctx := prepareTest(t, ResourceTypeJobs, client)
+			p := getPath(ResourceTypeJobs, claims, "large.bin")
+
+			started, err := server.StartMultipartUpload(ctx, &artifacts.StartMultipartUploadRequest{Path: p, Size: int64(len(content))})
+			require.NoError(t, err)
+
+			_, err = server.AbortMultipartUpload(ctx, &artifacts.AbortMultipartUploadRequest{Path: p, UploadId: started.UploadId})
+			require.NoError(t, err)
+
+			_, err = server.StartMultipartUpload(ctx, &artifacts.StartMultipartUploadRequest{Path: p, UploadId: started.UploadId})
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		})
+
+		t.Run(backend+"/uploads of other paths are not found", func(t *testing.T) {
+			server, claims, ctx := prepareTest(t, ResourceTypeJobs, client)
+			p := getPath(ResourceTypeJobs, claims, "large.bin")
+
+			started, err := server.StartMultipartUpload(ctx, &artifacts.StartMultipartUploadRequest{Path: p, Size: int64(len(content))})
+			require.NoError(t, err)
+
+			other := getPath(ResourceTypeJobs, claims, "other.bin")
+			_, err = server.AbortMultipartUpload(ctx, &artifacts.AbortMultipartUploadRequest{Path: other, UploadId: started.UploadId})
+			assert.Equal(t, codes.NotFound, status.Code(err))
+
+			_, err = server.AbortMultipartUpload(ctx, &artifacts.AbortMultipartUploadRequest{Path: p, UploadId: uuid.NewV4().String()})
+			assert.Equal(t, codes.NotFound, status.Code(err))
+
+			_, err = server.AbortMultipartUpload(ctx, &artifacts.AbortMultipartUploadRequest{Path: p, UploadId: "not-an-id"})
+			assert.Equal(t, codes.NotFound, status.Code(err))
+		})
+
+		t.Run(backend+"/existing files are not overwritten without force", func(t *testing.T) {
+			server, claims, ctx := prepareTest(t, ResourceTypeJobs, client)
+			p := getPath(ResourceTypeJobs, claims, "first/file1.txt")
+
+			_, err := server.StartMultipartUpload(ctx, &artifacts.StartMultipartUploadRequest{Path: p, Size: int64(len(content))})
+			assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+			_, err = server.StartMultipartUpload(ctx, &artifacts.StartMultipartUploadRequest{Path: p, Size: int64(len(content)), Force: true})
+			assert.NoError(t, err)
+		})
+
+		t.Run(backend+"/invalid size", func(t *testing.T) {
+			server, claims, ctx := prepareTest(t, ResourceTypeJobs, client)
+			p := getPath(ResourceTypeJobs, claims, "large.bin")
+
+			_, err := server.StartMultipartUpload(ctx, &artifacts.StartMultipartUploadRequest{Path: p})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+
+		t.Run(backend+"/stale uploads are listed for the janitor", func(t *testing.T) {
+			server, claims, ctx := prepareTest(t, ResourceTypeJobs, client)
+			p := getPath(ResourceTypeJobs, claims, "large.bin")
+
+			_, err := server.StartMultipartUpload(ctx, &artifacts.StartMultipartUploadRequest{Path: p, Size: int64(len(content))})
+			require.NoError(t, err)
+
+			uploads, err := models.FindStaleMultipartUploads(0, 10)
+			require.NoError(t, err)
+			assert.Len(t, uploads, 1)
+		})
+	})
+}
+
+func unmarshalUpload(response *artifacts.StartMultipartUploadResponse) *storage.MultipartUpload {
+	upload := &storage.MultipartUpload{
+		ID:         response.UploadId,
+		PartSize:   response.PartSize,
+		SessionURI: response.SessionUri,
+	}
+
+	for _, part := range response.Parts {
+		upload.Parts = append(upload.Parts, storage.UploadPart{Number: int(part.Number), URL: part.URL, ETag: part.Etag})
+	}
+
+	return upload
+}
+
+func marshalParts(parts []storage.UploadPart) []*artifacts.UploadPart {
+	marshaled := []*artifacts.UploadPart{}
+	for _, part := range parts {
+		marshaled = append(marshaled, &artifacts.UploadPart{Number: int32(part.Number), Etag: part.ETag})
+	}
+
+	return marshaled
+}
@@ -12,6 +12,7 @@ import (
 	gojwt "github.com/golang-jwt/jwt/v5"
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
 	"github.com/renderedtext/go-watchman"
+	uuid "github.com/satori/go.uuid"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/api/descriptors/artifacts"
 	publicapi "github.com/semaphoreio/semaphore/artifacthub/pkg/api/public"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/jwt"
@@ -33,6 +34,13 @@ var (
 	ResourceTypes         = []string{ResourceTypeProjects, ResourceTypeWorkflows, ResourceTypeJobs}
 
 	pathRegex = regexp.MustCompile(`artifacts\/(projects|workflows|jobs)\/([a-z0-9\-]{36})\/*`)
+
+	signedURLOperations = map[artifacts.GenerateSignedURLsRequest_Type]string{
+		artifacts.GenerateSignedURLsRequest_PUSH:      jwt.OperationPush,
+		artifacts.GenerateSignedURLsRequest_PUSHFORCE: jwt.OperationPush,
+		artifacts.GenerateSignedURLsRequest_PULL:      jwt.OperationPull,
+		artifacts.GenerateSignedURLsRequest_YANK:      jwt.OperationYank,
+	}
 )
 
 const (
@@ -91,7 +99,7 @@ func (s *Server) GenerateSignedURLs(ctx context.Context,
 		return response, nil
 	}
 
-	artifact, claims, err := s.authenticateAndGetClaims(token, q.Paths)
+	artifact, claims, err := s.authenticateAndGetClaims(token, q.Paths, signedURLOperations[q.Type])
 	if err != nil {
 		log.Error("Error authenticating request", zap.Error(err))
 		return nil, err
@@ -107,12 +115,19 @@ func (s *Server) GenerateSignedURLs(ctx context.Context,
 		zap.String("workflow", claims.Workflow),
 	)
 
+	push := q.Type == artifacts.GenerateSignedURLsRequest_PUSH || q.Type == artifacts.GenerateSignedURLsRequest_PUSHFORCE
+	if push {
+		if err := models.ObjectLabels(q.Labels).Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
 	var us []*artifacts.SignedURL
 	switch q.Type {
 	case artifacts.GenerateSignedURLsRequest_PUSH:
-		us, err = publicapi.GenerateSignedURLPush(ctx, s.StorageClient, artifact, q.Paths, false)
+		us, err = publicapi.GenerateSignedURLPush(ctx, s.StorageClient, artifact, q.Paths, q.Digests, false)
 	case artifacts.GenerateSignedURLsRequest_PUSHFORCE:
-		us, err = publicapi.GenerateSignedURLPush(ctx, s.StorageClient, artifact, q.Paths, true)
+		us, err = publicapi.GenerateSignedURLPush(ctx, s.StorageClient, artifact, q.Paths, q.Digests, true)
 	case artifacts.GenerateSignedURLsRequest_PULL:
 		us, err = publicapi.GenerateSignedURLPull(ctx, s.StorageClient, artifact, q.Paths[0])
 	case artifacts.GenerateSignedURLsRequest_YANK:
@@ -124,12 +139,23 @@ func (s *Server) GenerateSignedURLs(ctx context.Context,
 		switch err {
 		case publicapi.ErrArtifactNotFound:
 			return nil, status.Error(codes.NotFound, err.Error())
+		case publicapi.ErrStorageQuotaExceeded:
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		case publicapi.ErrInvalidDigests:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		default:
 			log.Error("[GenerateSignedURLs] Unknown error", zap.Error(err))
 			return nil, err
 		}
 	}
 
+	if push {
+		force := q.Type == artifacts.GenerateSignedURLsRequest_PUSHFORCE
+		if err := recordMetadata(artifact, claims, q.Paths, q.Labels, force); err != nil {
+			return nil, err
+		}
+	}
+
 	response.URLs = us
 	log.Debug("[GenerateSignedURLs] Sending", zap.Reflect("response", response))
 	return response, nil
@@ -163,8 +189,10 @@ func (s *Server) serveWithListener(lis net.Listener) error {
 			recovery.StreamServerInterceptor(opts...),
 		),
 		grpc.KeepaliveParams(keepalive.ServerParameters{
-			MaxConnectionAge:      time.Minute,
-			MaxConnectionAgeGrace: 30 * time.Second,
+			MaxConnectionAge: time.Minute,
+			// Archive downloads are streamed on a single call, and are
+			// cut off when the grace period after the max age ends.
+			MaxConnectionAgeGrace: 10 * time.Minute,
 		}),
 		grpc.MaxRecvMsgSize(getMaxReceiveMessageSize()),
 	)
@@ -185,7 +213,7 @@ func getMaxReceiveMessageSize() int {
 	return maxReceiveMsgSize
 }
 
-func (s *Server) authenticateAndGetClaims(token string, paths []string) (*models.Artifact, *jwt.Claims, error) {
+func (s *Server) authenticateAndGetClaims(token string, paths []string, operation string) (*models.Artifact, *jwt.Claims, error) {
 	resourceType, resourceID, err := s.findAndValidateResource(paths)
 	if err != nil {
 		return nil, nil, err
@@ -196,6 +224,11 @@ func (s *Server) authenticateAndGetClaims(token string, paths []string) (*models
 		return nil, nil, status.Error(codes.PermissionDenied, err.Error())
 	}
 
+	err = authorizeClaims(claims, operation, paths)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	artifacts, err := models.FindArtifactByID(claims.ArtifactID)
 	if err != nil {
 		return nil, nil, err
@@ -204,6 +237,41 @@ func (s *Server) authenticateAndGetClaims(token string, paths []string) (*models
 	return artifacts, claims, nil
 }
 
+// authorizeClaims checks the restrictions of scoped tokens, and if the token was revoked.
+func authorizeClaims(claims *jwt.Claims, operation string, paths []string) error {
+	if !claims.Allows(operation) {
+		return status.Errorf(codes.PermissionDenied, "token does not allow %s", operation)
+	}
+
+	for _, p := range paths {
+		loc := pathRegex.FindStringIndex(p)
+		if !claims.AllowsPath(p[loc[1]:]) {
+			return status.Errorf(codes.PermissionDenied, "token does not allow access to %s", p)
+		}
+	}
+
+	// Tokens issued before they had IDs can't be revoked.
+	if claims.ID == "" {
+		return nil
+	}
+
+	id, err := uuid.FromString(claims.ID)
+	if err != nil {
+		return status.Error(codes.PermissionDenied, "invalid claim: jti")
+	}
+
+	revoked, err := models.IsTokenRevoked(id)
+	if err != nil {
+		return err
+	}
+
+	if revoked {
+		return status.Error(codes.PermissionDenied, "token has been revoked")
+	}
+
+	return nil
+}
+
 func (s *Server) validateJWT(resourceType, resourceID, token string) (*jwt.Claims, error) {
 	switch resourceType {
 	case ResourceTypeJobs:
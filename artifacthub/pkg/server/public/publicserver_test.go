@@ -2,6 +2,8 @@ package publicserver
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net"
 	"os"
@@ -12,6 +14,7 @@ import (
 
 	uuid "github.com/satori/go.uuid"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/api/descriptors/artifacts"
+	privateapi "github.com/semaphoreio/semaphore/artifacthub/pkg/api/private"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/jwt"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/storage"
@@ -171,6 +174,73 @@ func Test__EmptyWorkflowJWTClaimHasNoAccessToAnyWorkflows(t *testing.T) {
 	})
 }
 
+func Test__ScopedTokens(t *testing.T) {
+	storage.RunTestForAllBackends(t, func(backend string, client storage.Client) {
+		baseClaims := jwt.Claims{
+			ID:         uuid.NewV4().String(),
+			Project:    uuid.NewV4().String(),
+			Workflow:   uuid.NewV4().String(),
+			Job:        uuid.NewV4().String(),
+			Operations: []string{jwt.OperationPull},
+			PathPrefix: "first/",
+		}
+
+		generate := func(ctx context.Context, server *Server, t artifacts.GenerateSignedURLsRequest_Type, paths ...string) error {
+			_, err := server.GenerateSignedURLs(ctx, &artifacts.GenerateSignedURLsRequest{Type: t, Paths: paths})
+			return err
+		}
+
+		t.Run(backend+"/allowed operation under the prefix", func(t *testing.T) {
+			server, claims, ctx := prepareTestWithClaims(t, ResourceTypeJobs, client, baseClaims)
+
+			err := generate(ctx, server, artifacts.GenerateSignedURLsRequest_PULL, getPath(ResourceTypeJobs, claims, "first/file1.txt"))
+			assert.NoError(t, err)
+		})
+
+		t.Run(backend+"/operations not allowed", func(t *testing.T) {
+			server, claims, ctx := prepareTestWithClaims(t, ResourceTypeJobs, client, baseClaims)
+			p := getPath(ResourceTypeJobs, claims, "first/file1.txt")
+
+			for _, requestType := range []artifacts.GenerateSignedURLsRequest_Type{
+				artifacts.GenerateSignedURLsRequest_PUSH,
+				artifacts.GenerateSignedURLsRequest_PUSHFORCE,
+				artifacts.GenerateSignedURLsRequest_YANK,
+			} {
+				err := generate(ctx, server, requestType, p)
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			}
+
+			_, err := server.StartMultipartUpload(ctx, &artifacts.StartMultipartUploadRequest{Path: p, Size: 1})
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		})
+
+		t.Run(backend+"/paths outside of the prefix", func(t *testing.T) {
+			server, claims, ctx := prepareTestWithClaims(t, ResourceTypeJobs, client, baseClaims)
+
+			for _, p := range []string{"second/file1.txt", "first-other/file.txt", "first/../second/file1.txt", ""} {
+				err := generate(ctx, server, artifacts.GenerateSignedURLsRequest_PULL, getPath(ResourceTypeJobs, claims, p))
+				assert.Equal(t, codes.PermissionDenied, status.Code(err), p)
+			}
+
+			err := server.DownloadArchive(&artifacts.DownloadArchiveRequest{
+				Path: getPath(ResourceTypeJobs, claims, "second"),
+			}, &archiveStream{ctx: ctx})
+
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		})
+
+		t.Run(backend+"/revoked token", func(t *testing.T) {
+			server, claims, ctx := prepareTestWithClaims(t, ResourceTypeJobs, client, baseClaims)
+			p := getPath(ResourceTypeJobs, claims, "first/file1.txt")
+			require.NoError(t, models.RevokeToken(uuid.FromStringOrNil(claims.ID), time.Now().Add(time.Hour)))
+
+			err := generate(ctx, server, artifacts.GenerateSignedURLsRequest_PULL, p)
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			assert.Contains(t, err.Error(), "token has been revoked")
+		})
+	})
+}
+
 func Test__GetSignedURLForPush(t *testing.T) {
 	storage.RunTestForAllBackends(t, func(backend string, client storage.Client) {
 		for _, resourceType := range ResourceTypes {
@@ -289,6 +359,257 @@ func Test__GetSignedURLForForcePush(t *testing.T) {
 	})
 }
 
+func Test__GetSignedURLForPushWithStorageQuota(t *testing.T) {
+	storage.RunTestForAllBackends(t, func(backend string, client storage.Client) {
+		server, claims, ctx := prepareTest(t, ResourceTypeJobs, client)
+		request := &artifacts.GenerateSignedURLsRequest{
+			Type:  artifacts.GenerateSignedURLsRequest_PUSH,
+			Paths: []string{getPath(ResourceTypeJobs, claims, "newfile1.txt")},
+		}
+
+		artifact, err := models.FindArtifactByID(claims.ArtifactID)
+		require.NoError(t, err)
+
+		orgID := uuid.NewV4()
+		require.NoError(t, artifact.UpdateOrgID(orgID))
+
+		usage := []models.ArtifactUsage{{Category: models.UsageCategoryJob, CategoryID: claims.Job, Size: 100, ObjectCount: 1}}
+		require.NoError(t, models.ReplaceArtifactUsage(artifact.ID, usage, time.Now()))
+
+		t.Run(backend+"/under the quota => URLs are generated", func(t *testing.T) {
+			_, err := models.SaveStorageQuota(orgID, 200, 300)
+			require.NoError(t, err)
+
+			response, err := server.GenerateSignedURLs(ctx, request)
+			require.NoError(t, err)
+			assert.Len(t, response.URLs, 2)
+		})
+
+		t.Run(backend+"/over the soft limit => URLs are generated", func(t *testing.T) {
+			_, err := models.SaveStorageQuota(orgID, 50, 300)
+			require.NoError(t, err)
+
+			response, err := server.GenerateSignedURLs(ctx, request)
+			require.NoError(t, err)
+			assert.Len(t, response.URLs, 2)
+		})
+
+		t.Run(backend+"/over the hard limit => resource exhausted", func(t *testing.T) {
+			_, err := models.SaveStorageQuota(orgID, 50, 100)
+			require.NoError(t, err)
+
+			_, err = server.GenerateSignedURLs(ctx, request)
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		})
+
+		t.Run(backend+"/pulling is not limited", func(t *testing.T) {
+			response, err := server.GenerateSignedURLs(ctx, &artifacts.GenerateSignedURLsRequest{
+				Type:  artifacts.GenerateSignedURLsRequest_PULL,
+				Paths: []string{getPath(ResourceTypeJobs, claims, "first/file1.txt")},
+			})
+
+			require.NoError(t, err)
+			assert.NotEmpty(t, response.URLs)
+		})
+	})
+}
+
+func Test__GetSignedURLForPushWithStorageQuotaBeforeOrganizations(t *testing.T) {
+	storage.RunTestForAllBackends(t, func(backend string, client storage.Client) {
+		server, claims, ctx := prepareTest(t, ResourceTypeJobs, client)
+		request := &artifacts.GenerateSignedURLsRequest{
+			Type:  artifacts.GenerateSignedURLsRequest_PUSH,
+			Paths: []string{getPath(ResourceTypeJobs, claims, "newfile1.txt")},
+		}
+
+		// created before organizations were recorded
+		artifact, err := models.FindArtifactByID(claims.ArtifactID)
+		require.NoError(t, err)
+		require.Nil(t, artifact.OrgID)
+
+		orgID := uuid.NewV4()
+		_, err = models.SaveStorageQuota(orgID, 50, 100)
+		require.NoError(t, err)
+
+		usage := []models.ArtifactUsage{{Category: models.UsageCategoryJob, CategoryID: claims.Job, Size: 100, ObjectCount: 1}}
+		require.NoError(t, models.ReplaceArtifactUsage(artifact.ID, usage, time.Now()))
+
+		t.Run(backend+"/without an organization => URLs are generated", func(t *testing.T) {
+			response, err := server.GenerateSignedURLs(ctx, request)
+			require.NoError(t, err)
+			assert.Len(t, response.URLs, 2)
+		})
+
+		t.Run(backend+"/once the organization is set => resource exhausted", func(t *testing.T) {
+			require.NoError(t, privateapi.SetArtifactOrganization(artifact.ID.String(), orgID.String()))
+
+			_, err := server.GenerateSignedURLs(ctx, request)
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		})
+	})
+}
+
+func Test__GetSignedURLForPushWithDigests(t *testing.T) {
+	storage.RunTestForAllBackends(t, func(backend string, client storage.Client) {
+		server, claims, ctx := prepareTest(t, ResourceTypeJobs, client)
+		content := sha256.Sum256([]byte("content"))
+		digest := hex.EncodeToString(content[:])
+
+		t.Run(backend+"/digests not matching paths => invalid argument", func(t *testing.T) {
+			_, err := server.GenerateSignedURLs(ctx, &artifacts.GenerateSignedURLsRequest{
+				Type:    artifacts.GenerateSignedURLsRequest_PUSH,
+				Paths:   []string{getPath(ResourceTypeJobs, claims, "a.txt"), getPath(ResourceTypeJobs, claims, "b.txt")},
+				Digests: []string{digest},
+			})
+
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+
+		t.Run(backend+"/invalid digest => invalid argument", func(t *testing.T) {
+			_, err := server.GenerateSignedURLs(ctx, &artifacts.GenerateSignedURLsRequest{
+				Type:    artifacts.GenerateSignedURLsRequest_PUSH,
+				Paths:   []string{getPath(ResourceTypeJobs, claims, "a.txt")},
+				Digests: []string{"not-a-digest"},
+			})
+
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+
+		t.Run(backend+"/new blob => single URL for uploading the blob", func(t *testing.T) {
+			p := getPath(ResourceTypeJobs, claims, "a.txt")
+			response, err := server.GenerateSignedURLs(ctx, &artifacts.GenerateSignedURLsRequest{
+				Type:    artifacts.GenerateSignedURLsRequest_PUSH,
+				Paths:   []string{p},
+				Digests: []string{digest},
+			})
+
+			require.NoError(t, err)
+			require.Len(t, response.URLs, 1)
+			assert.Equal(t, artifacts.SignedURL_PUT, response.URLs[0].Method)
+			assert.Contains(t, response.URLs[0].URL, "blobs/sha256/"+digest)
+			assert.Equal(t, digest, response.URLs[0].Digest)
+			assert.Equal(t, p, response.URLs[0].Path)
+			assert.False(t, response.URLs[0].AlreadyPresent)
+		})
+
+		artifact, err := models.FindArtifactByID(claims.ArtifactID)
+		require.NoError(t, err)
+
+		bucket := client.GetBucket(storage.BucketOptions{Name: artifact.BucketName, PathPrefix: artifact.IdempotencyToken})
+		require.NoError(t, bucket.CreateObject(context.Background(), "blobs/sha256/"+digest, []byte("content")))
+
+		t.Run(backend+"/stored blob => already present", func(t *testing.T) {
+			p := getPath(ResourceTypeJobs, claims, "b.txt")
+			response, err := server.GenerateSignedURLs(ctx, &artifacts.GenerateSignedURLsRequest{
+				Type:    artifacts.GenerateSignedURLsRequest_PUSH,
+				Paths:   []string{p},
+				Digests: []string{strings.ToUpper(digest)},
+			})
+
+			require.NoError(t, err)
+			require.Len(t, response.URLs, 1)
+			assert.True(t, response.URLs[0].AlreadyPresent)
+			assert.Empty(t, response.URLs[0].URL)
+			assert.Equal(t, digest, response.URLs[0].Digest)
+			assert.Equal(t, p, response.URLs[0].Path)
+		})
+
+		t.Run(backend+"/existing path without force => HEAD and PUT for the path", func(t *testing.T) {
+			p := getPath(ResourceTypeJobs, claims, "first/file1.txt")
+			response, err := server.GenerateSignedURLs(ctx, &artifacts.GenerateSignedURLsRequest{
+				Type:    artifacts.GenerateSignedURLsRequest_PUSH,
+				Paths:   []string{p},
+				Digests: []string{digest},
+			})
+
+			require.NoError(t, err)
+			require.Len(t, response.URLs, 2)
+			assert.Equal(t, artifacts.SignedURL_HEAD, response.URLs[0].Method)
+			assert.Equal(t, artifacts.SignedURL_PUT, response.URLs[1].Method)
+		})
+
+		t.Run(backend+"/pulling a path pushed with a digest => blob URL", func(t *testing.T) {
+			p := getPath(ResourceTypeJobs, claims, "b.txt")
+			response, err := server.GenerateSignedURLs(ctx, &artifacts.GenerateSignedURLsRequest{
+				Type:  artifacts.GenerateSignedURLsRequest_PULL,
+				Paths: []string{p},
+			})
+
+			require.NoError(t, err)
+			require.Len(t, response.URLs, 1)
+			assert.Equal(t, artifacts.SignedURL_GET, response.URLs[0].Method)
+			assert.Contains(t, response.URLs[0].URL, "blobs/sha256/"+digest)
+			assert.Equal(t, digest, response.URLs[0].Digest)
+			assert.Equal(t, p, response.URLs[0].Path)
+		})
+
+		t.Run(backend+"/blob not matching its digest => uploaded again", func(t *testing.T) {
+			otherDigest := strings.Repeat("ab", 32)
+			require.NoError(t, bucket.CreateObject(context.Background(), "blobs/sha256/"+otherDigest, []byte("something else")))
+
+			_, err := server.GenerateSignedURLs(ctx, &artifacts.GenerateSignedURLsRequest{
+				Type:    artifacts.GenerateSignedURLsRequest_PUSH,
+				Paths:   []string{getPath(ResourceTypeJobs, claims, "c.txt")},
+				Digests: []string{otherDigest},
+			})
+
+			require.NoError(t, err)
+
+			response, err := server.GenerateSignedURLs(ctx, &artifacts.GenerateSignedURLsRequest{
+				Type:    artifacts.GenerateSignedURLsRequest_PUSH,
+				Paths:   []string{getPath(ResourceTypeJobs, claims, "d.txt")},
+				Digests: []string{otherDigest},
+			})
+
+			require.NoError(t, err)
+			require.Len(t, response.URLs, 1)
+			assert.False(t, response.URLs[0].AlreadyPresent)
+			assert.Contains(t, response.URLs[0].URL, "blobs/sha256/"+otherDigest)
+
+			exists, err := bucket.IsFile(context.Background(), "blobs/sha256/"+otherDigest)
+			require.NoError(t, err)
+			assert.False(t, exists)
+		})
+
+		t.Run(backend+"/blob never uploaded => path can't be pulled, and is pushed again", func(t *testing.T) {
+			p := getPath(ResourceTypeJobs, claims, "c.txt")
+			_, err := server.GenerateSignedURLs(ctx, &artifacts.GenerateSignedURLsRequest{
+				Type:  artifacts.GenerateSignedURLsRequest_PULL,
+				Paths: []string{p},
+			})
+
+			assert.Equal(t, codes.NotFound, status.Code(err))
+
+			response, err := server.GenerateSignedURLs(ctx, &artifacts.GenerateSignedURLsRequest{
+				Type:    artifacts.GenerateSignedURLsRequest_PUSH,
+				Paths:   []string{p},
+				Digests: []string{digest},
+			})
+
+			require.NoError(t, err)
+			require.Len(t, response.URLs, 1)
+			assert.True(t, response.URLs[0].AlreadyPresent)
+		})
+
+		t.Run(backend+"/yanking a path pushed with a digest => refs are released", func(t *testing.T) {
+			p := getPath(ResourceTypeJobs, claims, "b.txt")
+			response, err := server.GenerateSignedURLs(ctx, &artifacts.GenerateSignedURLsRequest{
+				Type:  artifacts.GenerateSignedURLsRequest_YANK,
+				Paths: []string{p},
+			})
+
+			require.NoError(t, err)
+			require.Len(t, response.URLs, 1)
+			assert.Equal(t, artifacts.SignedURL_DELETE, response.URLs[0].Method)
+			assert.Contains(t, response.URLs[0].URL, p)
+
+			refs, err := models.ListObjectRefs(artifact.ID)
+			require.NoError(t, err)
+			assert.NotContains(t, refs, p)
+		})
+	})
+}
+
 func Test__GetSignedURLForPull(t *testing.T) {
 	storage.RunTestForAllBackends(t, func(backend string, client storage.Client) {
 		for _, resourceType := range ResourceTypes {
@@ -3,6 +3,7 @@ package storage
 import (
 	"context"
 	"fmt"
+	"io"
 	"time"
 )
 
@@ -24,6 +25,18 @@ type SignURLOptions struct {
 	Method             string
 	PathPrefix         string
 	IncludeContentType bool
+
+	// ContentTypePath is used to pick the content type instead of Path,
+	// for objects stored under a path without the original file name.
+	ContentTypePath string
+}
+
+func (o *SignURLOptions) contentTypePath() string {
+	if o.ContentTypePath != "" {
+		return o.ContentTypePath
+	}
+
+	return o.Path
 }
 
 type Bucket interface {
@@ -31,6 +44,12 @@ type Bucket interface {
 	ListObjectsWithPagination(options ListOptions) (ObjectPager, error)
 	DeleteObjects(paths []string) error
 	CreateObject(ctx context.Context, objectName string, content []byte) error
+	ReadObject(ctx context.Context, path string) (io.ReadCloser, error)
+	ObjectSize(ctx context.Context, path string) (int64, error)
+
+	// CopyObject copies an object into another bucket of the same storage backend,
+	// or into another path of the same bucket, without downloading it.
+	CopyObject(ctx context.Context, path string, dst Bucket, dstPath string) error
 	IsDir(ctx context.Context, path string) (bool, error)
 	IsFile(ctx context.Context, path string) (bool, error)
 	DeletePath(ctx context.Context, path string) error
@@ -67,6 +86,7 @@ type PathIterator interface {
 type Object struct {
 	Path string
 	Age  *time.Duration
+	Size int64
 }
 
 type ObjectPager interface {
@@ -75,3 +95,5 @@ type ObjectPager interface {
 
 var ErrNoMoreObjects = fmt.Errorf("no more objects in the storage")
 var ErrMissingBucket = fmt.Errorf("storage: bucket doesn't exist")
+var ErrMissingObject = fmt.Errorf("storage: object doesn't exist")
+var ErrIncompatibleBucket = fmt.Errorf("storage: buckets are in different storage backends")
@@ -13,17 +13,27 @@ import (
 )
 
 func AppendContentType(mimes map[string]bool, extraMimes map[string]string, URL, path string) string {
+	if m := inlineContentType(mimes, extraMimes, path); m != "" {
+		return appendResponseOverrides(URL, m)
+	}
+
+	return URL // will download as before
+}
+
+// inlineContentType returns the mime the file should be opened in the browser with,
+// or an empty string, if it should be downloaded.
+func inlineContentType(mimes map[string]bool, extraMimes map[string]string, path string) string {
 	fileType := filepath.Ext(path)
 	if m, ok := extraMimes[fileType]; ok { // force extra mime
-		return appendResponseOverrides(URL, m)
+		return m
 	}
 
 	m := mime.TypeByExtension(fileType) // the real mime of that file type
 	if _, ok := mimes[m]; ok {          // if we need it to be openable in browser
-		return appendResponseOverrides(URL, m)
+		return m
 	}
 
-	return URL // will download as before
+	return ""
 }
 
 // appendResponseOverrides appends the GCS response-content-* override query
@@ -50,6 +50,10 @@ type Gcs struct {
 	Mimes                   map[string]bool
 	ExtraMimes              map[string]string
 	ManageBucketPermissions bool
+
+	// Resumable sessions are started through the JSON API of the emulator,
+	// since it doesn't serve signed URLs.
+	EmulatorHost string
 }
 
 var _ Client = &Gcs{}
@@ -121,6 +125,7 @@ func newGcsClientForEmulator() (*Gcs, error) {
 		Mimes:                   LoadMimes(),
 		ExtraMimes:              LoadExtraMimes(),
 		ManageBucketPermissions: false,
+		EmulatorHost:            os.Getenv("STORAGE_EMULATOR_HOST"),
 	}, nil
 }
 
@@ -249,7 +254,7 @@ func (c *Gcs) SignURL(ctx context.Context, options SignURLOptions) (string, erro
 		return url, nil
 	}
 
-	return AppendContentType(c.Mimes, c.ExtraMimes, url, options.Path), nil
+	return AppendContentType(c.Mimes, c.ExtraMimes, url, options.contentTypePath()), nil
 }
 
 func (c *Gcs) DestroyBucket(ctx context.Context, options BucketOptions) error {
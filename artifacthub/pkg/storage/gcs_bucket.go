@@ -90,6 +90,47 @@ func (b *GcsBucket) IsFile(ctx context.Context, path string) (bool, error) {
 	return false, l.ErrorCode(codes.Unknown, "IsFile in GCS", err)
 }
 
+func (b *GcsBucket) ReadObject(ctx context.Context, path string) (io.ReadCloser, error) {
+	reader, err := b.BucketHandler.Object(path).NewReader(ctx)
+	if err == gcsstorage.ErrObjectNotExist {
+		return nil, ErrMissingObject
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return reader, nil
+}
+
+func (b *GcsBucket) ObjectSize(ctx context.Context, path string) (int64, error) {
+	attrs, err := b.BucketHandler.Object(path).Attrs(ctx)
+	if err == gcsstorage.ErrObjectNotExist {
+		return 0, ErrMissingObject
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return attrs.Size, nil
+}
+
+func (b *GcsBucket) CopyObject(ctx context.Context, path string, dst Bucket, dstPath string) error {
+	dstBucket, ok := dst.(*GcsBucket)
+	if !ok {
+		return ErrIncompatibleBucket
+	}
+
+	copier := dstBucket.BucketHandler.Object(dstPath).CopierFrom(b.BucketHandler.Object(path))
+	_, err := copier.Run(ctx)
+	if err == gcsstorage.ErrObjectNotExist {
+		return ErrMissingObject
+	}
+
+	return err
+}
+
 func (b *GcsBucket) IsDir(ctx context.Context, dir string) (bool, error) {
 	if len(dir) == 0 {
 		return true, nil
@@ -0,0 +1,108 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	gcsstorage "cloud.google.com/go/storage"
+)
+
+var _ MultipartClient = &Gcs{}
+
+var resumableSessionClient = &http.Client{Timeout: 30 * time.Second}
+
+// StartMultipartUpload starts a resumable upload session. The session URI works
+// without any other credentials, and GCS keeps it alive for a week.
+func (c *Gcs) StartMultipartUpload(ctx context.Context, options MultipartUploadOptions) (*MultipartUpload, error) {
+	startURL, err := c.resumableStartURL(options)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, startURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("x-goog-resumable", "start")
+
+	response, err := resumableSessionClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("failed to start resumable upload: %s", response.Status)
+	}
+
+	sessionURI := response.Header.Get("Location")
+	if sessionURI == "" {
+		return nil, fmt.Errorf("failed to start resumable upload: no session URI returned")
+	}
+
+	return &MultipartUpload{ID: sessionURI, PartSize: options.PartSize(), SessionURI: sessionURI}, nil
+}
+
+func (c *Gcs) resumableStartURL(options MultipartUploadOptions) (string, error) {
+	if c.EmulatorHost != "" {
+		query := url.Values{"uploadType": {"resumable"}, "name": {options.Path}}
+		return fmt.Sprintf("%s/upload/storage/v1/b/%s/o?%s", strings.TrimSuffix(c.EmulatorHost, "/"), options.BucketName, query.Encode()), nil
+	}
+
+	return gcsstorage.SignedURL(options.BucketName, options.Path, &gcsstorage.SignedURLOptions{
+		GoogleAccessID: c.Credentials.ClientEmail,
+		PrivateKey:     []byte(c.Credentials.PrivateKey),
+		Method:         http.MethodPost,
+		Headers:        []string{"x-goog-resumable:start"},
+		Scheme:         gcsstorage.SigningSchemeV4,
+		Expires:        time.Now().Add(time.Minute * SignedURLExpireInMinutes),
+	})
+}
+
+// ResumeMultipartUpload returns the same session. Clients ask the session
+// how much of the file it received, and continue from there.
+func (c *Gcs) ResumeMultipartUpload(ctx context.Context, options MultipartUploadOptions, uploadID string) (*MultipartUpload, error) {
+	return &MultipartUpload{ID: uploadID, PartSize: options.PartSize(), SessionURI: uploadID}, nil
+}
+
+// CompleteMultipartUpload checks the object is there, since
+// resumable sessions finish on their own with the last chunk.
+func (c *Gcs) CompleteMultipartUpload(ctx context.Context, options MultipartUploadOptions, uploadID string, parts []UploadPart) error {
+	_, err := c.Client.Bucket(options.BucketName).Object(options.Path).Attrs(ctx)
+	if errors.Is(err, gcsstorage.ErrObjectNotExist) {
+		return ErrMultipartUploadIncomplete
+	}
+
+	return err
+}
+
+// Deleting a session is answered with 499 Client Closed Request.
+func (c *Gcs) AbortMultipartUpload(ctx context.Context, options MultipartUploadOptions, uploadID string) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodDelete, uploadID, nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := resumableSessionClient.Do(request)
+	if err != nil {
+		return err
+	}
+
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case 499, http.StatusNoContent, http.StatusOK:
+		return nil
+	case http.StatusNotFound, http.StatusGone:
+		return ErrMultipartUploadNotFound
+	default:
+		return fmt.Errorf("failed to abort resumable upload: %s", response.Status)
+	}
+}
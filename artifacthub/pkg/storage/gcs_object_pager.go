@@ -25,6 +25,7 @@ func (i *GcsObjectPager) NextPage() ([]*Object, string, error) {
 		result = append(result, &Object{
 			Path: rawObjects[i].Name,
 			Age:  &age,
+			Size: rawObjects[i].Size,
 		})
 	}
 
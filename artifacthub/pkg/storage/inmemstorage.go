@@ -1,7 +1,9 @@
 package storage
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"strconv"
 	"time"
 )
@@ -11,8 +13,9 @@ type InMemoryStorage struct {
 }
 
 type InMemoryBucket struct {
-	Name    string
-	Objects []*PathItem
+	Name     string
+	Objects  []*PathItem
+	Contents map[string][]byte
 }
 
 type InMemoryObjectIterator struct {
@@ -42,7 +45,7 @@ func (c *InMemoryStorage) GetBucket(options BucketOptions) Bucket {
 		return c.buckets[name]
 	}
 
-	c.buckets[name] = &InMemoryBucket{Name: name, Objects: []*PathItem{}}
+	c.buckets[name] = &InMemoryBucket{Name: name, Objects: []*PathItem{}, Contents: map[string][]byte{}}
 	return c.buckets[name]
 }
 
@@ -117,6 +120,7 @@ func (p *InMemoryObjectPager) NextPage() ([]*Object, string, error) {
 		result = append(result, &Object{
 			Path: p.bucket.Objects[i].Path,
 			Age:  p.bucket.Objects[i].Age,
+			Size: p.bucket.Objects[i].Size,
 		})
 	}
 
@@ -197,5 +201,38 @@ func (b *InMemoryBucket) CreateObject(ctx context.Context, name string, content
 		Age:         nil,
 		Size:        int64(len(content)),
 	})
+	b.Contents[name] = content
 	return nil
 }
+
+func (b *InMemoryBucket) ObjectSize(ctx context.Context, path string) (int64, error) {
+	content, ok := b.Contents[path]
+	if !ok {
+		return 0, ErrMissingObject
+	}
+
+	return int64(len(content)), nil
+}
+
+func (b *InMemoryBucket) CopyObject(ctx context.Context, path string, dst Bucket, dstPath string) error {
+	dstBucket, ok := dst.(*InMemoryBucket)
+	if !ok {
+		return ErrIncompatibleBucket
+	}
+
+	content, ok := b.Contents[path]
+	if !ok {
+		return ErrMissingObject
+	}
+
+	return dstBucket.CreateObject(ctx, dstPath, content)
+}
+
+func (b *InMemoryBucket) ReadObject(ctx context.Context, path string) (io.ReadCloser, error) {
+	content, ok := b.Contents[path]
+	if !ok {
+		return nil, ErrMissingObject
+	}
+
+	return io.NopCloser(bytes.NewReader(content)), nil
+}
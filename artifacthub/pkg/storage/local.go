@@ -0,0 +1,178 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/util/random"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/util/retry"
+)
+
+// LocalStorage keeps artifacts on the local disk,
+// for installations that do not want to run an object store.
+// Every bucket is a directory under <Path>/buckets, and files
+// are uploaded and downloaded through signed URLs served by ServeHTTP.
+type LocalStorage struct {
+	Path       string
+	URL        *url.URL
+	SigningKey []byte
+	Mimes      map[string]bool
+	ExtraMimes map[string]string
+}
+
+type LocalOptions struct {
+	Path       string
+	URL        string
+	SigningKey string
+}
+
+var _ Client = &LocalStorage{}
+
+func NewLocalClient(options LocalOptions) (*LocalStorage, error) {
+	if options.Path == "" {
+		return nil, fmt.Errorf("local storage path is required")
+	}
+
+	if options.SigningKey == "" {
+		return nil, fmt.Errorf("local storage signing key is required")
+	}
+
+	u, err := url.Parse(options.URL)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("local storage URL '%s' is invalid", options.URL)
+	}
+
+	root, err := filepath.Abs(options.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &LocalStorage{
+		Path:       root,
+		URL:        u,
+		SigningKey: []byte(options.SigningKey),
+		Mimes:      LoadMimes(),
+		ExtraMimes: LoadExtraMimes(),
+	}
+
+	for _, dir := range []string{client.bucketsDir(), client.tmpDir()} {
+		if err := os.MkdirAll(dir, 0750); err != nil {
+			return nil, err
+		}
+	}
+
+	return client, nil
+}
+
+func (c *LocalStorage) bucketsDir() string {
+	return filepath.Join(c.Path, "buckets")
+}
+
+// Uploads are written here first, and moved into the bucket when complete,
+// so a partially uploaded file is never visible.
+func (c *LocalStorage) tmpDir() string {
+	return filepath.Join(c.Path, "tmp")
+}
+
+func (c *LocalStorage) GetBucket(options BucketOptions) Bucket {
+	return c.getBucket(options)
+}
+
+func (c *LocalStorage) getBucket(options BucketOptions) *LocalBucket {
+	return &LocalBucket{
+		Root:       filepath.Join(c.bucketsDir(), options.Name),
+		TmpDir:     c.tmpDir(),
+		BucketName: options.Name,
+		PathPrefix: options.PathPrefix,
+	}
+}
+
+func (c *LocalStorage) CreateBucket(ctx context.Context) (string, error) {
+	var bucketName string
+
+	err := retry.OnFailure(ctx, "Bucket creation", func() error {
+		name, err := random.RandomNameStr(ctx)
+		if err != nil {
+			return err
+		}
+
+		// Mkdir fails if the directory already exists,
+		// so we never hand out the same bucket twice.
+		bucketName = name
+		return os.Mkdir(filepath.Join(c.bucketsDir(), name), 0750)
+	})
+
+	return bucketName, err
+}
+
+func (c *LocalStorage) DestroyBucket(ctx context.Context, options BucketOptions) error {
+	return c.getBucket(options).Destroy(ctx)
+}
+
+func (c *LocalStorage) SignURL(ctx context.Context, options SignURLOptions) (string, error) {
+	switch options.Method {
+	case "GET", "HEAD", "PUT", "DELETE":
+	default:
+		return "", fmt.Errorf("method %s not supported", options.Method)
+	}
+
+	key := options.Path
+	if options.PathPrefix != "" {
+		key = fmt.Sprintf("%s/%s", options.PathPrefix, options.Path)
+	}
+
+	expires := time.Now().Add(SignedURLExpireInMinutes * time.Minute).Unix()
+
+	// Unlike object stores, the response overrides are part of the signature,
+	// so they can't be changed to make the browser render something else.
+	overrides := responseOverrides{}
+	if options.IncludeContentType {
+		if contentType := inlineContentType(c.Mimes, c.ExtraMimes, options.contentTypePath()); contentType != "" {
+			overrides = responseOverrides{contentType: contentType, disposition: "inline"}
+		}
+	}
+
+	u := *c.URL
+	u.Path = fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(c.URL.Path, "/"), options.BucketName, key)
+	u.RawPath = ""
+
+	query := url.Values{
+		"Method":    []string{options.Method},
+		"Expires":   []string{strconv.FormatInt(expires, 10)},
+		"Signature": []string{c.signature(options.Method, options.BucketName, key, expires, overrides)},
+	}
+
+	if overrides.contentType != "" {
+		query.Set("response-content-type", overrides.contentType)
+		query.Set("response-content-disposition", overrides.disposition)
+	}
+
+	// Spaces are encoded as %20, for the same reasons appendResponseOverrides does it.
+	u.RawQuery = strings.ReplaceAll(query.Encode(), "+", "%20")
+	return u.String(), nil
+}
+
+type responseOverrides struct {
+	contentType string
+	disposition string
+}
+
+func (c *LocalStorage) signature(method, bucketName, key string, expires int64, overrides responseOverrides) string {
+	fields := []string{method, bucketName, key, strconv.FormatInt(expires, 10)}
+	if overrides.contentType != "" || overrides.disposition != "" {
+		fields = append(fields, overrides.contentType, overrides.disposition)
+	}
+
+	mac := hmac.New(sha256.New, c.SigningKey)
+	mac.Write([]byte(strings.Join(fields, "\n")))
+	return hex.EncodeToString(mac.Sum(nil))
+}
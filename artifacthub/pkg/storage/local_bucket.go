@@ -0,0 +1,495 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	ctxutil "github.com/semaphoreio/semaphore/artifacthub/pkg/util/context"
+	pathutil "github.com/semaphoreio/semaphore/artifacthub/pkg/util/path"
+	"go.uber.org/zap"
+)
+
+type LocalBucket struct {
+	Root       string
+	TmpDir     string
+	BucketName string
+	PathPrefix string
+}
+
+type localFile struct {
+	Key     string
+	Size    int64
+	ModTime time.Time
+}
+
+var _ Bucket = &LocalBucket{}
+
+var errInvalidLocalPath = errors.New("invalid path")
+
+func (b *LocalBucket) prefixPath(path string) string {
+	if b.PathPrefix != "" {
+		return fmt.Sprintf("%s/%s", b.PathPrefix, path)
+	}
+
+	return path
+}
+
+func (b *LocalBucket) removePathPrefix(path string) string {
+	if b.PathPrefix != "" {
+		return strings.TrimPrefix(path, b.PathPrefix+"/")
+	}
+
+	return path
+}
+
+// checkBucket makes sure the bucket name points to a directory under
+// the buckets directory, and that this directory still exists.
+func (b *LocalBucket) checkBucket() error {
+	if b.BucketName == "" || b.BucketName == "." || b.BucketName == ".." || strings.ContainsAny(b.BucketName, `/\`) {
+		return ErrMissingBucket
+	}
+
+	info, err := os.Stat(b.Root)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return ErrMissingBucket
+		}
+
+		return err
+	}
+
+	if !info.IsDir() {
+		return ErrMissingBucket
+	}
+
+	return nil
+}
+
+// filePath returns where the object with the given key lives on disk.
+// Keys are never allowed to point outside of the bucket directory.
+func (b *LocalBucket) filePath(key string) (string, error) {
+	for _, segment := range strings.Split(key, "/") {
+		if segment == ".." {
+			return "", fmt.Errorf("%w '%s'", errInvalidLocalPath, key)
+		}
+	}
+
+	return filepath.Join(b.Root, filepath.FromSlash(key)), nil
+}
+
+// walkFiles calls fn for every file whose key starts with prefix.
+// The prefix is expected to be empty or end in a slash, like a directory.
+func (b *LocalBucket) walkFiles(prefix string, fn func(localFile) error) error {
+	if err := b.checkBucket(); err != nil {
+		return err
+	}
+
+	dir, err := b.filePath(prefix)
+	if err != nil {
+		return err
+	}
+
+	return filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Files can be deleted while we are walking the directory.
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+
+			return err
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil
+			}
+
+			return err
+		}
+
+		rel, err := filepath.Rel(b.Root, p)
+		if err != nil {
+			return err
+		}
+
+		key := filepath.ToSlash(rel)
+		if !strings.HasPrefix(key, prefix) {
+			return nil
+		}
+
+		return fn(localFile{Key: key, Size: info.Size(), ModTime: info.ModTime()})
+	})
+}
+
+// listFiles returns all the files under prefix, sorted by key,
+// which is the same order object stores list their objects in.
+func (b *LocalBucket) listFiles(prefix string) ([]localFile, error) {
+	files := []localFile{}
+	err := b.walkFiles(prefix, func(file localFile) error {
+		files = append(files, file)
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Key < files[j].Key
+	})
+
+	return files, nil
+}
+
+func (b *LocalBucket) Destroy(ctx context.Context) error {
+	if err := b.checkBucket(); err != nil {
+		if err == ErrMissingBucket {
+			return nil
+		}
+
+		return err
+	}
+
+	return os.RemoveAll(b.Root)
+}
+
+func (b *LocalBucket) IsFile(ctx context.Context, path string) (bool, error) {
+	if len(path) == 0 {
+		return false, nil
+	}
+
+	if err := b.checkBucket(); err != nil {
+		return false, err
+	}
+
+	p, err := b.filePath(b.prefixPath(path))
+	if err != nil {
+		return false, err
+	}
+
+	info, err := os.Stat(p)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return info.Mode().IsRegular(), nil
+}
+
+func (b *LocalBucket) ReadObject(ctx context.Context, path string) (io.ReadCloser, error) {
+	if err := b.checkBucket(); err != nil {
+		return nil, err
+	}
+
+	p, err := b.filePath(b.prefixPath(path))
+	if err != nil {
+		return nil, err
+	}
+
+	// #nosec
+	f, err := os.Open(p)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrMissingObject
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (b *LocalBucket) ObjectSize(ctx context.Context, path string) (int64, error) {
+	if err := b.checkBucket(); err != nil {
+		return 0, err
+	}
+
+	p, err := b.filePath(b.prefixPath(path))
+	if err != nil {
+		return 0, err
+	}
+
+	info, err := os.Stat(p)
+	if errors.Is(err, fs.ErrNotExist) || (err == nil && !info.Mode().IsRegular()) {
+		return 0, ErrMissingObject
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	return info.Size(), nil
+}
+
+func (b *LocalBucket) CopyObject(ctx context.Context, path string, dst Bucket, dstPath string) error {
+	dstBucket, ok := dst.(*LocalBucket)
+	if !ok {
+		return ErrIncompatibleBucket
+	}
+
+	reader, err := b.ReadObject(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	defer reader.Close()
+	return dstBucket.writeFile(dstBucket.prefixPath(dstPath), reader)
+}
+
+// Like in object stores, a directory exists only if there are files in it.
+func (b *LocalBucket) IsDir(ctx context.Context, path string) (bool, error) {
+	if len(path) == 0 {
+		return true, nil
+	}
+
+	found := false
+	err := b.walkFiles(b.prefixPath(pathutil.EndsInSlash(path)), func(file localFile) error {
+		found = true
+		return fs.SkipAll
+	})
+
+	if err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
+
+func (b *LocalBucket) DeletePath(ctx context.Context, path string) error {
+	isFile, err := b.IsFile(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	if isFile {
+		return b.DeleteFile(ctx, path)
+	}
+
+	return b.DeleteDir(ctx, path)
+}
+
+func (b *LocalBucket) DeleteDir(ctx context.Context, path string) error {
+	if err := b.checkBucket(); err != nil {
+		return err
+	}
+
+	dir, err := b.filePath(b.prefixPath(pathutil.EndsInSlash(path)))
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Lstat(dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+
+		return err
+	}
+
+	if !info.IsDir() {
+		return nil
+	}
+
+	// The bucket directory itself is only emptied.
+	if dir == b.Root {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			if err := os.RemoveAll(filepath.Join(dir, entry.Name())); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+
+	b.removeEmptyParents(filepath.Dir(dir))
+	return nil
+}
+
+func (b *LocalBucket) DeleteFile(ctx context.Context, path string) error {
+	if err := b.checkBucket(); err != nil {
+		return err
+	}
+
+	p, err := b.filePath(b.prefixPath(path))
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Lstat(p)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			ctxutil.Logger(ctx).Debug("file already deleted in local storage", zap.String("filename", p))
+			return nil
+		}
+
+		return err
+	}
+
+	// Directories are not files, and are not deleted here.
+	if info.IsDir() {
+		return nil
+	}
+
+	if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	b.removeEmptyParents(filepath.Dir(p))
+	return nil
+}
+
+// removeEmptyParents cleans up the directories left empty after a delete.
+// The bucket directory is kept.
+func (b *LocalBucket) removeEmptyParents(dir string) {
+	for dir != b.Root && strings.HasPrefix(dir, b.Root+string(filepath.Separator)) {
+		if err := os.Remove(dir); err != nil {
+			return
+		}
+
+		dir = filepath.Dir(dir)
+	}
+}
+
+// CORS headers are set by the local storage file handler.
+func (b *LocalBucket) SetCORS(ctx context.Context) error {
+	return nil
+}
+
+func (b *LocalBucket) DeleteObjects(paths []string) error {
+	for i := 0; i < len(paths); i++ {
+		err := b.DeletePath(context.Background(), paths[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// When sub-directories are wrapped, files directly in the path are listed first,
+// followed by the sub-directories, like S3 does for common prefixes.
+func (b *LocalBucket) ListPath(options ListOptions) (PathIterator, error) {
+	prefix := b.prefixPath(pathutil.EndsInSlash(options.Path))
+	files, err := b.listFiles(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	items := []PathItem{}
+	directories := []string{}
+	for _, file := range files {
+		if options.UseDelimiter() {
+			rest := strings.TrimPrefix(file.Key, prefix)
+			if i := strings.Index(rest, "/"); i >= 0 {
+				directory := prefix + rest[:i+1]
+				if len(directories) == 0 || directories[len(directories)-1] != directory {
+					directories = append(directories, directory)
+				}
+
+				continue
+			}
+		}
+
+		age := time.Since(file.ModTime)
+		items = append(items, PathItem{
+			Path:        b.removePathPrefix(file.Key),
+			IsDirectory: false,
+			Age:         &age,
+			Size:        file.Size,
+		})
+	}
+
+	for _, directory := range directories {
+		items = append(items, PathItem{
+			Path:        b.removePathPrefix(directory),
+			IsDirectory: true,
+		})
+	}
+
+	return &LocalPathIterator{Items: items}, nil
+}
+
+func (b *LocalBucket) ListObjectsWithPagination(options ListOptions) (ObjectPager, error) {
+	maxKeys := options.MaxKeys
+	if maxKeys == 0 {
+		maxKeys = 1000
+	}
+
+	return &LocalObjectPager{
+		Bucket:     b,
+		Prefix:     b.prefixPath(pathutil.EndsInSlash(options.Path)),
+		NextMarker: options.PaginationToken,
+		MaxKeys:    maxKeys,
+	}, nil
+}
+
+// Only used in tests
+func (b *LocalBucket) CreateObject(ctx context.Context, objectName string, content []byte) error {
+	return b.writeFile(b.prefixPath(objectName), bytes.NewReader(content))
+}
+
+// writeFile writes the content into a temporary file first,
+// and moves it to its place once everything is written.
+func (b *LocalBucket) writeFile(key string, content io.Reader) error {
+	if err := b.checkBucket(); err != nil {
+		return err
+	}
+
+	p, err := b.filePath(key)
+	if err != nil {
+		return err
+	}
+
+	if p == b.Root {
+		return fmt.Errorf("%w '%s'", errInvalidLocalPath, key)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(p), 0750); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(b.TmpDir, "upload-")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, content); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), p)
+}
@@ -0,0 +1,179 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/util/log"
+	"go.uber.org/zap"
+)
+
+var _ http.Handler = &LocalStorage{}
+
+// ServeHTTP serves the URLs signed by the local storage client.
+// Every request needs a valid signature for its method, bucket and path,
+// the same way object stores handle their signed URLs.
+func (c *LocalStorage) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bucketName, key, ok := c.parseRequestPath(r.URL.Path)
+	if !ok {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+
+	if err := c.verifySignature(r, bucketName, key); err != nil {
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	setLocalCORSHeaders(w, r)
+
+	bucket := c.getBucket(BucketOptions{Name: bucketName})
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		c.serveFile(w, r, bucket, key)
+	case http.MethodPut:
+		if err := bucket.writeFile(key, r.Body); err != nil {
+			c.handleError(w, "Failed to write file", bucketName, key, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	case http.MethodDelete:
+		if err := bucket.DeleteFile(r.Context(), key); err != nil {
+			c.handleError(w, "Failed to delete file", bucketName, key, err)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// The request path is <URL path>/<bucket name>/<key>.
+func (c *LocalStorage) parseRequestPath(requestPath string) (string, string, bool) {
+	base := strings.TrimSuffix(c.URL.Path, "/") + "/"
+	if !strings.HasPrefix(requestPath, base) {
+		return "", "", false
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(requestPath, base), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+
+	return parts[0], parts[1], true
+}
+
+func (c *LocalStorage) verifySignature(r *http.Request, bucketName, key string) error {
+	query := r.URL.Query()
+	if query.Get("Method") != r.Method {
+		return fmt.Errorf("URL is not signed for %s", r.Method)
+	}
+
+	expires, err := strconv.ParseInt(query.Get("Expires"), 10, 64)
+	if err != nil {
+		return fmt.Errorf("URL expiration is invalid")
+	}
+
+	if time.Now().Unix() > expires {
+		return fmt.Errorf("URL expired")
+	}
+
+	overrides := responseOverrides{
+		contentType: query.Get("response-content-type"),
+		disposition: query.Get("response-content-disposition"),
+	}
+
+	expected := c.signature(r.Method, bucketName, key, expires, overrides)
+	if !hmac.Equal([]byte(expected), []byte(query.Get("Signature"))) {
+		return fmt.Errorf("signature does not match")
+	}
+
+	return nil
+}
+
+func (c *LocalStorage) serveFile(w http.ResponseWriter, r *http.Request, bucket *LocalBucket, key string) {
+	if err := bucket.checkBucket(); err != nil {
+		c.handleError(w, "Failed to read file", bucket.BucketName, key, err)
+		return
+	}
+
+	p, err := bucket.filePath(key)
+	if err != nil {
+		c.handleError(w, "Failed to read file", bucket.BucketName, key, err)
+		return
+	}
+
+	// #nosec
+	f, err := os.Open(p)
+	if err != nil {
+		c.handleError(w, "Failed to read file", bucket.BucketName, key, err)
+		return
+	}
+
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil || !info.Mode().IsRegular() {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+
+	// The overrides are covered by the signature, so they were chosen by us.
+	// Everything else is downloaded, and never sniffed and rendered by the browser,
+	// since the local storage is usually served from the same origin as Semaphore.
+	query := r.URL.Query()
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	if contentType := query.Get("response-content-type"); contentType != "" {
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", query.Get("response-content-disposition"))
+	} else {
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Header().Set("Content-Disposition", "attachment")
+	}
+
+	http.ServeContent(w, r, info.Name(), info.ModTime(), f)
+}
+
+func (c *LocalStorage) handleError(w http.ResponseWriter, msg, bucketName, key string, err error) {
+	if errors.Is(err, fs.ErrNotExist) || errors.Is(err, ErrMissingBucket) {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+
+	if errors.Is(err, errInvalidLocalPath) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	log.Error(msg, zap.String("bucketName", bucketName), zap.String("path", key), zap.Error(err))
+	http.Error(w, "internal error", http.StatusInternalServerError)
+}
+
+func setLocalCORSHeaders(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		return
+	}
+
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return
+	}
+
+	for _, allowed := range strings.Split(os.Getenv("CORS_ORIGINS"), ",") {
+		if allowed == "*" || allowed == origin {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Set("Access-Control-Expose-Headers", "Access-Control-Request-Header")
+			w.Header().Add("Vary", "Origin")
+			return
+		}
+	}
+}
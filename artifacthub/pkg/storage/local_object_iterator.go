@@ -0,0 +1,44 @@
+package storage
+
+// LocalPathIterator goes through a listing that was already read from disk.
+type LocalPathIterator struct {
+	Items     []PathItem
+	NextIndex int
+	IsDone    bool
+}
+
+var _ PathIterator = &LocalPathIterator{}
+
+func (i *LocalPathIterator) Next() (*PathItem, error) {
+	if i.NextIndex >= len(i.Items) {
+		i.IsDone = true
+		return nil, ErrNoMoreObjects
+	}
+
+	item := i.Items[i.NextIndex]
+	i.NextIndex++
+	return &item, nil
+}
+
+func (i *LocalPathIterator) Count() (int, error) {
+	count := 0
+
+	for !i.Done() {
+		_, err := i.Next()
+		if err == ErrNoMoreObjects {
+			break
+		}
+
+		if err != nil {
+			return count, err
+		}
+
+		count++
+	}
+
+	return count, nil
+}
+
+func (i *LocalPathIterator) Done() bool {
+	return i.IsDone
+}
@@ -0,0 +1,51 @@
+package storage
+
+import (
+	"sort"
+	"time"
+)
+
+// LocalObjectPager uses the key of the last object in a page as the pagination token,
+// so objects deleted between pages do not make the next page skip anything.
+type LocalObjectPager struct {
+	Bucket     *LocalBucket
+	NextMarker string
+	MaxKeys    int64
+	Prefix     string
+}
+
+var _ ObjectPager = &LocalObjectPager{}
+
+func (p *LocalObjectPager) NextPage() ([]*Object, string, error) {
+	files, err := p.Bucket.listFiles(p.Prefix)
+	if err != nil {
+		return nil, "", err
+	}
+
+	start := sort.Search(len(files), func(i int) bool {
+		return files[i].Key > p.NextMarker
+	})
+
+	end := start + int(p.MaxKeys)
+	if end > len(files) {
+		end = len(files)
+	}
+
+	objects := []*Object{}
+	for _, file := range files[start:end] {
+		age := time.Since(file.ModTime)
+		objects = append(objects, &Object{
+			Path: p.Bucket.removePathPrefix(file.Key),
+			Age:  &age,
+			Size: file.Size,
+		})
+	}
+
+	if end < len(files) {
+		p.NextMarker = files[end-1].Key
+	} else {
+		p.NextMarker = ""
+	}
+
+	return objects, p.NextMarker, nil
+}
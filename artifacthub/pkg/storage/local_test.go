@@ -0,0 +1,233 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLocalTestClient(t *testing.T) (*LocalStorage, *httptest.Server) {
+	client := &LocalStorage{}
+	server := httptest.NewServer(client)
+	t.Cleanup(server.Close)
+
+	c, err := NewLocalClient(LocalOptions{
+		Path:       t.TempDir(),
+		URL:        server.URL + "/storage",
+		SigningKey: "test-key",
+	})
+
+	require.NoError(t, err)
+	*client = *c
+	return client, server
+}
+
+func doSignedRequest(t *testing.T, client *LocalStorage, bucketName, path, method, body string) (int, string) {
+	signedURL, err := client.SignURL(context.Background(), SignURLOptions{
+		BucketName: bucketName,
+		Path:       path,
+		Method:     method,
+		PathPrefix: TestBucketPathPrefix,
+	})
+
+	require.NoError(t, err)
+	return doRequest(t, signedURL, method, body)
+}
+
+func doRequest(t *testing.T, u, method, body string) (int, string) {
+	request, err := http.NewRequest(method, u, strings.NewReader(body))
+	require.NoError(t, err)
+
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	defer response.Body.Close()
+
+	content, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	return response.StatusCode, string(content)
+}
+
+func Test__LocalStorageSignedURLs(t *testing.T) {
+	client, _ := newLocalTestClient(t)
+	bucketName, err := client.CreateBucket(context.Background())
+	require.NoError(t, err)
+
+	bucket := client.GetBucket(BucketOptions{Name: bucketName, PathPrefix: TestBucketPathPrefix})
+	path := "artifacts/jobs/file with spaces+plus.txt"
+
+	t.Run("push, pull and yank a file", func(t *testing.T) {
+		code, _ := doSignedRequest(t, client, bucketName, path, http.MethodHead, "")
+		assert.Equal(t, http.StatusNotFound, code)
+
+		code, _ = doSignedRequest(t, client, bucketName, path, http.MethodPut, "hello")
+		assert.Equal(t, http.StatusOK, code)
+
+		isFile, err := bucket.IsFile(context.Background(), path)
+		require.NoError(t, err)
+		assert.True(t, isFile)
+
+		code, _ = doSignedRequest(t, client, bucketName, path, http.MethodHead, "")
+		assert.Equal(t, http.StatusOK, code)
+
+		code, content := doSignedRequest(t, client, bucketName, path, http.MethodGet, "")
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "hello", content)
+
+		code, _ = doSignedRequest(t, client, bucketName, path, http.MethodDelete, "")
+		assert.Equal(t, http.StatusNoContent, code)
+
+		isFile, err = bucket.IsFile(context.Background(), path)
+		require.NoError(t, err)
+		assert.False(t, isFile)
+
+		isDir, err := bucket.IsDir(context.Background(), "artifacts/")
+		require.NoError(t, err)
+		assert.False(t, isDir)
+	})
+
+	t.Run("URL signed for one method cannot be used for another", func(t *testing.T) {
+		signedURL, err := client.SignURL(context.Background(), SignURLOptions{
+			BucketName: bucketName,
+			Path:       path,
+			Method:     http.MethodGet,
+		})
+
+		require.NoError(t, err)
+		code, _ := doRequest(t, signedURL, http.MethodPut, "overwritten")
+		assert.Equal(t, http.StatusForbidden, code)
+	})
+
+	t.Run("tampered URLs are rejected", func(t *testing.T) {
+		signedURL, err := client.SignURL(context.Background(), SignURLOptions{
+			BucketName: bucketName,
+			Path:       "artifacts/a.txt",
+			Method:     http.MethodPut,
+		})
+
+		require.NoError(t, err)
+
+		u, _ := url.Parse(signedURL)
+		u.Path = strings.Replace(u.Path, "a.txt", "b.txt", 1)
+		code, _ := doRequest(t, u.String(), http.MethodPut, "content")
+		assert.Equal(t, http.StatusForbidden, code)
+
+		u, _ = url.Parse(signedURL)
+		query := u.Query()
+		query.Set("Expires", "4102444800")
+		u.RawQuery = query.Encode()
+		code, _ = doRequest(t, u.String(), http.MethodPut, "content")
+		assert.Equal(t, http.StatusForbidden, code)
+	})
+
+	t.Run("expired URLs are rejected", func(t *testing.T) {
+		u := client.URL.JoinPath(bucketName, "artifacts/a.txt")
+		u.RawQuery = url.Values{
+			"Method":    []string{http.MethodGet},
+			"Expires":   []string{"1000"},
+			"Signature": []string{client.signature(http.MethodGet, bucketName, "artifacts/a.txt", 1000, responseOverrides{})},
+		}.Encode()
+
+		code, content := doRequest(t, u.String(), http.MethodGet, "")
+		assert.Equal(t, http.StatusForbidden, code)
+		assert.Contains(t, content, "URL expired")
+	})
+
+	t.Run("paths outside of the bucket are rejected", func(t *testing.T) {
+		code, _ := doSignedRequest(t, client, bucketName, "../../secret.txt", http.MethodPut, "content")
+		assert.Equal(t, http.StatusBadRequest, code)
+
+		isFile, err := client.GetBucket(BucketOptions{Name: bucketName}).IsFile(context.Background(), "secret.txt")
+		assert.NoError(t, err)
+		assert.False(t, isFile)
+	})
+
+	t.Run("content type overrides are applied", func(t *testing.T) {
+		code, _ := doSignedRequest(t, client, bucketName, "artifacts/page.html", http.MethodPut, "<p>hi</p>")
+		require.Equal(t, http.StatusOK, code)
+
+		client.Mimes = map[string]bool{"text/html; charset=utf-8": true}
+		signedURL, err := client.SignURL(context.Background(), SignURLOptions{
+			BucketName:         bucketName,
+			Path:               "artifacts/page.html",
+			Method:             http.MethodGet,
+			PathPrefix:         TestBucketPathPrefix,
+			IncludeContentType: true,
+		})
+
+		require.NoError(t, err)
+		response, err := http.Get(signedURL)
+		require.NoError(t, err)
+		defer response.Body.Close()
+
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		assert.Equal(t, "text/html; charset=utf-8", response.Header.Get("Content-Type"))
+		assert.Equal(t, "inline", response.Header.Get("Content-Disposition"))
+		assert.Equal(t, "nosniff", response.Header.Get("X-Content-Type-Options"))
+	})
+
+	t.Run("content type overrides can't be added or changed", func(t *testing.T) {
+		code, _ := doSignedRequest(t, client, bucketName, "artifacts/page.txt", http.MethodPut, "<script>alert(1)</script>")
+		require.Equal(t, http.StatusOK, code)
+
+		signedURL, err := client.SignURL(context.Background(), SignURLOptions{
+			BucketName: bucketName,
+			Path:       "artifacts/page.txt",
+			Method:     http.MethodGet,
+			PathPrefix: TestBucketPathPrefix,
+		})
+
+		require.NoError(t, err)
+
+		response, err := http.Get(signedURL)
+		require.NoError(t, err)
+		response.Body.Close()
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		assert.Equal(t, "application/octet-stream", response.Header.Get("Content-Type"))
+		assert.Equal(t, "attachment", response.Header.Get("Content-Disposition"))
+		assert.Equal(t, "nosniff", response.Header.Get("X-Content-Type-Options"))
+
+		code, _ = doRequest(t, AppendContentType(map[string]bool{}, map[string]string{".txt": "text/html"}, signedURL, "page.txt"), http.MethodGet, "")
+		assert.Equal(t, http.StatusForbidden, code)
+
+		signedURL, err = client.SignURL(context.Background(), SignURLOptions{
+			BucketName:         bucketName,
+			Path:               "artifacts/page.html",
+			Method:             http.MethodGet,
+			PathPrefix:         TestBucketPathPrefix,
+			IncludeContentType: true,
+		})
+
+		require.NoError(t, err)
+
+		u, _ := url.Parse(signedURL)
+		query := u.Query()
+		query.Set("response-content-type", "image/svg+xml")
+		u.RawQuery = query.Encode()
+		code, _ = doRequest(t, u.String(), http.MethodGet, "")
+		assert.Equal(t, http.StatusForbidden, code)
+	})
+
+	assert.NoError(t, client.DestroyBucket(context.Background(), BucketOptions{Name: bucketName}))
+}
+
+func Test__LocalStorageOptions(t *testing.T) {
+	_, err := NewLocalClient(LocalOptions{URL: "http://localhost:9001", SigningKey: "key"})
+	assert.Error(t, err)
+
+	_, err = NewLocalClient(LocalOptions{Path: t.TempDir(), URL: "localhost", SigningKey: "key"})
+	assert.Error(t, err)
+
+	_, err = NewLocalClient(LocalOptions{Path: t.TempDir(), URL: "http://localhost:9001"})
+	assert.Error(t, err)
+
+	bucket := (&LocalStorage{Path: t.TempDir()}).GetBucket(BucketOptions{Name: "../.."})
+	_, err = bucket.IsDir(context.Background(), "artifacts")
+	assert.ErrorIs(t, err, ErrMissingBucket)
+}
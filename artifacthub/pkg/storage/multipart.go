@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+)
+
+// S3 refuses parts smaller than 5MiB, except the last one, and uploads of more than 10000 parts.
+// Parts grow from MinUploadPartSize until a file fits into MaxUploadPartCount parts.
+const (
+	MinUploadPartSize  = 16 * 1024 * 1024
+	MaxUploadPartCount = 10000
+)
+
+var ErrMultipartUploadNotFound = fmt.Errorf("storage: multipart upload doesn't exist")
+var ErrMultipartUploadIncomplete = fmt.Errorf("storage: multipart upload is not finished")
+
+// MultipartClient is implemented by the backends that can upload large files
+// in parts, or through resumable sessions.
+type MultipartClient interface {
+	StartMultipartUpload(ctx context.Context, options MultipartUploadOptions) (*MultipartUpload, error)
+	ResumeMultipartUpload(ctx context.Context, options MultipartUploadOptions, uploadID string) (*MultipartUpload, error)
+	CompleteMultipartUpload(ctx context.Context, options MultipartUploadOptions, uploadID string, parts []UploadPart) error
+	AbortMultipartUpload(ctx context.Context, options MultipartUploadOptions, uploadID string) error
+}
+
+type MultipartUploadOptions struct {
+	BucketName string
+	Path       string
+	PathPrefix string
+	Size       int64
+}
+
+func (o *MultipartUploadOptions) prefixedPath() string {
+	if o.PathPrefix != "" {
+		return fmt.Sprintf("%s/%s", o.PathPrefix, o.Path)
+	}
+
+	return o.Path
+}
+
+// PartSize returns the size of the parts a file is split into,
+// so it fits into MaxUploadPartCount parts.
+func (o *MultipartUploadOptions) PartSize() int64 {
+	partSize := int64(MinUploadPartSize)
+	for o.Size > partSize*MaxUploadPartCount {
+		partSize *= 2
+	}
+
+	return partSize
+}
+
+func (o *MultipartUploadOptions) PartCount() int {
+	if o.Size <= 0 {
+		return 1
+	}
+
+	partSize := o.PartSize()
+	return int((o.Size + partSize - 1) / partSize)
+}
+
+// MultipartUpload is an upload in progress.
+// Backends uploading in parts return signed URLs for the parts,
+// and backends uploading through resumable sessions return the session URI.
+type MultipartUpload struct {
+	ID         string
+	PartSize   int64
+	Parts      []UploadPart
+	SessionURI string
+}
+
+type UploadPart struct {
+	Number int
+	URL    string
+	ETag   string
+}
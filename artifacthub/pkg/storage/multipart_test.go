@@ -0,0 +1,118 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__MultipartPartSize(t *testing.T) {
+	testCases := []struct {
+		size      int64
+		partSize  int64
+		partCount int
+	}{
+		{size: 0, partSize: MinUploadPartSize, partCount: 1},
+		{size: 1, partSize: MinUploadPartSize, partCount: 1},
+		{size: MinUploadPartSize, partSize: MinUploadPartSize, partCount: 1},
+		{size: MinUploadPartSize + 1, partSize: MinUploadPartSize, partCount: 2},
+		{size: MinUploadPartSize * MaxUploadPartCount, partSize: MinUploadPartSize, partCount: MaxUploadPartCount},
+		{size: MinUploadPartSize*MaxUploadPartCount + 1, partSize: 2 * MinUploadPartSize, partCount: MaxUploadPartCount/2 + 1},
+		{size: 5 * 1024 * 1024 * 1024 * 1024, partSize: 1024 * 1024 * 1024, partCount: 5 * 1024},
+	}
+
+	for _, tc := range testCases {
+		options := MultipartUploadOptions{Size: tc.size}
+		assert.Equal(t, tc.partSize, options.PartSize(), "size %d", tc.size)
+		assert.Equal(t, tc.partCount, options.PartCount(), "size %d", tc.size)
+	}
+}
+
+func Test__MultipartUpload(t *testing.T) {
+	RunTestForAllBackends(t, func(backend string, client Client) {
+		multipartClient, ok := client.(MultipartClient)
+		if !ok {
+			return
+		}
+
+		bucketName, err := client.CreateBucket(context.TODO())
+		require.NoError(t, err)
+
+		bucket := client.GetBucket(BucketOptions{Name: bucketName, PathPrefix: TestBucketPathPrefix})
+		require.NoError(t, SeedBucket(bucket, []SeedObject{}))
+
+		content := bytes.Repeat([]byte("a"), MinUploadPartSize+1024)
+		options := func(path string) MultipartUploadOptions {
+			return MultipartUploadOptions{
+				BucketName: bucketName,
+				Path:       path,
+				PathPrefix: TestBucketPathPrefix,
+				Size:       int64(len(content)),
+			}
+		}
+
+		t.Run(backend+" upload is completed", func(t *testing.T) {
+			upload, err := multipartClient.StartMultipartUpload(context.TODO(), options("artifacts/large.bin"))
+			require.NoError(t, err)
+			assert.NotEmpty(t, upload.ID)
+
+			parts, err := UploadMultipart(upload, content)
+			require.NoError(t, err)
+
+			require.NoError(t, multipartClient.CompleteMultipartUpload(context.TODO(), options("artifacts/large.bin"), upload.ID, parts))
+
+			exists, err := bucket.IsFile(context.TODO(), "artifacts/large.bin")
+			require.NoError(t, err)
+			assert.True(t, exists)
+		})
+
+		t.Run(backend+" resumed upload signs the parts again", func(t *testing.T) {
+			upload, err := multipartClient.StartMultipartUpload(context.TODO(), options("artifacts/resumed.bin"))
+			require.NoError(t, err)
+
+			resumed, err := multipartClient.ResumeMultipartUpload(context.TODO(), options("artifacts/resumed.bin"), upload.ID)
+			require.NoError(t, err)
+			assert.Equal(t, upload.ID, resumed.ID)
+			assert.Equal(t, upload.SessionURI, resumed.SessionURI)
+			assert.Len(t, resumed.Parts, len(upload.Parts))
+
+			parts, err := UploadMultipart(resumed, content)
+			require.NoError(t, err)
+			require.NoError(t, multipartClient.CompleteMultipartUpload(context.TODO(), options("artifacts/resumed.bin"), upload.ID, parts))
+		})
+
+		t.Run(backend+" unfinished upload can't be completed", func(t *testing.T) {
+			upload, err := multipartClient.StartMultipartUpload(context.TODO(), options("artifacts/unfinished.bin"))
+			require.NoError(t, err)
+
+			err = multipartClient.CompleteMultipartUpload(context.TODO(), options("artifacts/unfinished.bin"), upload.ID, []UploadPart{})
+			require.Error(t, err)
+
+			exists, err := bucket.IsFile(context.TODO(), "artifacts/unfinished.bin")
+			require.NoError(t, err)
+			assert.False(t, exists)
+		})
+
+		t.Run(backend+" upload is aborted", func(t *testing.T) {
+			upload, err := multipartClient.StartMultipartUpload(context.TODO(), options("artifacts/aborted.bin"))
+			require.NoError(t, err)
+
+			require.NoError(t, multipartClient.AbortMultipartUpload(context.TODO(), options("artifacts/aborted.bin"), upload.ID))
+
+			err = multipartClient.CompleteMultipartUpload(context.TODO(), options("artifacts/aborted.bin"), upload.ID, []UploadPart{})
+			require.Error(t, err)
+
+			exists, err := bucket.IsFile(context.TODO(), "artifacts/aborted.bin")
+			require.NoError(t, err)
+			assert.False(t, exists)
+		})
+
+		assert.Nil(t, client.DestroyBucket(context.TODO(), BucketOptions{
+			Name:       bucketName,
+			PathPrefix: TestBucketPathPrefix,
+		}))
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"net/url"
 	"os"
 	"strings"
 	"time"
@@ -67,6 +69,120 @@ func (b *S3Bucket) IsFile(ctx context.Context, path string) (bool, error) {
 	return true, nil
 }
 
+func (b *S3Bucket) ReadObject(ctx context.Context, path string) (io.ReadCloser, error) {
+	obj, err := b.Client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(b.BucketName),
+		Key:    aws.String(b.prefixPath(path)),
+	})
+
+	if err != nil {
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, ErrMissingObject
+		}
+
+		return nil, err
+	}
+
+	return obj.Body, nil
+}
+
+func (b *S3Bucket) ObjectSize(ctx context.Context, path string) (int64, error) {
+	head, err := b.Client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(b.BucketName),
+		Key:    aws.String(b.prefixPath(path)),
+	})
+
+	if err != nil {
+		// HEAD responses have no body, so the error code is only the status.
+		var aerr awserr.Error
+		if errors.As(err, &aerr) && aerr.Code() == "NotFound" {
+			return 0, ErrMissingObject
+		}
+
+		return 0, err
+	}
+
+	return aws.Int64Value(head.ContentLength), nil
+}
+
+// S3 copies objects larger than this only part by part.
+const s3MaxCopyObjectSize = 5 * 1024 * 1024 * 1024
+
+func (b *S3Bucket) CopyObject(ctx context.Context, path string, dst Bucket, dstPath string) error {
+	dstBucket, ok := dst.(*S3Bucket)
+	if !ok {
+		return ErrIncompatibleBucket
+	}
+
+	size, err := b.ObjectSize(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	source := url.PathEscape(b.BucketName + "/" + b.prefixPath(path))
+	if size > s3MaxCopyObjectSize {
+		return dstBucket.copyObjectInParts(ctx, source, size, dstPath)
+	}
+
+	_, err = b.Client.CopyObjectWithContext(ctx, &s3.CopyObjectInput{
+		Bucket:     aws.String(dstBucket.BucketName),
+		Key:        aws.String(dstBucket.prefixPath(dstPath)),
+		CopySource: aws.String(source),
+	})
+
+	return err
+}
+
+func (b *S3Bucket) copyObjectInParts(ctx context.Context, source string, size int64, path string) error {
+	options := MultipartUploadOptions{BucketName: b.BucketName, Path: path, PathPrefix: b.PathPrefix, Size: size}
+	upload, err := b.Client.CreateMultipartUploadWithContext(ctx, &s3.CreateMultipartUploadInput{
+		Bucket: aws.String(b.BucketName),
+		Key:    aws.String(options.prefixedPath()),
+	})
+
+	if err != nil {
+		return err
+	}
+
+	parts := []*s3.CompletedPart{}
+	partSize := options.PartSize()
+	for number := 1; number <= options.PartCount(); number++ {
+		first := int64(number-1) * partSize
+		last := min(first+partSize, size) - 1
+
+		output, err := b.Client.UploadPartCopyWithContext(ctx, &s3.UploadPartCopyInput{
+			Bucket:          aws.String(b.BucketName),
+			Key:             aws.String(options.prefixedPath()),
+			UploadId:        upload.UploadId,
+			PartNumber:      aws.Int64(int64(number)),
+			CopySource:      aws.String(source),
+			CopySourceRange: aws.String(fmt.Sprintf("bytes=%d-%d", first, last)),
+		})
+
+		if err != nil {
+			_, _ = b.Client.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+				Bucket:   aws.String(b.BucketName),
+				Key:      aws.String(options.prefixedPath()),
+				UploadId: upload.UploadId,
+			})
+
+			return err
+		}
+
+		parts = append(parts, &s3.CompletedPart{ETag: output.CopyPartResult.ETag, PartNumber: aws.Int64(int64(number))})
+	}
+
+	_, err = b.Client.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(b.BucketName),
+		Key:             aws.String(options.prefixedPath()),
+		UploadId:        upload.UploadId,
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: parts},
+	})
+
+	return err
+}
+
 func (b *S3Bucket) IsDir(ctx context.Context, path string) (bool, error) {
 	if len(path) == 0 {
 		return true, nil
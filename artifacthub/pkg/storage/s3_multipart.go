@@ -0,0 +1,115 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+)
+
+var _ MultipartClient = &S3{}
+
+func (c *S3) StartMultipartUpload(ctx context.Context, options MultipartUploadOptions) (*MultipartUpload, error) {
+	output, err := c.Client.CreateMultipartUploadWithContext(ctx, &s3.CreateMultipartUploadInput{
+		Bucket: aws.String(options.BucketName),
+		Key:    aws.String(options.prefixedPath()),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.signUploadParts(options, aws.StringValue(output.UploadId), map[int]string{})
+}
+
+// ResumeMultipartUpload signs the parts that are not uploaded yet again,
+// since the URLs signed when the upload started might have expired.
+func (c *S3) ResumeMultipartUpload(ctx context.Context, options MultipartUploadOptions, uploadID string) (*MultipartUpload, error) {
+	uploaded := map[int]string{}
+
+	err := c.Client.ListPartsPagesWithContext(ctx, &s3.ListPartsInput{
+		Bucket:   aws.String(options.BucketName),
+		Key:      aws.String(options.prefixedPath()),
+		UploadId: aws.String(uploadID),
+	}, func(page *s3.ListPartsOutput, lastPage bool) bool {
+		for _, part := range page.Parts {
+			uploaded[int(aws.Int64Value(part.PartNumber))] = aws.StringValue(part.ETag)
+		}
+
+		return true
+	})
+
+	if err != nil {
+		return nil, s3MultipartError(err)
+	}
+
+	return c.signUploadParts(options, uploadID, uploaded)
+}
+
+func (c *S3) signUploadParts(options MultipartUploadOptions, uploadID string, uploaded map[int]string) (*MultipartUpload, error) {
+	upload := &MultipartUpload{ID: uploadID, PartSize: options.PartSize()}
+
+	for number := 1; number <= options.PartCount(); number++ {
+		if etag, ok := uploaded[number]; ok {
+			upload.Parts = append(upload.Parts, UploadPart{Number: number, ETag: etag})
+			continue
+		}
+
+		request, _ := c.Client.UploadPartRequest(&s3.UploadPartInput{
+			Bucket:     aws.String(options.BucketName),
+			Key:        aws.String(options.prefixedPath()),
+			UploadId:   aws.String(uploadID),
+			PartNumber: aws.Int64(int64(number)),
+		})
+
+		url, err := request.Presign(SignedURLExpireInMinutes * time.Minute)
+		if err != nil {
+			return nil, err
+		}
+
+		upload.Parts = append(upload.Parts, UploadPart{Number: number, URL: url})
+	}
+
+	return upload, nil
+}
+
+func (c *S3) CompleteMultipartUpload(ctx context.Context, options MultipartUploadOptions, uploadID string, parts []UploadPart) error {
+	completed := make([]*s3.CompletedPart, 0, len(parts))
+	for _, part := range parts {
+		completed = append(completed, &s3.CompletedPart{
+			PartNumber: aws.Int64(int64(part.Number)),
+			ETag:       aws.String(part.ETag),
+		})
+	}
+
+	_, err := c.Client.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(options.BucketName),
+		Key:             aws.String(options.prefixedPath()),
+		UploadId:        aws.String(uploadID),
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: completed},
+	})
+
+	return s3MultipartError(err)
+}
+
+func (c *S3) AbortMultipartUpload(ctx context.Context, options MultipartUploadOptions, uploadID string) error {
+	_, err := c.Client.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(options.BucketName),
+		Key:      aws.String(options.prefixedPath()),
+		UploadId: aws.String(uploadID),
+	})
+
+	return s3MultipartError(err)
+}
+
+func s3MultipartError(err error) error {
+	var aerr awserr.Error
+	if errors.As(err, &aerr) && aerr.Code() == s3.ErrCodeNoSuchUpload {
+		return ErrMultipartUploadNotFound
+	}
+
+	return err
+}
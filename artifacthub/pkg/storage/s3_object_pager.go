@@ -32,6 +32,7 @@ func (p *S3ObjectPager) NextPage() ([]*Object, string, error) {
 		objects = append(objects, &Object{
 			Path: p.removePathPrefix(*object.Key),
 			Age:  &age,
+			Size: aws.Int64Value(object.Size),
 		})
 	}
 
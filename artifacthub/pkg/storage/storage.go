@@ -13,6 +13,12 @@ func New() (Client, error) {
 			Region:          os.Getenv("AWS_REGION"),
 		}
 		return NewS3Client(s3Options)
+	case "local":
+		return NewLocalClient(LocalOptions{
+			Path:       os.Getenv("ARTIFACT_STORAGE_LOCAL_PATH"),
+			URL:        os.Getenv("ARTIFACT_STORAGE_LOCAL_URL"),
+			SigningKey: os.Getenv("ARTIFACT_STORAGE_LOCAL_SIGNING_KEY"),
+		})
 	default:
 		return NewGcsClient(os.Getenv("GOOGLE_APPLICATION_CREDENTIALS"))
 	}
@@ -2,9 +2,11 @@ package storage
 
 import (
 	"context"
+	"io"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const TestBucketPathPrefix = "test-prefix"
@@ -99,6 +101,7 @@ func Test__ListObjectsWithPagination(t *testing.T) {
 			assert.Empty(t, nextToken)
 			if assert.Len(t, objects, 7) {
 				assert.Equal(t, objects[0].Path, "artifacts/first/file1.txt")
+				assert.Equal(t, objects[0].Size, int64(len("hello")))
 				assert.Equal(t, objects[1].Path, "artifacts/first/file2.txt")
 				assert.Equal(t, objects[2].Path, "artifacts/first/somedir/file3.txt")
 				assert.Equal(t, objects[3].Path, "artifacts/second/file1.txt")
@@ -197,6 +200,90 @@ func Test__IsFile(t *testing.T) {
 	})
 }
 
+func Test__ReadObject(t *testing.T) {
+	RunTestForAllBackends(t, func(backend string, client Client) {
+		bucketName, err := client.CreateBucket(context.TODO())
+		assert.Nil(t, err)
+
+		bucket := client.GetBucket(BucketOptions{
+			Name:       bucketName,
+			PathPrefix: TestBucketPathPrefix,
+		})
+
+		assert.Nil(t, SeedBucket(bucket, seedObjects()))
+
+		t.Run(backend+" file exists => content", func(t *testing.T) {
+			reader, err := bucket.ReadObject(context.Background(), "artifacts/first/file1.txt")
+			require.Nil(t, err)
+			defer reader.Close()
+
+			content, err := io.ReadAll(reader)
+			assert.Nil(t, err)
+			assert.Equal(t, "hello", string(content))
+		})
+
+		t.Run(backend+" file does not exist => error", func(t *testing.T) {
+			_, err := bucket.ReadObject(context.Background(), "artifacts/no/such/path/file.txt")
+			assert.ErrorIs(t, err, ErrMissingObject)
+		})
+
+		assert.Nil(t, client.DestroyBucket(context.TODO(), BucketOptions{
+			Name:       bucketName,
+			PathPrefix: TestBucketPathPrefix,
+		}))
+	})
+}
+
+func Test__CopyObject(t *testing.T) {
+	RunTestForAllBackends(t, func(backend string, client Client) {
+		bucketName, err := client.CreateBucket(context.TODO())
+		assert.Nil(t, err)
+
+		otherBucketName, err := client.CreateBucket(context.TODO())
+		assert.Nil(t, err)
+
+		bucket := client.GetBucket(BucketOptions{Name: bucketName, PathPrefix: TestBucketPathPrefix})
+		otherBucket := client.GetBucket(BucketOptions{Name: otherBucketName, PathPrefix: TestBucketPathPrefix})
+		assert.Nil(t, SeedBucket(bucket, seedObjects()))
+		assert.Nil(t, SeedBucket(otherBucket, []SeedObject{}))
+
+		t.Run(backend+" copy in the same bucket", func(t *testing.T) {
+			require.Nil(t, bucket.CopyObject(context.Background(), "artifacts/first/file1.txt", bucket, "artifacts/copies/file1.txt"))
+
+			size, err := bucket.ObjectSize(context.Background(), "artifacts/copies/file1.txt")
+			require.Nil(t, err)
+			assert.Equal(t, int64(len("hello")), size)
+		})
+
+		t.Run(backend+" copy into another bucket", func(t *testing.T) {
+			require.Nil(t, bucket.CopyObject(context.Background(), "artifacts/first/file1.txt", otherBucket, "artifacts/file1.txt"))
+
+			reader, err := otherBucket.ReadObject(context.Background(), "artifacts/file1.txt")
+			require.Nil(t, err)
+			defer reader.Close()
+
+			content, err := io.ReadAll(reader)
+			assert.Nil(t, err)
+			assert.Equal(t, "hello", string(content))
+		})
+
+		t.Run(backend+" file does not exist => error", func(t *testing.T) {
+			err := bucket.CopyObject(context.Background(), "artifacts/no/such/file.txt", bucket, "artifacts/copies/file.txt")
+			assert.ErrorIs(t, err, ErrMissingObject)
+
+			_, err = bucket.ObjectSize(context.Background(), "artifacts/no/such/file.txt")
+			assert.ErrorIs(t, err, ErrMissingObject)
+		})
+
+		for _, name := range []string{bucketName, otherBucketName} {
+			assert.Nil(t, client.DestroyBucket(context.TODO(), BucketOptions{
+				Name:       name,
+				PathPrefix: TestBucketPathPrefix,
+			}))
+		}
+	})
+}
+
 func Test__IsDir(t *testing.T) {
 	RunTestForAllBackends(t, func(backend string, client Client) {
 		bucketName, err := client.CreateBucket(context.TODO())
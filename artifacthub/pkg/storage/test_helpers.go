@@ -1,8 +1,12 @@
 package storage
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"net/http"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -26,6 +30,13 @@ var storageBackends = map[string]func() (Client, error){
 			Region:          os.Getenv("AWS_REGION"),
 		})
 	},
+	"local": func() (Client, error) {
+		return NewLocalClient(LocalOptions{
+			Path:       filepath.Join(os.TempDir(), "artifacthub-local-storage"),
+			URL:        "http://localhost:9001",
+			SigningKey: "local-storage-test-key",
+		})
+	},
 }
 
 func RunTestForAllBackends(t *testing.T, test func(string, Client)) {
@@ -51,3 +62,56 @@ func SeedBucket(bucket Bucket, seedObjects []SeedObject) error {
 
 	return nil
 }
+
+// UploadMultipart uploads the content the way clients do, in parts or through the
+// resumable session, and returns the uploaded parts. Parts with no URL are skipped.
+func UploadMultipart(upload *MultipartUpload, content []byte) ([]UploadPart, error) {
+	if upload.SessionURI != "" {
+		headers := http.Header{}
+		headers.Set("Content-Range", fmt.Sprintf("bytes 0-%d/%d", len(content)-1, len(content)))
+
+		_, err := put(upload.SessionURI, content, headers)
+		return nil, err
+	}
+
+	parts := []UploadPart{}
+	for _, part := range upload.Parts {
+		if part.URL == "" {
+			parts = append(parts, part)
+			continue
+		}
+
+		start := int64(part.Number-1) * upload.PartSize
+		end := min(start+upload.PartSize, int64(len(content)))
+
+		response, err := put(part.URL, content[start:end], http.Header{})
+		if err != nil {
+			return nil, err
+		}
+
+		parts = append(parts, UploadPart{Number: part.Number, ETag: response.Header.Get("ETag")})
+	}
+
+	return parts, nil
+}
+
+func put(url string, content []byte, headers http.Header) (*http.Response, error) {
+	request, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header = headers
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("upload to %s failed: %s", url, response.Status)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,59 @@
+package transfer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/storage"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/util/log"
+	pathutil "github.com/semaphoreio/semaphore/artifacthub/pkg/util/path"
+	"go.uber.org/zap"
+)
+
+var ErrBlobNotUploaded = errors.New("blob was not uploaded")
+
+// VerifyBlobContent reads the blob back from the storage, and checks it matches its digest.
+// Content that doesn't match is deleted, so the next push with the digest uploads it again.
+func VerifyBlobContent(ctx context.Context, bucket storage.Bucket, digest string) (bool, error) {
+	blobPath := pathutil.BlobPath(digest)
+	exists, err := bucket.IsFile(ctx, blobPath)
+	if err != nil || !exists {
+		return false, err
+	}
+
+	reader, err := bucket.ReadObject(ctx, blobPath)
+	if err != nil {
+		return false, err
+	}
+
+	defer reader.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, reader); err != nil {
+		return false, fmt.Errorf("error reading blob %s: %v", digest, err)
+	}
+
+	if hex.EncodeToString(hash.Sum(nil)) == digest {
+		return true, nil
+	}
+
+	log.Warn("Blob does not match its digest - deleting it", zap.String("digest", digest))
+	if err := bucket.DeleteFile(ctx, blobPath); err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
+
+// CheckBlob returns whether the blob was uploaded, and its content matches the digest.
+func CheckBlob(ctx context.Context, bucket storage.Bucket, artifactID uuid.UUID, digest string) (bool, error) {
+	return models.VerifyBlob(artifactID, digest, func() (bool, error) {
+		return VerifyBlobContent(ctx, bucket, digest)
+	})
+}
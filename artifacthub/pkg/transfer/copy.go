@@ -0,0 +1,196 @@
+package transfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/renderedtext/go-watchman"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/storage"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/util/log"
+	pathutil "github.com/semaphoreio/semaphore/artifacthub/pkg/util/path"
+	"go.uber.org/zap"
+)
+
+// Progress is reported at most this often while copying.
+const progressInterval = time.Second
+
+var (
+	ErrDestinationExists      = errors.New("destination already exists")
+	ErrInvalidCopyPath        = errors.New("invalid copy path")
+	ErrDifferentOrganizations = errors.New("artifact stores belong to different organizations")
+	ErrNoOrganization         = errors.New("artifact store has no organization set yet")
+)
+
+type CopyOptions struct {
+	Source          *models.Artifact
+	SourcePath      string
+	Destination     *models.Artifact
+	DestinationPath string
+	Force           bool
+}
+
+type Progress struct {
+	CopiedCount int
+	CopiedSize  int64
+	TotalCount  int
+	TotalSize   int64
+}
+
+func (o *CopyOptions) sameArtifact() bool {
+	return o.Source.ID == o.Destination.ID
+}
+
+// Copy copies a file, or all the files in a directory, with server-side copies,
+// so nothing goes through artifacthub itself. A file is copied to the destination path,
+// or into it, if it ends with a slash. Files in a directory keep their paths relative to it.
+//
+// Paths pushed with a digest keep pointing to their blob inside the same artifact store,
+// but the content of the blob is copied into other stores. Without force, nothing is
+// copied if the destination exists. The progress is reported while copying, and once more
+// when everything is copied.
+func Copy(ctx context.Context, client storage.Client, options CopyOptions, report func(Progress) error) error {
+	if err := validateCopy(options); err != nil {
+		return err
+	}
+
+	if err := CheckStorageQuota(options.Destination); err != nil {
+		return err
+	}
+
+	src := client.GetBucket(storage.BucketOptions{Name: options.Source.BucketName, PathPrefix: options.Source.IdempotencyToken})
+	dst := client.GetBucket(storage.BucketOptions{Name: options.Destination.BucketName, PathPrefix: options.Destination.IdempotencyToken})
+
+	files, err := ListFiles(ctx, src, options.Source.ID, options.SourcePath)
+	if err != nil {
+		return err
+	}
+
+	isFile := len(files) == 1 && files[0].Path == options.SourcePath
+	destination := func(f File) string {
+		if isFile && !pathutil.CheckEndsInSlash(options.DestinationPath) {
+			return options.DestinationPath
+		}
+
+		return pathutil.EndsInSlash(options.DestinationPath) + f.Name
+	}
+
+	if !options.Force {
+		exists, err := destinationExists(ctx, dst, isFile, destination(files[0]), options.DestinationPath)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			return ErrDestinationExists
+		}
+	}
+
+	progress := Progress{TotalCount: len(files)}
+	for _, f := range files {
+		progress.TotalSize += f.Size
+	}
+
+	defer watchman.Benchmark(time.Now(), "copies.duration")
+	lastReport := time.Now()
+
+	for _, f := range files {
+		if err := copyFile(ctx, src, dst, options, f, destination(f)); err != nil {
+			return fmt.Errorf("copying '%s': %w", f.Path, err)
+		}
+
+		progress.CopiedCount++
+		progress.CopiedSize += f.Size
+
+		if time.Since(lastReport) >= progressInterval {
+			lastReport = time.Now()
+			if err := report(progress); err != nil {
+				return err
+			}
+		}
+	}
+
+	_ = watchman.Submit("copies.count", progress.CopiedCount)
+	log.Info("Copied path",
+		zap.String("source", options.Source.ID.String()),
+		zap.String("source_path", options.SourcePath),
+		zap.String("destination", options.Destination.ID.String()),
+		zap.String("destination_path", options.DestinationPath),
+		zap.Int("count", progress.CopiedCount),
+		zap.Int64("size", progress.CopiedSize),
+	)
+
+	return report(progress)
+}
+
+func validateCopy(options CopyOptions) error {
+	for _, p := range []string{options.SourcePath, options.DestinationPath} {
+		if p == "" || strings.HasPrefix(p, pathutil.BlobPrefix) {
+			return ErrInvalidCopyPath
+		}
+	}
+
+	if options.sameArtifact() {
+		srcDir := pathutil.EndsInSlash(options.SourcePath)
+		if strings.HasPrefix(pathutil.EndsInSlash(options.DestinationPath), srcDir) {
+			return fmt.Errorf("%w: '%s' is inside of '%s'", ErrInvalidCopyPath, options.DestinationPath, options.SourcePath)
+		}
+
+		return nil
+	}
+
+	// Stores created before organizations were recorded can't be checked
+	// until their organization is set, on Create or with SetOrganization.
+	src, dst := options.Source.OrgID, options.Destination.OrgID
+	if src == nil || dst == nil {
+		return ErrNoOrganization
+	}
+
+	if *src != *dst {
+		return ErrDifferentOrganizations
+	}
+
+	return nil
+}
+
+func destinationExists(ctx context.Context, dst storage.Bucket, isFile bool, filePath, dirPath string) (bool, error) {
+	if isFile {
+		return dst.IsFile(ctx, filePath)
+	}
+
+	return dst.IsDir(ctx, dirPath)
+}
+
+func copyFile(ctx context.Context, src, dst storage.Bucket, options CopyOptions, f File, dstPath string) error {
+	if f.Digest != "" && options.sameArtifact() {
+		// Blobs are verified once, while listing the files,
+		// so their content is not read again for every path pointing to them.
+		verified, err := models.AddObjectRef(options.Destination.ID, dstPath, f.Digest)
+		if err != nil {
+			return err
+		}
+
+		if !verified {
+			return fmt.Errorf("blob %s is missing", f.Digest)
+		}
+
+		if err := src.CopyObject(ctx, f.Path, dst, dstPath); err != nil {
+			return err
+		}
+	} else {
+		// The copied content replaces the blob the destination path pointed to.
+		if err := models.ReleaseObjectRefs(options.Destination.ID, []string{dstPath}); err != nil {
+			return err
+		}
+
+		if err := src.CopyObject(ctx, f.ContentPath, dst, dstPath); err != nil {
+			return err
+		}
+	}
+
+	// Copies keep the labels of the source, so promoted files can be found the same way.
+	return models.CopyObjectMetadata(options.Source.ID, f.Path, options.Destination.ID, dstPath)
+}
@@ -0,0 +1,160 @@
+package transfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/storage"
+	pathutil "github.com/semaphoreio/semaphore/artifacthub/pkg/util/path"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/util/retry"
+)
+
+var ErrPathNotFound = errors.New("path not found")
+
+// File is a file of an artifact store. Paths pushed with a digest are small
+// pointers to their blob, so their content and size are taken from the blob.
+type File struct {
+	Path        string
+	Name        string
+	ContentPath string
+	Digest      string
+	Size        int64
+	Modified    time.Time
+}
+
+// ListFiles lists the files in the directory, named relative to it.
+// A path pointing to a file gives only that file, named by its base name.
+func ListFiles(ctx context.Context, bucket storage.Bucket, artifactID uuid.UUID, p string) ([]File, error) {
+	isFile, err := bucket.IsFile(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+
+	dir := strings.TrimSuffix(p, "/") + "/"
+	if isFile {
+		dir = path.Dir(p) + "/"
+	}
+
+	files, err := listObjects(ctx, bucket, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	if isFile {
+		files = filterFiles(files, func(f File) bool { return f.Path == p })
+	}
+
+	if len(files) == 0 {
+		return nil, ErrPathNotFound
+	}
+
+	return resolveBlobs(ctx, bucket, artifactID, files)
+}
+
+func listObjects(ctx context.Context, bucket storage.Bucket, dir string) ([]File, error) {
+	now := time.Now()
+	files := []File{}
+
+	err := retry.OnFailure(ctx, "Listing Bucket path", func() error {
+		files = []File{} // retry
+
+		iterator, err := bucket.ListPath(storage.ListOptions{Path: dir})
+		if err != nil {
+			return err
+		}
+
+		for !iterator.Done() {
+			o, err := iterator.Next()
+			if err == storage.ErrNoMoreObjects {
+				break
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if o.IsDirectory || !strings.HasPrefix(o.Path, dir) {
+				continue
+			}
+
+			modified := now
+			if o.Age != nil {
+				modified = now.Add(-*o.Age)
+			}
+
+			files = append(files, File{
+				Path:        o.Path,
+				Name:        strings.TrimPrefix(o.Path, dir),
+				ContentPath: o.Path,
+				Size:        o.Size,
+				Modified:    modified,
+			})
+		}
+
+		return nil
+	})
+
+	return files, err
+}
+
+func resolveBlobs(ctx context.Context, bucket storage.Bucket, artifactID uuid.UUID, files []File) ([]File, error) {
+	paths := make([]string, 0, len(files))
+	for _, f := range files {
+		paths = append(paths, f.Path)
+	}
+
+	refs, err := models.FindObjectRefs(artifactID, paths)
+	if err != nil || len(refs) == 0 {
+		return files, err
+	}
+
+	sizes := map[string]int64{}
+	for i, f := range files {
+		digest, ok := refs[f.Path]
+		if !ok {
+			continue
+		}
+
+		blobPath := pathutil.BlobPath(digest)
+		if _, ok := sizes[digest]; !ok {
+			uploaded, err := CheckBlob(ctx, bucket, artifactID, digest)
+			if err != nil {
+				return nil, err
+			}
+
+			if !uploaded {
+				return nil, fmt.Errorf("%w: %s of '%s'", ErrBlobNotUploaded, digest, f.Path)
+			}
+
+			size, err := bucket.ObjectSize(ctx, blobPath)
+			if err != nil {
+				return nil, fmt.Errorf("blob %s of '%s': %w", digest, f.Path, err)
+			}
+
+			sizes[digest] = size
+		}
+
+		files[i].ContentPath = blobPath
+		files[i].Digest = digest
+		files[i].Size = sizes[digest]
+	}
+
+	return files, nil
+}
+
+func filterFiles(files []File, keep func(File) bool) []File {
+	kept := []File{}
+	for _, f := range files {
+		if keep(f) {
+			kept = append(kept, f)
+		}
+	}
+
+	return kept
+}
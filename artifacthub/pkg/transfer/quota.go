@@ -0,0 +1,51 @@
+package transfer
+
+import (
+	"errors"
+
+	"github.com/renderedtext/go-watchman"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/util/log"
+	"go.uber.org/zap"
+)
+
+var ErrStorageQuotaExceeded = errors.New("storage quota exceeded")
+
+// CheckStorageQuota refuses uploads for organizations over their hard limit.
+// The usage comes from the last usage scan, so organizations can go over
+// their limits by what was uploaded since then.
+//
+// Artifacts created before organizations were recorded have no organization until it is set
+// on Create, or with SetOrganization. Their usage can't be counted towards any quota,
+// so uploads to them are allowed, and counted, to follow how far the backfill is.
+func CheckStorageQuota(artifact *models.Artifact) error {
+	if artifact.OrgID == nil {
+		_ = watchman.Increment("quota.no_organization")
+		return nil
+	}
+
+	quota, err := models.FindStorageQuotaOrReturnNil(*artifact.OrgID)
+	if err != nil || quota == nil {
+		return err
+	}
+
+	size, err := models.OrganizationUsageSize(*artifact.OrgID)
+	if err != nil {
+		return err
+	}
+
+	if quota.IsHardLimitExceeded(size) {
+		_ = watchman.Increment("quota.hard_limit.exceeded")
+		log.Warn("Storage quota hard limit exceeded",
+			zap.String("org_id", artifact.OrgID.String()), zap.Int64("size", size), zap.Int64("hard_limit", quota.HardLimit))
+		return ErrStorageQuotaExceeded
+	}
+
+	if quota.IsSoftLimitExceeded(size) {
+		_ = watchman.Increment("quota.soft_limit.exceeded")
+		log.Warn("Storage quota soft limit exceeded",
+			zap.String("org_id", artifact.OrgID.String()), zap.Int64("size", size), zap.Int64("soft_limit", quota.SoftLimit))
+	}
+
+	return nil
+}
@@ -27,8 +27,15 @@ const (
 	ExpirePrefix = "var/expires-in/"
 	// LockPath may exists if another worker is cleaning the bucket right now.
 	LockPath = "var/lock"
+	// BlobPrefix is where content pushed with a digest is stored, once per digest.
+	BlobPrefix = "blobs/sha256/"
 )
 
+// BlobPath returns where the content with the given SHA-256 digest is stored.
+func BlobPath(digest string) string {
+	return BlobPrefix + digest
+}
+
 // CheckEndsInSlash returns if the path looks like a directory.
 func CheckEndsInSlash(in string) bool {
 	return len(in) == 0 || in[len(in)-1] == '/'
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	uuid "github.com/satori/go.uuid"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/retention"
 )
 
 //
@@ -33,10 +34,14 @@ import (
 //
 // N. When the worker reaches the end of the bucket, it will no longer send a message to the amqp queue.
 //
+// Policies with keep last or max size rules are planned by the first worker,
+// and the plan is passed on in the next messages, so all the batches follow the same decisions.
+//
 
 type CleanRequest struct {
-	ArtifactBucketID uuid.UUID `json:"artifact_bucket_id"`
-	PaginationToken  string    `json:"pagination_token"`
+	ArtifactBucketID uuid.UUID       `json:"artifact_bucket_id"`
+	PaginationToken  string          `json:"pagination_token"`
+	Plan             *retention.Plan `json:"plan,omitempty"`
 }
 
 func NewCleanRequest(artifactID string) (*CleanRequest, error) {
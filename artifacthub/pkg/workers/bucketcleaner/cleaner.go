@@ -7,14 +7,22 @@ import (
 
 	"github.com/renderedtext/go-watchman"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/retention"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/storage"
+	pathutil "github.com/semaphoreio/semaphore/artifacthub/pkg/util/path"
 	"gorm.io/gorm"
 )
 
+// BlobReleaseGracePeriod is how long blobs stay stored after nothing references them.
+const BlobReleaseGracePeriod = 24 * time.Hour
+
 type BatchCleaner struct {
 	artifactBucket  *models.Artifact
 	retentionPolicy *models.RetentionPolicy
 	cleanRequest    *CleanRequest
+	report          *models.RetentionReport
+	evaluator       *retention.Evaluator
+	workflowRefs    retention.WorkflowRefs
 
 	client storage.Client
 	bucket storage.Bucket
@@ -65,7 +73,12 @@ func (c *BatchCleaner) Run(tx *gorm.DB) (string, error) {
 		return "", err
 	}
 
-	nextPageToken, err := c.cleanup()
+	err = c.setupEvaluator()
+	if err != nil {
+		return "", err
+	}
+
+	nextPageToken, err := c.cleanup(tx)
 	if err != nil {
 		return "", err
 	}
@@ -79,6 +92,17 @@ func (c *BatchCleaner) Run(tx *gorm.DB) (string, error) {
 	// we mark the cleaning as done, as stop.
 	if nextPageToken == "" {
 		c.paginationEnded = true
+
+		err = c.deleteReleasedBlobs()
+		if err != nil {
+			return "", err
+		}
+
+		err = c.finishReport(tx)
+		if err != nil {
+			return "", err
+		}
+
 		return "", c.saveThatCleaningIsDone(tx)
 	}
 
@@ -142,12 +166,43 @@ func (c *BatchCleaner) setupObjectPager() error {
 	return nil
 }
 
-func (c *BatchCleaner) cleanup() (string, error) {
+// Plans are built at the start of a pass, and reused by the next clean requests of the pass.
+func (c *BatchCleaner) setupEvaluator() error {
+	c.evaluator = retention.NewEvaluator(c.retentionPolicy, c.workflowRefs)
+	if !c.evaluator.NeedsPlan() {
+		return nil
+	}
+
+	if c.cleanRequest.PaginationToken != "" && c.cleanRequest.Plan != nil {
+		c.evaluator.SetPlan(c.cleanRequest.Plan)
+		return nil
+	}
+
+	blobRefs, err := models.ListObjectRefs(c.artifactBucket.ID)
+	if err != nil {
+		log.Printf("failed to list the blobs of the artifact, %s", err.Error())
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	err = c.evaluator.BuildPlan(ctx, c.bucket, blobRefs)
+	if err != nil {
+		log.Printf("failed to plan the retention policy, %s", err.Error())
+		return err
+	}
+
+	c.cleanRequest.Plan = c.evaluator.Plan()
+	return nil
+}
+
+func (c *BatchCleaner) cleanup(tx *gorm.DB) (string, error) {
 	var err error
 	var token string
 
 	for i := 0; i < c.pages; i++ {
-		token, err = c.cleanupOnePage()
+		token, err = c.cleanupOnePage(tx)
 		if err != nil {
 			return "", err
 		}
@@ -189,7 +244,7 @@ func (c *BatchCleaner) destroyArtifact() error {
 	return nil
 }
 
-func (c *BatchCleaner) cleanupOnePage() (string, error) {
+func (c *BatchCleaner) cleanupOnePage(tx *gorm.DB) (string, error) {
 	_ = watchman.Increment("bucketcleaner.worker.page_visits")
 	objects, nextPageToken, err := c.pager.NextPage()
 	if err != nil {
@@ -205,15 +260,24 @@ func (c *BatchCleaner) cleanupOnePage() (string, error) {
 
 	_ = watchman.IncrementBy("bucketcleaner.worker.object_visits", len(objects))
 
-	results := []string{}
+	matched, err := c.evaluator.Match(context.Background(), objects)
+	if err != nil {
+		return "", err
+	}
 
-	for _, object := range objects {
-		c.visitedObjectCount++
+	c.visitedObjectCount += len(objects)
+	c.deletedObjectCount += len(matched)
 
-		if c.retentionPolicy.IsMatching(object.Path, *object.Age) {
-			c.deletedObjectCount++
-			results = append(results, object.Path)
-		}
+	results := []string{}
+	deleted := []models.RetentionReportObject{}
+
+	for _, object := range matched {
+		results = append(results, object.Path)
+		deleted = append(deleted, models.RetentionReportObject{
+			Path: object.Path,
+			Size: object.Size,
+			Age:  int64(object.Age.Seconds()),
+		})
 	}
 
 	_ = watchman.IncrementBy("bucketcleaner.worker.delete_objects", len(results))
@@ -222,5 +286,75 @@ func (c *BatchCleaner) cleanupOnePage() (string, error) {
 		return "", err
 	}
 
+	err = models.ReleaseObjectRefs(c.artifactBucket.ID, results)
+	if err != nil {
+		return "", err
+	}
+
+	err = models.DeleteObjectMetadata(c.artifactBucket.ID, results)
+	if err != nil {
+		return "", err
+	}
+
+	if len(objects) > 0 {
+		report, err := c.loadReport(tx)
+		if err != nil {
+			return "", err
+		}
+
+		err = report.AddPageWithTx(tx, len(objects), deleted)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	return nextPageToken, nil
 }
+
+// The report is loaded only once there is something to record in it,
+// so nothing references an artifact that is about to be destroyed.
+func (c *BatchCleaner) loadReport(tx *gorm.DB) (*models.RetentionReport, error) {
+	if c.report != nil {
+		return c.report, nil
+	}
+
+	var err error
+	if c.cleanRequest.PaginationToken == "" {
+		c.report, err = models.StartRetentionReportWithTx(tx, c.artifactBucket.ID)
+	} else {
+		c.report, err = models.FindOpenRetentionReportWithTx(tx, c.artifactBucket.ID)
+	}
+
+	return c.report, err
+}
+
+func (c *BatchCleaner) finishReport(tx *gorm.DB) error {
+	report, err := c.loadReport(tx)
+	if err != nil {
+		return err
+	}
+
+	return report.FinishWithTx(tx)
+}
+
+// Blobs are not deleted as soon as nothing references them,
+// since a push could start referencing them again at the same time.
+func (c *BatchCleaner) deleteReleasedBlobs() error {
+	blobs, err := models.FindReleasedBlobs(c.artifactBucket.ID, BlobReleaseGracePeriod)
+	if err != nil {
+		return err
+	}
+
+	for _, blob := range blobs {
+		err := models.DeleteReleasedBlob(c.artifactBucket.ID, blob.Digest, func() error {
+			return c.bucket.DeleteFile(context.Background(), pathutil.BlobPath(blob.Digest))
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_ = watchman.IncrementBy("bucketcleaner.worker.delete_blobs", len(blobs))
+	return nil
+}
@@ -42,6 +42,19 @@ func Test__Cleaner(t *testing.T) {
 		assert.Equal(t, cleaner.deletedObjectCount, 2)
 		assert.Equal(t, cleaner.visitedObjectCount, 5)
 		assert.False(t, cleaner.artifactDeleted)
+
+		reports, err := models.ListRetentionReports(artifact.ID, 10)
+		assert.NoError(t, err)
+		if assert.Len(t, reports, 1) {
+			assert.NotNil(t, reports[0].FinishedAt)
+			assert.Equal(t, int64(5), reports[0].VisitedCount)
+			assert.Equal(t, int64(2), reports[0].DeletedCount)
+			assert.Equal(t, int64(2*1024), reports[0].DeletedSize)
+
+			objects, err := models.ListRetentionReportObjects(reports[0].ID, "", 10)
+			assert.NoError(t, err)
+			assert.Len(t, objects, 2)
+		}
 	})
 
 	t.Run("does not delete empty bucket if still active", func(t *testing.T) {
@@ -10,6 +10,7 @@ import (
 	tackle "github.com/renderedtext/go-tackle"
 	"github.com/renderedtext/go-watchman"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/db"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/retention"
 	"github.com/semaphoreio/semaphore/artifacthub/pkg/storage"
 	"gorm.io/gorm"
 )
@@ -19,6 +20,7 @@ type Worker struct {
 	consumer                      *tackle.Consumer
 	client                        storage.Client
 	NumberOfPagesToProcessInOneGo int
+	WorkflowRefs                  retention.WorkflowRefs
 }
 
 const BucketCleanerExchange = "artifacthub.bucketcleaner"
@@ -109,6 +111,7 @@ func (w *Worker) CleanBucket(cleanRequest *CleanRequest) error {
 
 	err := w.withLock(cleanRequest.ArtifactBucketID.String(), func(tx *gorm.DB) error {
 		cleaner := NewBatchCleaner(w.client, cleanRequest, w.NumberOfPagesToProcessInOneGo)
+		cleaner.workflowRefs = w.WorkflowRefs
 		token, err := cleaner.Run(tx)
 
 		log.Printf("BucketCleaner: Cleaning bucket %s - visited=%d deleted=%d pagination-finished=%t destroyed=%t",
@@ -122,6 +122,18 @@ func (w *Worker) handleMessage(delivery tackle.Delivery) error {
 		return err
 	}
 
+	err = models.ReleaseObjectRefsUnder(artifact.ID, jobPath)
+	if err != nil {
+		log.Printf("JobDeletion Worker: Error releasing blobs at path %s: %v", jobPath, err)
+		return err
+	}
+
+	err = models.DeleteObjectMetadataUnder(artifact.ID, jobPath)
+	if err != nil {
+		log.Printf("JobDeletion Worker: Error deleting metadata at path %s: %v", jobPath, err)
+		return err
+	}
+
 	err = watchman.Increment("retention.deleted.success")
 	if err != nil {
 		log.Printf("JobDeletion Worker: Failed to increment watchman counter: %v", err)
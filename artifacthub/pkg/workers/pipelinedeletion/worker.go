@@ -120,6 +120,18 @@ func (w *Worker) handleMessage(delivery tackle.Delivery) error {
 		return err
 	}
 
+	err = models.ReleaseObjectRefsUnder(artifact.ID, pipelinePath)
+	if err != nil {
+		log.Printf("PipelineDeletion Worker: Error releasing blobs at path %s: %v", pipelinePath, err)
+		return err
+	}
+
+	err = models.DeleteObjectMetadataUnder(artifact.ID, pipelinePath)
+	if err != nil {
+		log.Printf("PipelineDeletion Worker: Error deleting metadata at path %s: %v", pipelinePath, err)
+		return err
+	}
+
 	err = watchman.Increment("retention.pipeline_deleted.success")
 	if err != nil {
 		log.Printf("PipelineDeletion Worker: Failed to increment watchman counter: %v", err)
@@ -0,0 +1,133 @@
+package uploadjanitor
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	watchman "github.com/renderedtext/go-watchman"
+	"gorm.io/gorm"
+
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/storage"
+)
+
+const abortTimeout = 30 * time.Second
+
+// Janitor periodically aborts the multipart uploads that were started,
+// but never completed nor aborted by the clients, so their parts don't
+// keep taking space in the buckets.
+type Janitor struct {
+	StorageClient storage.MultipartClient
+	Naptime       time.Duration
+	BatchSize     int
+	MaxAge        time.Duration
+
+	Running bool
+	Cycles  int
+
+	stopChannel chan bool
+	ticker      *time.Ticker
+}
+
+func NewJanitor(client storage.MultipartClient, naptime time.Duration, batchSize int, maxAge time.Duration) *Janitor {
+	return &Janitor{
+		StorageClient: client,
+		Naptime:       naptime,
+		BatchSize:     batchSize,
+		MaxAge:        maxAge,
+	}
+}
+
+func (j *Janitor) Start() {
+	j.stopChannel = make(chan bool)
+	j.ticker = time.NewTicker(j.Naptime)
+	j.Running = true
+
+	go func() {
+		j.workloop()
+
+		j.Running = false
+		j.Cycles = 0
+		j.ticker.Stop()
+		close(j.stopChannel)
+	}()
+}
+
+func (j *Janitor) Stop() {
+	j.stopChannel <- true
+}
+
+func (j *Janitor) workloop() {
+	for {
+		select {
+		case <-j.stopChannel:
+			return
+
+		case <-j.ticker.C:
+			j.Cycles++
+
+			err := j.Tick()
+			if err != nil {
+				_ = watchman.Increment("uploadjanitor.failures")
+
+				log.Printf("UploadJanitor: err while aborting uploads %s", err.Error())
+			}
+		}
+	}
+}
+
+func (j *Janitor) Tick() error {
+	defer watchman.Benchmark(time.Now(), "uploadjanitor.tick.duration")
+
+	uploads, err := models.FindStaleMultipartUploads(j.MaxAge, j.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	_ = watchman.Submit("uploadjanitor.batch.size", len(uploads))
+
+	for i := range uploads {
+		err := j.Abort(&uploads[i])
+		if err != nil {
+			_ = watchman.Increment("uploadjanitor.abort.failures")
+
+			log.Printf("UploadJanitor: failed to abort %s, err: '%s'", uploads[i].ID.String(), err.Error())
+			continue
+		}
+	}
+
+	return nil
+}
+
+// Abort drops the parts of the upload from the storage, and forgets about it.
+// Uploads the storage doesn't know about anymore, and uploads of deleted artifacts,
+// are only forgotten.
+func (j *Janitor) Abort(upload *models.MultipartUpload) error {
+	artifact, err := models.FindArtifactByID(upload.ArtifactID.String())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return upload.Delete()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), abortTimeout)
+	defer cancel()
+
+	err = j.StorageClient.AbortMultipartUpload(ctx, storage.MultipartUploadOptions{
+		BucketName: artifact.BucketName,
+		Path:       upload.Path,
+		PathPrefix: artifact.IdempotencyToken,
+		Size:       upload.Size,
+	}, upload.UploadID)
+
+	if err != nil && !errors.Is(err, storage.ErrMultipartUploadNotFound) {
+		return err
+	}
+
+	_ = watchman.Increment("uploadjanitor.aborted")
+	return upload.Delete()
+}
@@ -0,0 +1,57 @@
+package uploadjanitor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/db"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeMultipartClient struct {
+	storage.MultipartClient
+	aborted []string
+	missing map[string]bool
+}
+
+func (c *fakeMultipartClient) AbortMultipartUpload(ctx context.Context, options storage.MultipartUploadOptions, uploadID string) error {
+	if c.missing[uploadID] {
+		return storage.ErrMultipartUploadNotFound
+	}
+
+	c.aborted = append(c.aborted, options.PathPrefix+"/"+options.Path+":"+uploadID)
+	return nil
+}
+
+func Test__Tick(t *testing.T) {
+	models.PrepareDatabaseForTests()
+
+	artifact, err := models.CreateArtifact(uuid.NewV4().String(), "token")
+	require.NoError(t, err)
+
+	createUpload := func(path, uploadID string, age time.Duration) {
+		upload, err := models.CreateMultipartUpload(artifact.ID, path, uploadID, 1024)
+		require.NoError(t, err)
+		require.NoError(t, db.Conn().Model(upload).Update("created_at", time.Now().Add(-age)).Error)
+	}
+
+	createUpload("artifacts/jobs/j1/stale.bin", "stale", 3*time.Hour)
+	createUpload("artifacts/jobs/j1/missing.bin", "missing", 3*time.Hour)
+	createUpload("artifacts/jobs/j1/fresh.bin", "fresh", time.Minute)
+
+	client := &fakeMultipartClient{missing: map[string]bool{"missing": true}}
+	janitor := NewJanitor(client, time.Second, 10, time.Hour)
+
+	require.NoError(t, janitor.Tick())
+	assert.Equal(t, []string{"token/artifacts/jobs/j1/stale.bin:stale"}, client.aborted)
+
+	uploads, err := models.FindStaleMultipartUploads(0, 10)
+	require.NoError(t, err)
+	require.Len(t, uploads, 1)
+	assert.Equal(t, "fresh", uploads[0].UploadID)
+}
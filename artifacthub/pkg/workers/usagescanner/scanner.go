@@ -0,0 +1,218 @@
+package usagescanner
+
+import (
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	watchman "github.com/renderedtext/go-watchman"
+
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/storage"
+	pathutil "github.com/semaphoreio/semaphore/artifacthub/pkg/util/path"
+)
+
+// Scanner periodically goes through the artifact buckets, and records how many bytes
+// are stored for every project, workflow and job in them. Every tick picks up the
+// artifacts with the oldest usage, and rescans them once the scan interval passes.
+type Scanner struct {
+	StorageClient storage.Client
+	Naptime       time.Duration
+	BatchSize     int
+	ScanInterval  time.Duration
+
+	Running bool
+	Cycles  int
+
+	stopChannel chan bool
+	ticker      *time.Ticker
+}
+
+func NewScanner(client storage.Client, naptime time.Duration, batchSize int, scanInterval time.Duration) *Scanner {
+	return &Scanner{
+		StorageClient: client,
+		Naptime:       naptime,
+		BatchSize:     batchSize,
+		ScanInterval:  scanInterval,
+	}
+}
+
+func (s *Scanner) Start() {
+	s.stopChannel = make(chan bool)
+	s.ticker = time.NewTicker(s.Naptime)
+	s.Running = true
+
+	go func() {
+		s.workloop()
+
+		s.Running = false
+		s.Cycles = 0
+		s.ticker.Stop()
+		close(s.stopChannel)
+	}()
+}
+
+func (s *Scanner) Stop() {
+	s.stopChannel <- true
+}
+
+func (s *Scanner) workloop() {
+	for {
+		select {
+		case <-s.stopChannel:
+			return
+
+		case <-s.ticker.C:
+			s.Cycles++
+
+			err := s.Tick()
+			if err != nil {
+				_ = watchman.Increment("usagescanner.failures")
+
+				log.Printf("UsageScanner: err while scanning usage %s", err.Error())
+			}
+		}
+	}
+}
+
+func (s *Scanner) Tick() error {
+	defer watchman.Benchmark(time.Now(), "usagescanner.tick.duration")
+
+	artifacts, err := models.FetchForUsageScan(s.BatchSize, s.ScanInterval)
+	if err != nil {
+		return err
+	}
+
+	_ = watchman.Submit("usagescanner.batch.size", len(artifacts))
+
+	for i := range artifacts {
+		err := s.ScanArtifact(&artifacts[i])
+		if err != nil {
+			_ = watchman.Increment("usagescanner.scan.failures")
+
+			log.Printf("UsageScanner: failed to scan %s, err: '%s'", artifacts[i].ID.String(), err.Error())
+			continue
+		}
+	}
+
+	return nil
+}
+
+func (s *Scanner) ScanArtifact(artifact *models.Artifact) error {
+	defer watchman.Benchmark(time.Now(), "usagescanner.scan.duration")
+
+	refs, err := models.ListObjectRefs(artifact.ID)
+	if err != nil {
+		return err
+	}
+
+	scannedAt := time.Now()
+	usage, err := ScanBucket(s.StorageClient, artifact, refs)
+	if err != nil {
+		// The bucket is gone, so nothing is stored in it anymore.
+		if !errors.Is(err, storage.ErrMissingBucket) {
+			return err
+		}
+
+		usage = []models.ArtifactUsage{}
+	}
+
+	return models.ReplaceArtifactUsage(artifact.ID, usage, scannedAt)
+}
+
+// ScanBucket goes through all the objects stored for an artifact,
+// and sums their sizes per project, workflow and job.
+// Paths pointing to blobs, given as a path => digest map, count with the size of the blob.
+func ScanBucket(client storage.Client, artifact *models.Artifact, refs map[string]string) ([]models.ArtifactUsage, error) {
+	bucket := client.GetBucket(storage.BucketOptions{
+		Name:       artifact.BucketName,
+		PathPrefix: artifact.IdempotencyToken,
+	})
+
+	blobSizes := map[string]int64{}
+	if len(refs) > 0 {
+		err := listObjects(bucket, pathutil.BlobPrefix, func(object *storage.Object) {
+			blobSizes[strings.TrimPrefix(object.Path, pathutil.BlobPrefix)] = object.Size
+		})
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	usage := []models.ArtifactUsage{}
+	indexes := map[string]int{}
+
+	err := listObjects(bucket, "artifacts/", func(object *storage.Object) {
+		category, categoryID, ok := parseObjectPath(object.Path)
+		if !ok {
+			return
+		}
+
+		key := category + "/" + categoryID
+		i, ok := indexes[key]
+		if !ok {
+			i = len(usage)
+			indexes[key] = i
+			usage = append(usage, models.ArtifactUsage{Category: category, CategoryID: categoryID})
+		}
+
+		size := object.Size
+		if digest, ok := refs[object.Path]; ok {
+			if blobSize, ok := blobSizes[digest]; ok {
+				size = blobSize
+			}
+		}
+
+		usage[i].Size += size
+		usage[i].ObjectCount++
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+func listObjects(bucket storage.Bucket, path string, fn func(*storage.Object)) error {
+	pager, err := bucket.ListObjectsWithPagination(storage.ListOptions{
+		Path:    path,
+		MaxKeys: 1000,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for {
+		objects, nextPageToken, err := pager.NextPage()
+		if err != nil {
+			return err
+		}
+
+		for _, object := range objects {
+			fn(object)
+		}
+
+		if nextPageToken == "" {
+			return nil
+		}
+	}
+}
+
+// Objects are stored under artifacts/<projects|workflows|jobs>/<ID>/.
+func parseObjectPath(path string) (string, string, bool) {
+	parts := strings.SplitN(path, "/", 4)
+	if len(parts) < 4 || parts[0] != "artifacts" || parts[2] == "" {
+		return "", "", false
+	}
+
+	switch parts[1] {
+	case models.UsageCategoryProject, models.UsageCategoryWorkflow, models.UsageCategoryJob:
+		return parts[1], parts[2], true
+	default:
+		return "", "", false
+	}
+}
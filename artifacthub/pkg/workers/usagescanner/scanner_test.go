@@ -0,0 +1,149 @@
+package usagescanner
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	uuid "github.com/satori/go.uuid"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/models"
+	"github.com/semaphoreio/semaphore/artifacthub/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createObjects(t *testing.T, client storage.Client, artifact *models.Artifact, objects map[string]string) {
+	bucket := client.GetBucket(storage.BucketOptions{Name: artifact.BucketName})
+	for path, content := range objects {
+		require.NoError(t, bucket.CreateObject(context.Background(), path, []byte(content)))
+	}
+}
+
+func Test__ScanBucket(t *testing.T) {
+	client := storage.NewInMemoryStorage()
+	artifact := &models.Artifact{BucketName: "usage-bucket"}
+
+	createObjects(t, client, artifact, map[string]string{
+		"artifacts/projects/p1/a.txt":         "12345",
+		"artifacts/projects/p1/dir/b.txt":     "123",
+		"artifacts/workflows/w1/c.txt":        "1",
+		"artifacts/jobs/j1/d.txt":             "1234567890",
+		"artifacts/jobs/j2/e.txt":             "12",
+		"artifacts/unknown/u1/f.txt":          "123",
+		"artifacts/jobs/file-without-id.txt":  "123",
+		"agent/jobs/j1/job_logs.txt.gz":       "123",
+		"artifacts/workflows/w1/sub/dir/g.gz": "1234",
+	})
+
+	usage, err := ScanBucket(client, artifact, map[string]string{})
+	require.NoError(t, err)
+
+	sizes := map[string]int64{}
+	counts := map[string]int64{}
+	for _, u := range usage {
+		sizes[u.Category+"/"+u.CategoryID] = u.Size
+		counts[u.Category+"/"+u.CategoryID] = u.ObjectCount
+	}
+
+	assert.Equal(t, map[string]int64{
+		"projects/p1":  8,
+		"workflows/w1": 5,
+		"jobs/j1":      10,
+		"jobs/j2":      2,
+	}, sizes)
+
+	assert.Equal(t, map[string]int64{
+		"projects/p1":  2,
+		"workflows/w1": 2,
+		"jobs/j1":      1,
+		"jobs/j2":      1,
+	}, counts)
+}
+
+func Test__ScanBucketWithBlobs(t *testing.T) {
+	client := storage.NewInMemoryStorage()
+	artifact := &models.Artifact{BucketName: "usage-blob-bucket"}
+	digest := strings.Repeat("ab", 32)
+
+	createObjects(t, client, artifact, map[string]string{
+		"blobs/sha256/" + digest:  "1234567890",
+		"artifacts/jobs/j1/a.txt": "sha256:" + digest + "\n",
+		"artifacts/jobs/j2/a.txt": "sha256:" + digest + "\n",
+		"artifacts/jobs/j2/b.txt": "123",
+		"artifacts/jobs/j3/c.txt": "sha256:missing\n",
+	})
+
+	usage, err := ScanBucket(client, artifact, map[string]string{
+		"artifacts/jobs/j1/a.txt": digest,
+		"artifacts/jobs/j2/a.txt": digest,
+		"artifacts/jobs/j3/c.txt": strings.Repeat("cd", 32),
+	})
+
+	require.NoError(t, err)
+
+	sizes := map[string]int64{}
+	for _, u := range usage {
+		sizes[u.Category+"/"+u.CategoryID] = u.Size
+	}
+
+	assert.Equal(t, map[string]int64{
+		"jobs/j1": 10,
+		"jobs/j2": 13,
+		"jobs/j3": int64(len("sha256:missing\n")),
+	}, sizes)
+}
+
+func Test__ScanArtifact(t *testing.T) {
+	models.PrepareDatabaseForTests()
+
+	client := storage.NewInMemoryStorage()
+	scanner := NewScanner(client, time.Second, 10, time.Hour)
+
+	orgID := uuid.NewV4()
+	artifact, err := models.CreateArtifact(uuid.NewV4().String(), uuid.NewV4().String())
+	require.NoError(t, err)
+	require.NoError(t, artifact.UpdateOrgID(orgID))
+
+	createObjects(t, client, artifact, map[string]string{
+		"artifacts/projects/p1/a.txt": "12345",
+		"artifacts/jobs/j1/b.txt":     "123",
+	})
+
+	t.Run("unscanned artifacts are picked up", func(t *testing.T) {
+		require.NoError(t, scanner.Tick())
+
+		usage, err := models.ListArtifactUsage(artifact.ID)
+		require.NoError(t, err)
+		require.Len(t, usage, 2)
+
+		size, err := models.OrganizationUsageSize(orgID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(8), size)
+	})
+
+	t.Run("recently scanned artifacts are skipped", func(t *testing.T) {
+		artifacts, err := models.FetchForUsageScan(10, time.Hour)
+		require.NoError(t, err)
+		assert.Empty(t, artifacts)
+	})
+
+	t.Run("rescans replace the previous usage", func(t *testing.T) {
+		createObjects(t, client, artifact, map[string]string{
+			"artifacts/jobs/j1/c.txt": "12",
+		})
+
+		require.NoError(t, scanner.ScanArtifact(artifact))
+
+		size, err := models.OrganizationUsageSize(orgID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), size)
+
+		usage, err := models.ListOrganizationUsage(orgID)
+		require.NoError(t, err)
+		require.Len(t, usage, 2)
+		assert.Equal(t, models.UsageCategoryJob, usage[0].Category)
+		assert.Equal(t, int64(5), usage[0].Size)
+		assert.Equal(t, int64(2), usage[0].ObjectCount)
+	})
+}
@@ -120,6 +120,18 @@ func (w *Worker) handleMessage(delivery tackle.Delivery) error {
 		return err
 	}
 
+	err = models.ReleaseObjectRefsUnder(artifact.ID, workflowPath)
+	if err != nil {
+		log.Printf("WorkflowDeletion Worker: Error releasing blobs at path %s: %v", workflowPath, err)
+		return err
+	}
+
+	err = models.DeleteObjectMetadataUnder(artifact.ID, workflowPath)
+	if err != nil {
+		log.Printf("WorkflowDeletion Worker: Error deleting metadata at path %s: %v", workflowPath, err)
+		return err
+	}
+
 	err = watchman.Increment("retention.workflow_deleted.success")
 	if err != nil {
 		log.Printf("WorkflowDeletion Worker: Failed to increment watchman counter: %v", err)
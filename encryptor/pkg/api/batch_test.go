@@ -0,0 +1,166 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/semaphoreio/semaphore/encryptor/pkg/crypto"
+	pb "github.com/semaphoreio/semaphore/encryptor/pkg/protos/encryptor"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newAESService(t *testing.T) *EncryptorService {
+	key := make([]byte, 32)
+	_, _ = rand.Read(key)
+	encryptor, err := crypto.NewAESGCMEncryptor(key)
+	require.NoError(t, err)
+	return NewEncryptorService(encryptor)
+}
+
+func Test__EncryptBatchAndDecryptBatch(t *testing.T) {
+	service := newAESService(t)
+
+	encrypted, err := service.EncryptBatch(context.TODO(), &pb.EncryptBatchRequest{
+		Items: []*pb.EncryptBatchItem{
+			{Id: "a", Raw: []byte("secret-a"), AssociatedData: []byte("project-a")},
+			{Id: "b", Raw: []byte("secret-b")},
+			{Id: "c", Raw: []byte("secret-c"), AssociatedData: []byte("project-c")},
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, encrypted.Results, 3)
+	require.Equal(t, int32(1), encrypted.Failed)
+	require.Empty(t, encrypted.Results[0].Error)
+	require.Equal(t, "b", encrypted.Results[1].Id)
+	require.Equal(t, "associated data is required", encrypted.Results[1].Error)
+	require.Empty(t, encrypted.Results[1].Cypher)
+	require.Empty(t, encrypted.Results[2].Error)
+
+	decrypted, err := service.DecryptBatch(context.TODO(), &pb.DecryptBatchRequest{
+		Items: []*pb.DecryptBatchItem{
+			{Id: "a", Cypher: encrypted.Results[0].Cypher, AssociatedData: []byte("project-a")},
+			{Id: "c", Cypher: encrypted.Results[2].Cypher, AssociatedData: []byte("project-a")},
+		},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, decrypted.Results, 2)
+	require.Equal(t, int32(1), decrypted.Failed)
+	require.Equal(t, "a", decrypted.Results[0].Id)
+	require.Equal(t, []byte("secret-a"), decrypted.Results[0].Raw)
+	require.Equal(t, "c", decrypted.Results[1].Id)
+	require.Contains(t, decrypted.Results[1].Error, "decrypting error")
+	require.Empty(t, decrypted.Results[1].Raw)
+}
+
+func Test__BatchTooLarge(t *testing.T) {
+	items := make([]*pb.EncryptBatchItem, MaxBatchSize+1)
+	for i := range items {
+		items[i] = &pb.EncryptBatchItem{Raw: []byte("a"), AssociatedData: []byte("a")}
+	}
+
+	_, err := testService.EncryptBatch(context.TODO(), &pb.EncryptBatchRequest{Items: items})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func Test__EncryptStreamAndDecryptStream(t *testing.T) {
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	pb.RegisterEncryptorServer(server, newAESService(t))
+	go func() { _ = server.Serve(listener) }()
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+
+	require.NoError(t, err)
+	defer conn.Close()
+	client := pb.NewEncryptorClient(conn)
+
+	// each item is 1MB, so the whole stream is bigger than MaxMessageSize,
+	// and the default receive limits on the client are enough for it.
+	items := 32
+	payload := make([]byte, 1024*1024)
+	_, _ = rand.Read(payload)
+
+	encryptStream, err := client.EncryptStream(context.TODO())
+	require.NoError(t, err)
+
+	go func() {
+		for i := 0; i < items; i++ {
+			_ = encryptStream.Send(&pb.EncryptBatchItem{
+				Id:             fmt.Sprintf("%d", i),
+				Raw:            payload,
+				AssociatedData: []byte("aaaa"),
+			})
+		}
+
+		_ = encryptStream.CloseSend()
+	}()
+
+	encrypted := []*pb.EncryptBatchResult{}
+	for {
+		result, err := encryptStream.Recv()
+		if err == io.EOF {
+			break
+		}
+
+		require.NoError(t, err)
+		require.Empty(t, result.Error)
+		require.Equal(t, fmt.Sprintf("%d", len(encrypted)), result.Id)
+		encrypted = append(encrypted, result)
+	}
+
+	require.Len(t, encrypted, items)
+
+	decryptStream, err := client.DecryptStream(context.TODO())
+	require.NoError(t, err)
+
+	go func() {
+		for i, result := range encrypted {
+			assocData := []byte("aaaa")
+			if i == 3 {
+				assocData = []byte("bbbb")
+			}
+
+			_ = decryptStream.Send(&pb.DecryptBatchItem{
+				Id:             result.Id,
+				Cypher:         result.Cypher,
+				AssociatedData: assocData,
+			})
+		}
+
+		_ = decryptStream.CloseSend()
+	}()
+
+	decrypted := []*pb.DecryptBatchResult{}
+	for {
+		result, err := decryptStream.Recv()
+		if err == io.EOF {
+			break
+		}
+
+		require.NoError(t, err)
+		decrypted = append(decrypted, result)
+	}
+
+	require.Len(t, decrypted, items)
+	require.Contains(t, decrypted[3].Error, "decrypting error")
+	require.Empty(t, decrypted[3].Raw)
+	require.Empty(t, decrypted[0].Error)
+	require.Equal(t, payload, decrypted[0].Raw)
+}
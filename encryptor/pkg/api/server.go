@@ -32,6 +32,11 @@ func RunServer(port int, encryptor crypto.Encryptor) {
 				grpc_recovery.WithRecoveryHandler(recoveryFunc()),
 			),
 		),
+		grpc.ChainStreamInterceptor(
+			grpc_recovery.StreamServerInterceptor(
+				grpc_recovery.WithRecoveryHandler(recoveryFunc()),
+			),
+		),
 	)
 
 	//
@@ -3,11 +3,18 @@ package api
 import (
 	"context"
 	"fmt"
+	"io"
 
 	crypto "github.com/semaphoreio/semaphore/encryptor/pkg/crypto"
 	pb "github.com/semaphoreio/semaphore/encryptor/pkg/protos/encryptor"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// Batches are limited by MaxMessageSize already,
+// but we also don't want to process an unbounded number of tiny items.
+const MaxBatchSize = 1000
+
 type EncryptorService struct {
 	Encryptor crypto.Encryptor
 }
@@ -33,3 +40,130 @@ func (s *EncryptorService) Decrypt(ctx context.Context, request *pb.DecryptReque
 
 	return &pb.DecryptResponse{Raw: raw}, nil
 }
+
+func (s *EncryptorService) Reencrypt(ctx context.Context, request *pb.ReencryptRequest) (*pb.ReencryptResponse, error) {
+	raw, err := s.Encryptor.Decrypt(request.Cypher, request.AssociatedData)
+	if err != nil {
+		return nil, fmt.Errorf("decrypting error: %v", err)
+	}
+
+	cypherText, err := s.Encryptor.Encrypt(raw, request.AssociatedData)
+	if err != nil {
+		return nil, fmt.Errorf("encryption error: %v", err)
+	}
+
+	response := &pb.ReencryptResponse{Cypher: cypherText}
+	if identifier, ok := s.Encryptor.(crypto.KeyIdentifier); ok {
+		response.KeyId = identifier.ActiveKeyID()
+	}
+
+	return response, nil
+}
+
+func (s *EncryptorService) EncryptBatch(ctx context.Context, request *pb.EncryptBatchRequest) (*pb.EncryptBatchResponse, error) {
+	if len(request.Items) > MaxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch has %d items, max is %d", len(request.Items), MaxBatchSize)
+	}
+
+	response := &pb.EncryptBatchResponse{}
+	for _, item := range request.Items {
+		result := s.encryptItem(item)
+		if result.Error != "" {
+			response.Failed++
+		}
+
+		response.Results = append(response.Results, result)
+	}
+
+	return response, nil
+}
+
+func (s *EncryptorService) DecryptBatch(ctx context.Context, request *pb.DecryptBatchRequest) (*pb.DecryptBatchResponse, error) {
+	if len(request.Items) > MaxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch has %d items, max is %d", len(request.Items), MaxBatchSize)
+	}
+
+	response := &pb.DecryptBatchResponse{}
+	for _, item := range request.Items {
+		result := s.decryptItem(item)
+		if result.Error != "" {
+			response.Failed++
+		}
+
+		response.Results = append(response.Results, result)
+	}
+
+	return response, nil
+}
+
+// Streams send back every result as soon as the item is processed,
+// so they are not limited by MaxMessageSize or by the number of items.
+func (s *EncryptorService) EncryptStream(stream pb.Encryptor_EncryptStreamServer) error {
+	for {
+		item, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		err = stream.Send(s.encryptItem(item))
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *EncryptorService) DecryptStream(stream pb.Encryptor_DecryptStreamServer) error {
+	for {
+		item, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		err = stream.Send(s.decryptItem(item))
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s *EncryptorService) encryptItem(item *pb.EncryptBatchItem) *pb.EncryptBatchResult {
+	result := &pb.EncryptBatchResult{Id: item.Id}
+	if len(item.AssociatedData) == 0 {
+		result.Error = "associated data is required"
+		return result
+	}
+
+	cypherText, err := s.Encryptor.Encrypt(item.Raw, item.AssociatedData)
+	if err != nil {
+		result.Error = fmt.Sprintf("encryption error: %v", err)
+		return result
+	}
+
+	result.Cypher = cypherText
+	return result
+}
+
+func (s *EncryptorService) decryptItem(item *pb.DecryptBatchItem) *pb.DecryptBatchResult {
+	result := &pb.DecryptBatchResult{Id: item.Id}
+	if len(item.AssociatedData) == 0 {
+		result.Error = "associated data is required"
+		return result
+	}
+
+	raw, err := s.Encryptor.Decrypt(item.Cypher, item.AssociatedData)
+	if err != nil {
+		result.Error = fmt.Sprintf("decrypting error: %v", err)
+		return result
+	}
+
+	result.Raw = raw
+	return result
+}
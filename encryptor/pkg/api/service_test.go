@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"crypto/rand"
 	"testing"
 
 	"github.com/semaphoreio/semaphore/encryptor/pkg/crypto"
@@ -25,3 +26,66 @@ func Test__Encrypt(t *testing.T) {
 	require.NotEmpty(t, response.Cypher)
 	require.Equal(t, message, string(response.Cypher))
 }
+
+func Test__Reencrypt(t *testing.T) {
+	oldKey := make([]byte, 32)
+	_, _ = rand.Read(oldKey)
+	newKey := make([]byte, 32)
+	_, _ = rand.Read(newKey)
+
+	oldEncryptor, err := crypto.NewAESGCMEncryptor(oldKey)
+	require.NoError(t, err)
+	rotatedEncryptor, err := crypto.NewAESGCMKeyringEncryptor(map[string][]byte{"v2": newKey}, "v2", oldKey)
+	require.NoError(t, err)
+	newOnlyEncryptor, err := crypto.NewAESGCMKeyringEncryptor(map[string][]byte{"v2": newKey}, "v2", nil)
+	require.NoError(t, err)
+
+	message := []byte("very sensitive information")
+	assocData := []byte("aaaa")
+	cypher, err := oldEncryptor.Encrypt(message, assocData)
+	require.NoError(t, err)
+
+	service := NewEncryptorService(rotatedEncryptor)
+	response, err := service.Reencrypt(context.TODO(), &pb.ReencryptRequest{
+		Cypher:         cypher,
+		AssociatedData: assocData,
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, "v2", response.KeyId)
+
+	// the new cypher can be decrypted without the old key
+	raw, err := newOnlyEncryptor.Decrypt(response.Cypher, assocData)
+	require.NoError(t, err)
+	require.Equal(t, message, raw)
+
+	// wrong associated data fails
+	_, err = service.Reencrypt(context.TODO(), &pb.ReencryptRequest{
+		Cypher:         cypher,
+		AssociatedData: []byte("bbbb"),
+	})
+
+	require.Error(t, err)
+}
+
+func Test__ReencryptWithEnvelopeEncryptor(t *testing.T) {
+	key := make([]byte, 32)
+	_, _ = rand.Read(key)
+
+	provider, err := crypto.NewLocalKEKProvider(key)
+	require.NoError(t, err)
+	encryptor, err := crypto.NewEnvelopeEncryptor(provider)
+	require.NoError(t, err)
+
+	cypher, err := encryptor.Encrypt([]byte("very sensitive information"), []byte("aaaa"))
+	require.NoError(t, err)
+
+	response, err := NewEncryptorService(encryptor).Reencrypt(context.TODO(), &pb.ReencryptRequest{
+		Cypher:         cypher,
+		AssociatedData: []byte("aaaa"),
+	})
+
+	require.NoError(t, err)
+	require.Equal(t, provider.KeyID(), response.KeyId)
+	require.Regexp(t, "^file:[0-9a-f]{16}$", response.KeyId)
+}
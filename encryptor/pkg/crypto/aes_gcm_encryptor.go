@@ -1,26 +1,161 @@
 package crypto
 
 import (
+	"bytes"
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
 	"fmt"
 )
 
+// Ciphertexts produced with a versioned key are prefixed with
+// magic + len(keyID) + keyID, so we know which key to use for decryption.
+// Ciphertexts produced before key versioning existed have no header at all,
+// and are decrypted with the legacy key.
+var keyIDHeaderMagic = []byte{'s', 'k', 'i', 0x01}
+
+const maxKeyIDLength = 255
+
 type AESGCMEncryptor struct {
-	key []byte
+	keys        map[string][]byte
+	activeKeyID string
+	legacyKey   []byte
 }
 
+// NewAESGCMEncryptor creates an encryptor using a single, unversioned key.
+// Ciphertexts produced by it do not carry a key ID header.
 func NewAESGCMEncryptor(key []byte) (Encryptor, error) {
 	if len(key) == 0 {
 		return nil, fmt.Errorf("empty key")
 	}
 
-	return &AESGCMEncryptor{key: key}, nil
+	return &AESGCMEncryptor{keys: map[string][]byte{}, legacyKey: key}, nil
+}
+
+// NewAESGCMKeyringEncryptor creates an encryptor that holds several keys.
+// New ciphertexts are always encrypted with the key identified by activeKeyID,
+// and all the other keys are only used for decryption.
+// If legacyKey is not empty, it is used to decrypt ciphertexts without a key ID header.
+func NewAESGCMKeyringEncryptor(keys map[string][]byte, activeKeyID string, legacyKey []byte) (Encryptor, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no keys")
+	}
+
+	for id, key := range keys {
+		if id == "" {
+			return nil, fmt.Errorf("empty key ID")
+		}
+
+		if len(id) > maxKeyIDLength {
+			return nil, fmt.Errorf("key ID %s is too long", id)
+		}
+
+		if len(key) == 0 {
+			return nil, fmt.Errorf("empty key for key ID %s", id)
+		}
+	}
+
+	if _, ok := keys[activeKeyID]; !ok {
+		return nil, fmt.Errorf("active key %s is not in the keyring", activeKeyID)
+	}
+
+	return &AESGCMEncryptor{
+		keys:        keys,
+		activeKeyID: activeKeyID,
+		legacyKey:   legacyKey,
+	}, nil
+}
+
+// ActiveKeyID returns the ID of the key used for new ciphertexts.
+// An empty ID means ciphertexts are produced without a key ID header.
+func (e *AESGCMEncryptor) ActiveKeyID() string {
+	return e.activeKeyID
 }
 
 func (e *AESGCMEncryptor) Encrypt(data []byte, associatedData []byte) ([]byte, error) {
-	aes, err := aes.NewCipher(e.key)
+	if e.activeKeyID == "" {
+		return seal(e.legacyKey, nil, data, associatedData)
+	}
+
+	header := make([]byte, 0, len(keyIDHeaderMagic)+1+len(e.activeKeyID))
+	header = append(header, keyIDHeaderMagic...)
+	header = append(header, byte(len(e.activeKeyID)))
+	header = append(header, e.activeKeyID...)
+	return seal(e.keys[e.activeKeyID], header, data, associatedData)
+}
+
+func (e *AESGCMEncryptor) Decrypt(cyphertext []byte, associatedData []byte) ([]byte, error) {
+	keyID, rest, hasHeader := parseKeyIDHeader(cyphertext)
+	if !hasHeader {
+		return e.decryptLegacy(cyphertext, associatedData)
+	}
+
+	key, ok := e.keys[keyID]
+	if !ok {
+		// A legacy ciphertext could randomly start with our header magic,
+		// so we still try the legacy key before giving up.
+		if len(e.legacyKey) > 0 {
+			if plaintext, err := open(e.legacyKey, cyphertext, associatedData); err == nil {
+				return plaintext, nil
+			}
+		}
+
+		return nil, fmt.Errorf("unknown key %s", keyID)
+	}
+
+	plaintext, err := open(key, rest, associatedData)
+	if err != nil {
+		if len(e.legacyKey) > 0 {
+			if plaintext, legacyErr := open(e.legacyKey, cyphertext, associatedData); legacyErr == nil {
+				return plaintext, nil
+			}
+		}
+
+		return nil, err
+	}
+
+	return plaintext, nil
+}
+
+// KeyID returns the ID of the key used to produce the ciphertext.
+// An empty ID is returned for ciphertexts without a key ID header.
+func (e *AESGCMEncryptor) KeyID(cyphertext []byte) string {
+	keyID, _, hasHeader := parseKeyIDHeader(cyphertext)
+	if !hasHeader {
+		return ""
+	}
+
+	return keyID
+}
+
+func (e *AESGCMEncryptor) decryptLegacy(cyphertext []byte, associatedData []byte) ([]byte, error) {
+	if len(e.legacyKey) == 0 {
+		return nil, fmt.Errorf("no legacy key to decrypt ciphertext without key ID")
+	}
+
+	return open(e.legacyKey, cyphertext, associatedData)
+}
+
+func parseKeyIDHeader(cyphertext []byte) (string, []byte, bool) {
+	if !bytes.HasPrefix(cyphertext, keyIDHeaderMagic) {
+		return "", nil, false
+	}
+
+	rest := cyphertext[len(keyIDHeaderMagic):]
+	if len(rest) == 0 {
+		return "", nil, false
+	}
+
+	keyIDLength := int(rest[0])
+	if keyIDLength == 0 || len(rest) < 1+keyIDLength {
+		return "", nil, false
+	}
+
+	return string(rest[1 : 1+keyIDLength]), rest[1+keyIDLength:], true
+}
+
+func seal(key, header, data, associatedData []byte) ([]byte, error) {
+	aes, err := aes.NewCipher(key)
 	if err != nil {
 		return nil, err
 	}
@@ -37,13 +172,13 @@ func (e *AESGCMEncryptor) Encrypt(data []byte, associatedData []byte) ([]byte, e
 		return nil, err
 	}
 
-	// The final value is nonce+ciphertext
-	ciphertext := gcm.Seal(nonce, nonce, data, associatedData)
-	return ciphertext, nil
+	// The final value is header+nonce+ciphertext
+	out := append(header, nonce...)
+	return gcm.Seal(out, nonce, data, associatedData), nil
 }
 
-func (e *AESGCMEncryptor) Decrypt(cyphertext []byte, associatedData []byte) ([]byte, error) {
-	block, err := aes.NewCipher(e.key)
+func open(key, cyphertext, associatedData []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
 	if err != nil {
 		return nil, err
 	}
@@ -56,6 +191,10 @@ func (e *AESGCMEncryptor) Decrypt(cyphertext []byte, associatedData []byte) ([]b
 	// We know the nonce is prepended in the cyphertext
 	// and we know its size, so can easily separate the two.
 	nonceSize := gcm.NonceSize()
+	if len(cyphertext) < nonceSize {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+
 	nonce := cyphertext[:nonceSize]
 	ciphertext := cyphertext[nonceSize:]
 
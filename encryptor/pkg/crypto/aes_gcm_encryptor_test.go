@@ -2,6 +2,7 @@ package crypto
 
 import (
 	"crypto/rand"
+	"encoding/base64"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -76,3 +77,108 @@ func Test__AESGCMEncryptor(t *testing.T) {
 		require.Nil(t, plaintext)
 	})
 }
+
+func Test__AESGCMKeyringEncryptor(t *testing.T) {
+	newKey := func() []byte {
+		key := make([]byte, 32)
+		_, _ = rand.Read(key)
+		return key
+	}
+
+	data := []byte("testing encryption")
+	assocData := []byte("aaaa")
+
+	t.Run("active key must be in the keyring", func(t *testing.T) {
+		encryptor, err := NewAESGCMKeyringEncryptor(map[string][]byte{"v1": newKey()}, "v2", nil)
+		require.Error(t, err)
+		require.Nil(t, encryptor)
+	})
+
+	t.Run("empty keyring fails encryptor creation", func(t *testing.T) {
+		encryptor, err := NewAESGCMKeyringEncryptor(map[string][]byte{}, "v1", nil)
+		require.Error(t, err)
+		require.Nil(t, encryptor)
+	})
+
+	t.Run("ciphertext carries the active key ID", func(t *testing.T) {
+		encryptor, err := NewAESGCMKeyringEncryptor(map[string][]byte{"v1": newKey(), "v2": newKey()}, "v2", nil)
+		require.NoError(t, err)
+
+		cyphertext, err := encryptor.Encrypt(data, assocData)
+		require.NoError(t, err)
+		require.Equal(t, "v2", encryptor.(*AESGCMEncryptor).KeyID(cyphertext))
+
+		plaintext, err := encryptor.Decrypt(cyphertext, assocData)
+		require.NoError(t, err)
+		require.Equal(t, data, plaintext)
+	})
+
+	t.Run("ciphertexts from previous keys still decrypt after rotation", func(t *testing.T) {
+		v1, v2 := newKey(), newKey()
+		before, _ := NewAESGCMKeyringEncryptor(map[string][]byte{"v1": v1}, "v1", nil)
+		after, _ := NewAESGCMKeyringEncryptor(map[string][]byte{"v1": v1, "v2": v2}, "v2", nil)
+
+		cyphertext, err := before.Encrypt(data, assocData)
+		require.NoError(t, err)
+
+		plaintext, err := after.Decrypt(cyphertext, assocData)
+		require.NoError(t, err)
+		require.Equal(t, data, plaintext)
+	})
+
+	t.Run("ciphertexts without header are decrypted with legacy key", func(t *testing.T) {
+		legacy := newKey()
+		legacyEncryptor, _ := NewAESGCMEncryptor(legacy)
+		encryptor, _ := NewAESGCMKeyringEncryptor(map[string][]byte{"v1": newKey()}, "v1", legacy)
+
+		cyphertext, err := legacyEncryptor.Encrypt(data, assocData)
+		require.NoError(t, err)
+		require.Empty(t, encryptor.(*AESGCMEncryptor).KeyID(cyphertext))
+
+		plaintext, err := encryptor.Decrypt(cyphertext, assocData)
+		require.NoError(t, err)
+		require.Equal(t, data, plaintext)
+	})
+
+	t.Run("ciphertexts without header fail without legacy key", func(t *testing.T) {
+		legacyEncryptor, _ := NewAESGCMEncryptor(newKey())
+		encryptor, _ := NewAESGCMKeyringEncryptor(map[string][]byte{"v1": newKey()}, "v1", nil)
+
+		cyphertext, err := legacyEncryptor.Encrypt(data, assocData)
+		require.NoError(t, err)
+
+		plaintext, err := encryptor.Decrypt(cyphertext, assocData)
+		require.Error(t, err)
+		require.Nil(t, plaintext)
+	})
+
+	t.Run("unknown key ID fails decryption", func(t *testing.T) {
+		encryptor1, _ := NewAESGCMKeyringEncryptor(map[string][]byte{"v1": newKey()}, "v1", nil)
+		encryptor2, _ := NewAESGCMKeyringEncryptor(map[string][]byte{"v2": newKey()}, "v2", nil)
+
+		cyphertext, err := encryptor1.Encrypt(data, assocData)
+		require.NoError(t, err)
+
+		plaintext, err := encryptor2.Decrypt(cyphertext, assocData)
+		require.ErrorContains(t, err, "unknown key v1")
+		require.Nil(t, plaintext)
+	})
+}
+
+func Test__ParseKeyring(t *testing.T) {
+	t.Run("parses id=key pairs", func(t *testing.T) {
+		keys, err := ParseKeyring("v1=" + base64.URLEncoding.EncodeToString([]byte("aaaa")) + ", v2=" + base64.URLEncoding.EncodeToString([]byte("bbbb")))
+		require.NoError(t, err)
+		require.Equal(t, map[string][]byte{"v1": []byte("aaaa"), "v2": []byte("bbbb")}, keys)
+	})
+
+	t.Run("duplicate key IDs fail", func(t *testing.T) {
+		_, err := ParseKeyring("v1=YWFhYQ==,v1=YmJiYg==")
+		require.Error(t, err)
+	})
+
+	t.Run("entries without ID fail", func(t *testing.T) {
+		_, err := ParseKeyring("YWFhYQ==")
+		require.Error(t, err)
+	})
+}
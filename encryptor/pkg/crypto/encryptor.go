@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"os"
+	"strings"
 )
 
 type Encryptor interface {
@@ -11,22 +12,149 @@ type Encryptor interface {
 	Decrypt(data []byte, associatedData []byte) ([]byte, error)
 }
 
+// KeyIdentifier is implemented by the encryptors that can tell
+// which key the ciphertexts they produce are encrypted with.
+type KeyIdentifier interface {
+	ActiveKeyID() string
+}
+
 func NewEncryptor(encryptorType string) (Encryptor, error) {
 	switch encryptorType {
 	case "no-op":
 		return NewNoOpEncryptor()
 
+	case "envelope":
+		return newEnvelopeEncryptorFromEnv()
+
 	default:
-		key := os.Getenv("ENCRYPTOR_AES_KEY")
-		if key == "" {
-			return nil, fmt.Errorf("ENCRYPTOR_AES_KEY is not set")
-		}
+		return newAESGCMEncryptorFromEnv()
+	}
+}
 
+// The AES keys are configured with:
+//   - ENCRYPTOR_AES_KEY: the key used before key versioning existed.
+//     Ciphertexts without a key ID header are decrypted with it.
+//   - ENCRYPTOR_AES_KEYS: comma-separated list of <id>=<base64 key> pairs.
+//   - ENCRYPTOR_AES_ACTIVE_KEY_ID: the ID of the key used to encrypt new data.
+//
+// If ENCRYPTOR_AES_KEYS is not set, ENCRYPTOR_AES_KEY is used for everything.
+func newAESGCMEncryptorFromEnv() (Encryptor, error) {
+	var legacyKey []byte
+	if key := os.Getenv("ENCRYPTOR_AES_KEY"); key != "" {
 		k, err := base64.URLEncoding.DecodeString(key)
 		if err != nil {
 			return nil, err
 		}
 
-		return NewAESGCMEncryptor(k)
+		legacyKey = k
+	}
+
+	keyring := os.Getenv("ENCRYPTOR_AES_KEYS")
+	if keyring == "" {
+		if legacyKey == nil {
+			return nil, fmt.Errorf("ENCRYPTOR_AES_KEY is not set")
+		}
+
+		return NewAESGCMEncryptor(legacyKey)
+	}
+
+	keys, err := ParseKeyring(keyring)
+	if err != nil {
+		return nil, err
+	}
+
+	activeKeyID := os.Getenv("ENCRYPTOR_AES_ACTIVE_KEY_ID")
+	if activeKeyID == "" {
+		return nil, fmt.Errorf("ENCRYPTOR_AES_ACTIVE_KEY_ID is not set")
+	}
+
+	return NewAESGCMKeyringEncryptor(keys, activeKeyID, legacyKey)
+}
+
+// ParseKeyring parses a comma-separated list of <id>=<base64 key> pairs.
+func ParseKeyring(keyring string) (map[string][]byte, error) {
+	keys := map[string][]byte{}
+	for _, pair := range strings.Split(keyring, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		id, encoded, found := strings.Cut(pair, "=")
+		if !found || id == "" {
+			return nil, fmt.Errorf("invalid keyring entry: expected <id>=<key>")
+		}
+
+		if _, exists := keys[id]; exists {
+			return nil, fmt.Errorf("duplicate key ID %s", id)
+		}
+
+		key, err := base64.URLEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %s: %v", id, err)
+		}
+
+		keys[id] = key
+	}
+
+	return keys, nil
+}
+
+// The envelope encryptor is configured with:
+//   - ENCRYPTOR_KEK_PROVIDER: "file" or "vault".
+//   - ENCRYPTOR_KEK_FILE: path to the file holding the base64 key, for the file provider.
+//   - ENCRYPTOR_VAULT_ADDR, ENCRYPTOR_VAULT_TRANSIT_MOUNT and ENCRYPTOR_VAULT_TRANSIT_KEY:
+//     where the Vault Transit key lives, for the vault provider.
+//   - ENCRYPTOR_VAULT_TOKEN_FILE or ENCRYPTOR_VAULT_TOKEN: the token used to talk to Vault.
+//
+// If the AES keys are still configured, they are used to decrypt
+// the values encrypted before switching to the envelope encryptor.
+func newEnvelopeEncryptorFromEnv() (Encryptor, error) {
+	provider, err := newKEKProviderFromEnv(os.Getenv("ENCRYPTOR_KEK_PROVIDER"))
+	if err != nil {
+		return nil, err
+	}
+
+	var fallback Encryptor
+	if os.Getenv("ENCRYPTOR_AES_KEY") != "" || os.Getenv("ENCRYPTOR_AES_KEYS") != "" {
+		fallback, err = newAESGCMEncryptorFromEnv()
+		if err != nil {
+			return nil, fmt.Errorf("error creating fallback AES encryptor: %v", err)
+		}
+	}
+
+	return NewEnvelopeEncryptorWithFallback(provider, fallback)
+}
+
+func newKEKProviderFromEnv(providerType string) (KEKProvider, error) {
+	switch providerType {
+	case "file":
+		path := os.Getenv("ENCRYPTOR_KEK_FILE")
+		if path == "" {
+			return nil, fmt.Errorf("ENCRYPTOR_KEK_FILE is not set")
+		}
+
+		return NewFileKEKProvider(path)
+
+	case "vault":
+		token := os.Getenv("ENCRYPTOR_VAULT_TOKEN")
+		if tokenFile := os.Getenv("ENCRYPTOR_VAULT_TOKEN_FILE"); tokenFile != "" {
+			content, err := os.ReadFile(tokenFile)
+			if err != nil {
+				return nil, fmt.Errorf("error reading vault token file: %v", err)
+			}
+
+			token = strings.TrimSpace(string(content))
+		}
+
+		return NewVaultKEKProvider(VaultKEKProviderOptions{
+			Address: os.Getenv("ENCRYPTOR_VAULT_ADDR"),
+			Token:   token,
+			Mount:   os.Getenv("ENCRYPTOR_VAULT_TRANSIT_MOUNT"),
+			KeyName: os.Getenv("ENCRYPTOR_VAULT_TRANSIT_KEY"),
+		})
+
+	default:
+		return nil, fmt.Errorf("unknown key-encryption-key provider %s", providerType)
 	}
 }
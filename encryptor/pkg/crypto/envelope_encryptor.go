@@ -0,0 +1,109 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+)
+
+// Envelope ciphertexts are:
+// magic + uint16(len(wrappedKey)) + wrappedKey + nonce + sealed data
+var envelopeHeaderMagic = []byte{'s', 'e', 'n', 0x01}
+
+const dataKeySize = 32
+
+// EnvelopeEncryptor encrypts every value with a freshly generated data key,
+// and stores the data key wrapped by the KEKProvider next to the ciphertext.
+//
+// Values encrypted before switching to envelope encryption don't have the envelope header.
+// Those are decrypted with the fallback encryptor, so they can still be read and reencrypted.
+type EnvelopeEncryptor struct {
+	provider KEKProvider
+	fallback Encryptor
+}
+
+func NewEnvelopeEncryptor(provider KEKProvider) (Encryptor, error) {
+	return NewEnvelopeEncryptorWithFallback(provider, nil)
+}
+
+func NewEnvelopeEncryptorWithFallback(provider KEKProvider, fallback Encryptor) (Encryptor, error) {
+	if provider == nil {
+		return nil, fmt.Errorf("no key-encryption-key provider")
+	}
+
+	return &EnvelopeEncryptor{provider: provider, fallback: fallback}, nil
+}
+
+func (e *EnvelopeEncryptor) Encrypt(data []byte, associatedData []byte) ([]byte, error) {
+	dataKey := make([]byte, dataKeySize)
+	_, err := rand.Read(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	wrappedKey, err := e.provider.WrapKey(dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("error wrapping data key: %v", err)
+	}
+
+	if len(wrappedKey) > 0xFFFF {
+		return nil, fmt.Errorf("wrapped data key is too long")
+	}
+
+	header := make([]byte, 0, len(envelopeHeaderMagic)+2+len(wrappedKey))
+	header = append(header, envelopeHeaderMagic...)
+	header = binary.BigEndian.AppendUint16(header, uint16(len(wrappedKey)))
+	header = append(header, wrappedKey...)
+	return seal(dataKey, header, data, associatedData)
+}
+
+// ActiveKeyID returns the ID of the key-encryption-key wrapping new data keys.
+func (e *EnvelopeEncryptor) ActiveKeyID() string {
+	return e.provider.KeyID()
+}
+
+func (e *EnvelopeEncryptor) Decrypt(cyphertext []byte, associatedData []byte) ([]byte, error) {
+	if !bytes.HasPrefix(cyphertext, envelopeHeaderMagic) {
+		if e.fallback != nil {
+			return e.fallback.Decrypt(cyphertext, associatedData)
+		}
+
+		return nil, fmt.Errorf("not an envelope ciphertext")
+	}
+
+	plaintext, err := e.decryptEnvelope(cyphertext, associatedData)
+	if err != nil {
+		// A ciphertext from the fallback could randomly start with the envelope magic,
+		// so we still try the fallback before giving up.
+		if e.fallback != nil {
+			if plaintext, fallbackErr := e.fallback.Decrypt(cyphertext, associatedData); fallbackErr == nil {
+				return plaintext, nil
+			}
+		}
+
+		return nil, err
+	}
+
+	return plaintext, nil
+}
+
+func (e *EnvelopeEncryptor) decryptEnvelope(cyphertext []byte, associatedData []byte) ([]byte, error) {
+	rest := cyphertext[len(envelopeHeaderMagic):]
+	if len(rest) < 2 {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+
+	wrappedKeyLength := int(binary.BigEndian.Uint16(rest))
+	rest = rest[2:]
+	if len(rest) < wrappedKeyLength {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+
+	dataKey, err := e.provider.UnwrapKey(rest[:wrappedKeyLength])
+	if err != nil {
+		return nil, fmt.Errorf("error unwrapping data key: %v", err)
+	}
+
+	return open(dataKey, rest[wrappedKeyLength:], associatedData)
+}
@@ -0,0 +1,194 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test__EnvelopeEncryptor(t *testing.T) {
+	newProvider := func() KEKProvider {
+		key := make([]byte, 32)
+		_, _ = rand.Read(key)
+		provider, err := NewLocalKEKProvider(key)
+		require.NoError(t, err)
+		return provider
+	}
+
+	data := []byte("testing encryption")
+	assocData := []byte("aaaa")
+
+	t.Run("no provider fails encryptor creation", func(t *testing.T) {
+		encryptor, err := NewEnvelopeEncryptor(nil)
+		require.Error(t, err)
+		require.Nil(t, encryptor)
+	})
+
+	t.Run("encrypts and decrypts properly", func(t *testing.T) {
+		encryptor, err := NewEnvelopeEncryptor(newProvider())
+		require.NoError(t, err)
+
+		cyphertext, err := encryptor.Encrypt(data, assocData)
+		require.NoError(t, err)
+		require.NotEmpty(t, cyphertext)
+
+		plaintext, err := encryptor.Decrypt(cyphertext, assocData)
+		require.NoError(t, err)
+		require.Equal(t, data, plaintext)
+	})
+
+	t.Run("every encryption uses a different data key", func(t *testing.T) {
+		encryptor, _ := NewEnvelopeEncryptor(newProvider())
+
+		c1, err := encryptor.Encrypt(data, assocData)
+		require.NoError(t, err)
+		c2, err := encryptor.Encrypt(data, assocData)
+		require.NoError(t, err)
+
+		k1, _, _ := splitEnvelope(t, c1)
+		k2, _, _ := splitEnvelope(t, c2)
+		require.NotEqual(t, k1, k2)
+	})
+
+	t.Run("decryption fails with wrong key-encryption-key", func(t *testing.T) {
+		encryptor1, _ := NewEnvelopeEncryptor(newProvider())
+		encryptor2, _ := NewEnvelopeEncryptor(newProvider())
+
+		cyphertext, err := encryptor1.Encrypt(data, assocData)
+		require.NoError(t, err)
+
+		plaintext, err := encryptor2.Decrypt(cyphertext, assocData)
+		require.Error(t, err)
+		require.Nil(t, plaintext)
+	})
+
+	t.Run("decryption fails with wrong associated data", func(t *testing.T) {
+		encryptor, _ := NewEnvelopeEncryptor(newProvider())
+
+		cyphertext, err := encryptor.Encrypt(data, assocData)
+		require.NoError(t, err)
+
+		plaintext, err := encryptor.Decrypt(cyphertext, []byte("bbbb"))
+		require.Error(t, err)
+		require.Nil(t, plaintext)
+	})
+
+	t.Run("decryption fails for truncated ciphertexts", func(t *testing.T) {
+		encryptor, _ := NewEnvelopeEncryptor(newProvider())
+
+		cyphertext, err := encryptor.Encrypt(data, assocData)
+		require.NoError(t, err)
+
+		for _, size := range []int{0, 3, 5, 10} {
+			plaintext, err := encryptor.Decrypt(cyphertext[:size], assocData)
+			require.Error(t, err)
+			require.Nil(t, plaintext)
+		}
+	})
+
+	t.Run("values encrypted before switching are decrypted with the fallback", func(t *testing.T) {
+		key := make([]byte, 32)
+		_, _ = rand.Read(key)
+		keyring, err := NewAESGCMKeyringEncryptor(map[string][]byte{"k1": key}, "k1", nil)
+		require.NoError(t, err)
+
+		oldCyphertext, err := keyring.Encrypt(data, assocData)
+		require.NoError(t, err)
+
+		withoutFallback, _ := NewEnvelopeEncryptor(newProvider())
+		_, err = withoutFallback.Decrypt(oldCyphertext, assocData)
+		require.ErrorContains(t, err, "not an envelope ciphertext")
+
+		encryptor, err := NewEnvelopeEncryptorWithFallback(newProvider(), keyring)
+		require.NoError(t, err)
+
+		plaintext, err := encryptor.Decrypt(oldCyphertext, assocData)
+		require.NoError(t, err)
+		require.Equal(t, data, plaintext)
+
+		// reencrypting moves the value to an envelope
+		newCyphertext, err := encryptor.Encrypt(plaintext, assocData)
+		require.NoError(t, err)
+		require.Equal(t, envelopeHeaderMagic, newCyphertext[:len(envelopeHeaderMagic)])
+
+		plaintext, err = encryptor.Decrypt(newCyphertext, assocData)
+		require.NoError(t, err)
+		require.Equal(t, data, plaintext)
+	})
+
+	t.Run("fallback values that look like envelopes are decrypted with the fallback", func(t *testing.T) {
+		key := make([]byte, 32)
+		_, _ = rand.Read(key)
+		legacy, err := NewAESGCMEncryptor(key)
+		require.NoError(t, err)
+
+		// legacy ciphertexts start with a random nonce, which can start with the envelope magic
+		nonce := make([]byte, 12)
+		_, _ = rand.Read(nonce)
+		copy(nonce, envelopeHeaderMagic)
+
+		block, err := aes.NewCipher(key)
+		require.NoError(t, err)
+		gcm, err := cipher.NewGCM(block)
+		require.NoError(t, err)
+		oldCyphertext := gcm.Seal(nonce, nonce, data, assocData)
+
+		encryptor, err := NewEnvelopeEncryptorWithFallback(newProvider(), legacy)
+		require.NoError(t, err)
+
+		plaintext, err := encryptor.Decrypt(oldCyphertext, assocData)
+		require.NoError(t, err)
+		require.Equal(t, data, plaintext)
+
+		// without it, the envelope error is returned
+		withoutFallback, _ := NewEnvelopeEncryptor(newProvider())
+		_, err = withoutFallback.Decrypt(oldCyphertext, assocData)
+		require.Error(t, err)
+	})
+}
+
+func Test__FileKEKProvider(t *testing.T) {
+	t.Run("reads key from file", func(t *testing.T) {
+		key := make([]byte, 32)
+		_, _ = rand.Read(key)
+		path := filepath.Join(t.TempDir(), "kek")
+		require.NoError(t, os.WriteFile(path, []byte(base64.URLEncoding.EncodeToString(key)+"\n"), 0600))
+
+		provider, err := NewFileKEKProvider(path)
+		require.NoError(t, err)
+
+		wrapped, err := provider.WrapKey([]byte("data-key"))
+		require.NoError(t, err)
+		unwrapped, err := provider.UnwrapKey(wrapped)
+		require.NoError(t, err)
+		require.Equal(t, []byte("data-key"), unwrapped)
+	})
+
+	t.Run("missing file fails", func(t *testing.T) {
+		provider, err := NewFileKEKProvider(filepath.Join(t.TempDir(), "does-not-exist"))
+		require.Error(t, err)
+		require.Nil(t, provider)
+	})
+
+	t.Run("invalid key size fails", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "kek")
+		require.NoError(t, os.WriteFile(path, []byte(base64.URLEncoding.EncodeToString([]byte("short"))), 0600))
+
+		provider, err := NewFileKEKProvider(path)
+		require.Error(t, err)
+		require.Nil(t, provider)
+	})
+}
+
+func splitEnvelope(t *testing.T, cyphertext []byte) ([]byte, []byte, []byte) {
+	require.Equal(t, envelopeHeaderMagic, cyphertext[:len(envelopeHeaderMagic)])
+	rest := cyphertext[len(envelopeHeaderMagic):]
+	size := int(rest[0])<<8 | int(rest[1])
+	return rest[2 : 2+size], rest[2+size : 2+size+12], rest[2+size+12:]
+}
@@ -0,0 +1,55 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// FileKEKProvider keeps the key-encryption-key in a local file,
+// similar to how a PKCS#11 token keeps it in a local device.
+// The file is expected to be mounted from a secret store,
+// and contains the base64-encoded key.
+type FileKEKProvider struct {
+	key []byte
+}
+
+func NewFileKEKProvider(path string) (KEKProvider, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading key file: %v", err)
+	}
+
+	key, err := base64.URLEncoding.DecodeString(strings.TrimSpace(string(content)))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding key file: %v", err)
+	}
+
+	return NewLocalKEKProvider(key)
+}
+
+func NewLocalKEKProvider(key []byte) (KEKProvider, error) {
+	switch len(key) {
+	case 16, 24, 32:
+		return &FileKEKProvider{key: key}, nil
+	default:
+		return nil, fmt.Errorf("invalid key size %d", len(key))
+	}
+}
+
+func (p *FileKEKProvider) WrapKey(dataKey []byte) ([]byte, error) {
+	return seal(p.key, nil, dataKey, nil)
+}
+
+// KeyID is a fingerprint of the key, so a new key file gets a new ID.
+func (p *FileKEKProvider) KeyID() string {
+	fingerprint := sha256.Sum256(p.key)
+	return "file:" + hex.EncodeToString(fingerprint[:8])
+}
+
+func (p *FileKEKProvider) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	return open(p.key, wrappedKey, nil)
+}
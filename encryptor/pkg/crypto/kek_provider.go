@@ -0,0 +1,9 @@
+package crypto
+
+// KEKProvider wraps and unwraps the data keys used by the EnvelopeEncryptor.
+// The key-encryption-key itself never leaves the provider, only an ID that identifies it.
+type KEKProvider interface {
+	WrapKey(dataKey []byte) ([]byte, error)
+	UnwrapKey(wrappedKey []byte) ([]byte, error)
+	KeyID() string
+}
@@ -0,0 +1,149 @@
+package crypto
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const defaultVaultTransitMount = "transit"
+
+// VaultKEKProvider wraps data keys using the HashiCorp Vault Transit secrets engine,
+// or any HTTP server that implements its encrypt/decrypt endpoints.
+type VaultKEKProvider struct {
+	address string
+	token   string
+	mount   string
+	keyName string
+	client  *http.Client
+}
+
+type VaultKEKProviderOptions struct {
+	Address string
+	Token   string
+	Mount   string
+	KeyName string
+	Timeout time.Duration
+}
+
+type vaultRequest struct {
+	Plaintext  string `json:"plaintext,omitempty"`
+	Ciphertext string `json:"ciphertext,omitempty"`
+}
+
+type vaultResponse struct {
+	Data struct {
+		Plaintext  string `json:"plaintext"`
+		Ciphertext string `json:"ciphertext"`
+	} `json:"data"`
+	Errors []string `json:"errors"`
+}
+
+func NewVaultKEKProvider(options VaultKEKProviderOptions) (KEKProvider, error) {
+	if options.Address == "" {
+		return nil, fmt.Errorf("vault address is required")
+	}
+
+	if options.Token == "" {
+		return nil, fmt.Errorf("vault token is required")
+	}
+
+	if options.KeyName == "" {
+		return nil, fmt.Errorf("vault transit key name is required")
+	}
+
+	mount := options.Mount
+	if mount == "" {
+		mount = defaultVaultTransitMount
+	}
+
+	timeout := options.Timeout
+	if timeout == 0 {
+		timeout = 10 * time.Second
+	}
+
+	return &VaultKEKProvider{
+		address: strings.TrimSuffix(options.Address, "/"),
+		token:   options.Token,
+		mount:   strings.Trim(mount, "/"),
+		keyName: options.KeyName,
+		client:  &http.Client{Timeout: timeout},
+	}, nil
+}
+
+func (p *VaultKEKProvider) WrapKey(dataKey []byte) ([]byte, error) {
+	response, err := p.do("encrypt", vaultRequest{
+		Plaintext: base64.StdEncoding.EncodeToString(dataKey),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if response.Data.Ciphertext == "" {
+		return nil, fmt.Errorf("vault returned empty ciphertext")
+	}
+
+	return []byte(response.Data.Ciphertext), nil
+}
+
+// KeyID is the transit key, Vault keeps track of its versions.
+func (p *VaultKEKProvider) KeyID() string {
+	return "vault:" + p.mount + "/" + p.keyName
+}
+
+func (p *VaultKEKProvider) UnwrapKey(wrappedKey []byte) ([]byte, error) {
+	response, err := p.do("decrypt", vaultRequest{
+		Ciphertext: string(wrappedKey),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return base64.StdEncoding.DecodeString(response.Data.Plaintext)
+}
+
+func (p *VaultKEKProvider) do(operation string, request vaultRequest) (*vaultResponse, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/v1/%s/%s/%s", p.address, p.mount, operation, p.keyName)
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-Vault-Token", p.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("vault %s request failed: %v", operation, err)
+	}
+
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	response := vaultResponse{}
+	if err := json.Unmarshal(resBody, &response); err != nil && res.StatusCode == http.StatusOK {
+		return nil, fmt.Errorf("invalid vault %s response: %v", operation, err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("vault %s request failed with status %d: %s", operation, res.StatusCode, strings.Join(response.Errors, ", "))
+	}
+
+	return &response, nil
+}
@@ -0,0 +1,131 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeVault is a minimal stand-in for the Vault Transit secrets engine.
+func fakeVault(t *testing.T, token, mount, keyName string) *httptest.Server {
+	key := make([]byte, 32)
+	_, _ = rand.Read(key)
+	local, err := NewLocalKEKProvider(key)
+	require.NoError(t, err)
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != token {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+
+		request := vaultRequest{}
+		_ = json.NewDecoder(r.Body).Decode(&request)
+
+		response := vaultResponse{}
+		switch r.URL.Path {
+		case "/v1/" + mount + "/encrypt/" + keyName:
+			plaintext, _ := base64.StdEncoding.DecodeString(request.Plaintext)
+			wrapped, _ := local.WrapKey(plaintext)
+			response.Data.Ciphertext = "vault:v1:" + base64.StdEncoding.EncodeToString(wrapped)
+
+		case "/v1/" + mount + "/decrypt/" + keyName:
+			wrapped, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(request.Ciphertext, "vault:v1:"))
+			plaintext, err := local.UnwrapKey(wrapped)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"errors":["cipher: message authentication failed"]}`))
+				return
+			}
+
+			response.Data.Plaintext = base64.StdEncoding.EncodeToString(plaintext)
+
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+}
+
+func Test__VaultKEKProvider(t *testing.T) {
+	server := fakeVault(t, "s.token", "transit", "semaphore")
+	defer server.Close()
+
+	t.Run("missing options fail provider creation", func(t *testing.T) {
+		_, err := NewVaultKEKProvider(VaultKEKProviderOptions{Token: "s.token", KeyName: "semaphore"})
+		require.Error(t, err)
+		_, err = NewVaultKEKProvider(VaultKEKProviderOptions{Address: server.URL, KeyName: "semaphore"})
+		require.Error(t, err)
+		_, err = NewVaultKEKProvider(VaultKEKProviderOptions{Address: server.URL, Token: "s.token"})
+		require.Error(t, err)
+	})
+
+	t.Run("wraps and unwraps data keys", func(t *testing.T) {
+		provider, err := NewVaultKEKProvider(VaultKEKProviderOptions{
+			Address: server.URL + "/",
+			Token:   "s.token",
+			KeyName: "semaphore",
+		})
+
+		require.NoError(t, err)
+
+		wrapped, err := provider.WrapKey([]byte("data-key"))
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(string(wrapped), "vault:v1:"))
+
+		unwrapped, err := provider.UnwrapKey(wrapped)
+		require.NoError(t, err)
+		require.Equal(t, []byte("data-key"), unwrapped)
+	})
+
+	t.Run("envelope encryptor works with vault provider", func(t *testing.T) {
+		provider, _ := NewVaultKEKProvider(VaultKEKProviderOptions{
+			Address: server.URL,
+			Token:   "s.token",
+			KeyName: "semaphore",
+		})
+
+		encryptor, err := NewEnvelopeEncryptor(provider)
+		require.NoError(t, err)
+
+		cyphertext, err := encryptor.Encrypt([]byte("testing encryption"), []byte("aaaa"))
+		require.NoError(t, err)
+
+		plaintext, err := encryptor.Decrypt(cyphertext, []byte("aaaa"))
+		require.NoError(t, err)
+		require.Equal(t, []byte("testing encryption"), plaintext)
+	})
+
+	t.Run("vault errors are returned", func(t *testing.T) {
+		provider, _ := NewVaultKEKProvider(VaultKEKProviderOptions{
+			Address: server.URL,
+			Token:   "wrong",
+			KeyName: "semaphore",
+		})
+
+		_, err := provider.WrapKey([]byte("data-key"))
+		require.ErrorContains(t, err, "permission denied")
+	})
+
+	t.Run("unknown transit key fails", func(t *testing.T) {
+		provider, _ := NewVaultKEKProvider(VaultKEKProviderOptions{
+			Address: server.URL,
+			Token:   "s.token",
+			Mount:   "transit",
+			KeyName: "other",
+		})
+
+		_, err := provider.WrapKey([]byte("data-key"))
+		require.ErrorContains(t, err, "status 404")
+	})
+}
@@ -19,8 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Encryptor_Encrypt_FullMethodName = "/InternalApi.Encryptor.Encryptor/Encrypt"
-	Encryptor_Decrypt_FullMethodName = "/InternalApi.Encryptor.Encryptor/Decrypt"
+	Encryptor_Encrypt_FullMethodName       = "/InternalApi.Encryptor.Encryptor/Encrypt"
+	Encryptor_Decrypt_FullMethodName       = "/InternalApi.Encryptor.Encryptor/Decrypt"
+	Encryptor_Reencrypt_FullMethodName     = "/InternalApi.Encryptor.Encryptor/Reencrypt"
+	Encryptor_EncryptBatch_FullMethodName  = "/InternalApi.Encryptor.Encryptor/EncryptBatch"
+	Encryptor_DecryptBatch_FullMethodName  = "/InternalApi.Encryptor.Encryptor/DecryptBatch"
+	Encryptor_EncryptStream_FullMethodName = "/InternalApi.Encryptor.Encryptor/EncryptStream"
+	Encryptor_DecryptStream_FullMethodName = "/InternalApi.Encryptor.Encryptor/DecryptStream"
 )
 
 // EncryptorClient is the client API for Encryptor service.
@@ -29,6 +34,14 @@ const (
 type EncryptorClient interface {
 	Encrypt(ctx context.Context, in *EncryptRequest, opts ...grpc.CallOption) (*EncryptResponse, error)
 	Decrypt(ctx context.Context, in *DecryptRequest, opts ...grpc.CallOption) (*DecryptResponse, error)
+	Reencrypt(ctx context.Context, in *ReencryptRequest, opts ...grpc.CallOption) (*ReencryptResponse, error)
+	EncryptBatch(ctx context.Context, in *EncryptBatchRequest, opts ...grpc.CallOption) (*EncryptBatchResponse, error)
+	DecryptBatch(ctx context.Context, in *DecryptBatchRequest, opts ...grpc.CallOption) (*DecryptBatchResponse, error)
+	// Streaming variants of the batch operations, for payloads
+	// which do not fit into a single message.
+	// One result is sent back for every item received, in the same order.
+	EncryptStream(ctx context.Context, opts ...grpc.CallOption) (Encryptor_EncryptStreamClient, error)
+	DecryptStream(ctx context.Context, opts ...grpc.CallOption) (Encryptor_DecryptStreamClient, error)
 }
 
 type encryptorClient struct {
@@ -57,12 +70,109 @@ func (c *encryptorClient) Decrypt(ctx context.Context, in *DecryptRequest, opts
 	return out, nil
 }
 
+func (c *encryptorClient) Reencrypt(ctx context.Context, in *ReencryptRequest, opts ...grpc.CallOption) (*ReencryptResponse, error) {
+	out := new(ReencryptResponse)
+	err := c.cc.Invoke(ctx, Encryptor_Reencrypt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *encryptorClient) EncryptBatch(ctx context.Context, in *EncryptBatchRequest, opts ...grpc.CallOption) (*EncryptBatchResponse, error) {
+	out := new(EncryptBatchResponse)
+	err := c.cc.Invoke(ctx, Encryptor_EncryptBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *encryptorClient) DecryptBatch(ctx context.Context, in *DecryptBatchRequest, opts ...grpc.CallOption) (*DecryptBatchResponse, error) {
+	out := new(DecryptBatchResponse)
+	err := c.cc.Invoke(ctx, Encryptor_DecryptBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *encryptorClient) EncryptStream(ctx context.Context, opts ...grpc.CallOption) (Encryptor_EncryptStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Encryptor_ServiceDesc.Streams[0], Encryptor_EncryptStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &encryptorEncryptStreamClient{stream}
+	return x, nil
+}
+
+type Encryptor_EncryptStreamClient interface {
+	Send(*EncryptBatchItem) error
+	Recv() (*EncryptBatchResult, error)
+	grpc.ClientStream
+}
+
+type encryptorEncryptStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *encryptorEncryptStreamClient) Send(m *EncryptBatchItem) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *encryptorEncryptStreamClient) Recv() (*EncryptBatchResult, error) {
+	m := new(EncryptBatchResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *encryptorClient) DecryptStream(ctx context.Context, opts ...grpc.CallOption) (Encryptor_DecryptStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Encryptor_ServiceDesc.Streams[1], Encryptor_DecryptStream_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &encryptorDecryptStreamClient{stream}
+	return x, nil
+}
+
+type Encryptor_DecryptStreamClient interface {
+	Send(*DecryptBatchItem) error
+	Recv() (*DecryptBatchResult, error)
+	grpc.ClientStream
+}
+
+type encryptorDecryptStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *encryptorDecryptStreamClient) Send(m *DecryptBatchItem) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *encryptorDecryptStreamClient) Recv() (*DecryptBatchResult, error) {
+	m := new(DecryptBatchResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // EncryptorServer is the server API for Encryptor service.
 // All implementations should embed UnimplementedEncryptorServer
 // for forward compatibility
 type EncryptorServer interface {
 	Encrypt(context.Context, *EncryptRequest) (*EncryptResponse, error)
 	Decrypt(context.Context, *DecryptRequest) (*DecryptResponse, error)
+	Reencrypt(context.Context, *ReencryptRequest) (*ReencryptResponse, error)
+	EncryptBatch(context.Context, *EncryptBatchRequest) (*EncryptBatchResponse, error)
+	DecryptBatch(context.Context, *DecryptBatchRequest) (*DecryptBatchResponse, error)
+	// Streaming variants of the batch operations, for payloads
+	// which do not fit into a single message.
+	// One result is sent back for every item received, in the same order.
+	EncryptStream(Encryptor_EncryptStreamServer) error
+	DecryptStream(Encryptor_DecryptStreamServer) error
 }
 
 // UnimplementedEncryptorServer should be embedded to have forward compatible implementations.
@@ -75,6 +185,21 @@ func (UnimplementedEncryptorServer) Encrypt(context.Context, *EncryptRequest) (*
 func (UnimplementedEncryptorServer) Decrypt(context.Context, *DecryptRequest) (*DecryptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Decrypt not implemented")
 }
+func (UnimplementedEncryptorServer) Reencrypt(context.Context, *ReencryptRequest) (*ReencryptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reencrypt not implemented")
+}
+func (UnimplementedEncryptorServer) EncryptBatch(context.Context, *EncryptBatchRequest) (*EncryptBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptBatch not implemented")
+}
+func (UnimplementedEncryptorServer) DecryptBatch(context.Context, *DecryptBatchRequest) (*DecryptBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecryptBatch not implemented")
+}
+func (UnimplementedEncryptorServer) EncryptStream(Encryptor_EncryptStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method EncryptStream not implemented")
+}
+func (UnimplementedEncryptorServer) DecryptStream(Encryptor_DecryptStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method DecryptStream not implemented")
+}
 
 // UnsafeEncryptorServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to EncryptorServer will
@@ -123,6 +248,112 @@ func _Encryptor_Decrypt_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Encryptor_Reencrypt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReencryptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EncryptorServer).Reencrypt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Encryptor_Reencrypt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EncryptorServer).Reencrypt(ctx, req.(*ReencryptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Encryptor_EncryptBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncryptBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EncryptorServer).EncryptBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Encryptor_EncryptBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EncryptorServer).EncryptBatch(ctx, req.(*EncryptBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Encryptor_DecryptBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecryptBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EncryptorServer).DecryptBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Encryptor_DecryptBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EncryptorServer).DecryptBatch(ctx, req.(*DecryptBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Encryptor_EncryptStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EncryptorServer).EncryptStream(&encryptorEncryptStreamServer{stream})
+}
+
+type Encryptor_EncryptStreamServer interface {
+	Send(*EncryptBatchResult) error
+	Recv() (*EncryptBatchItem, error)
+	grpc.ServerStream
+}
+
+type encryptorEncryptStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *encryptorEncryptStreamServer) Send(m *EncryptBatchResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *encryptorEncryptStreamServer) Recv() (*EncryptBatchItem, error) {
+	m := new(EncryptBatchItem)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Encryptor_DecryptStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EncryptorServer).DecryptStream(&encryptorDecryptStreamServer{stream})
+}
+
+type Encryptor_DecryptStreamServer interface {
+	Send(*DecryptBatchResult) error
+	Recv() (*DecryptBatchItem, error)
+	grpc.ServerStream
+}
+
+type encryptorDecryptStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *encryptorDecryptStreamServer) Send(m *DecryptBatchResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *encryptorDecryptStreamServer) Recv() (*DecryptBatchItem, error) {
+	m := new(DecryptBatchItem)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Encryptor_ServiceDesc is the grpc.ServiceDesc for Encryptor service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -138,7 +369,32 @@ var Encryptor_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Decrypt",
 			Handler:    _Encryptor_Decrypt_Handler,
 		},
+		{
+			MethodName: "Reencrypt",
+			Handler:    _Encryptor_Reencrypt_Handler,
+		},
+		{
+			MethodName: "EncryptBatch",
+			Handler:    _Encryptor_EncryptBatch_Handler,
+		},
+		{
+			MethodName: "DecryptBatch",
+			Handler:    _Encryptor_DecryptBatch_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "EncryptStream",
+			Handler:       _Encryptor_EncryptStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DecryptStream",
+			Handler:       _Encryptor_DecryptStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "encryptor.proto",
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -12,6 +13,7 @@ import (
 	"github.com/semaphoreio/semaphore/loghub2/pkg/amqp"
 	"github.com/semaphoreio/semaphore/loghub2/pkg/internalapi"
 	"github.com/semaphoreio/semaphore/loghub2/pkg/publicapi"
+	"github.com/semaphoreio/semaphore/loghub2/pkg/retention"
 	"github.com/semaphoreio/semaphore/loghub2/pkg/storage"
 	"github.com/semaphoreio/semaphore/loghub2/pkg/utils"
 	"github.com/semaphoreio/semaphore/loghub2/pkg/workers/jobdeletion"
@@ -45,10 +47,10 @@ func startPublicAPI(redisStorage *storage.RedisStorage, cloudStorage storage.Sto
 	}
 }
 
-func startInternalAPI() {
+func startInternalAPI(redisStorage *storage.RedisStorage, cloudStorage storage.Storage) {
 	log.Println("Starting Internal API")
 	privateKey := utils.AssertEnvVar("LOGHUB2_PRIVATE_KEY")
-	internalapi.RunServer(50051, privateKey)
+	internalapi.RunServer(50051, privateKey, redisStorage, cloudStorage)
 }
 
 func configureWatchman() {
@@ -144,16 +146,33 @@ func jobDeletionWorker(cloudStorage storage.Storage) {
 	}
 }
 
+func startRetentionSweeper(redisStorage *storage.RedisStorage, cloudStorage storage.Storage) {
+	log.Println("Starting retention sweeper...")
+
+	interval := retention.DefaultSweepInterval
+	if value := os.Getenv("RETENTION_SWEEPER_INTERVAL"); value != "" {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			log.Fatalf("Invalid RETENTION_SWEEPER_INTERVAL '%s': %v", value, err)
+		}
+
+		interval = d
+	}
+
+	retention.NewSweeper(redisStorage, cloudStorage).Start(context.Background(), interval)
+}
+
 func createRedisStorage() *storage.RedisStorage {
 	host := utils.AssertEnvVar("REDIS_HOST")
 	port := utils.AssertEnvVar("REDIS_PORT")
 	username := os.Getenv("REDIS_USERNAME")
 	password := os.Getenv("REDIS_PASSWORD")
 	redisStorage := storage.NewRedisStorage(storage.RedisConfig{
-		Address:  host,
-		Port:     port,
-		Username: username,
-		Password: password,
+		Address:    host,
+		Port:       port,
+		Username:   username,
+		Password:   password,
+		SecretsKey: os.Getenv("LOGHUB2_PRIVATE_KEY"),
 	})
 
 	err := utils.RetryWithConstantWait("Redis connection", ConnectionRetries, ConnectionRetryWait*time.Second, func() error {
@@ -168,16 +187,12 @@ func createRedisStorage() *storage.RedisStorage {
 }
 
 func shouldInitializeStorages() bool {
-	return os.Getenv("START_PUBLIC_API") == "yes" || os.Getenv("START_ARCHIVATOR") == "yes" || os.Getenv("START_JOB_DELETION_WORKER") == "yes"
+	return os.Getenv("START_INTERNAL_API") == "yes" || os.Getenv("START_PUBLIC_API") == "yes" || os.Getenv("START_ARCHIVATOR") == "yes" || os.Getenv("START_JOB_DELETION_WORKER") == "yes" || os.Getenv("START_RETENTION_SWEEPER") == "yes"
 }
 
 func main() {
 	configureWatchman()
 
-	if os.Getenv("START_INTERNAL_API") == "yes" {
-		go startInternalAPI()
-	}
-
 	var cloudStorage storage.Storage
 	var redisStorage *storage.RedisStorage
 	var err error
@@ -191,6 +206,10 @@ func main() {
 		}
 	}
 
+	if os.Getenv("START_INTERNAL_API") == "yes" {
+		go startInternalAPI(redisStorage, cloudStorage)
+	}
+
 	if os.Getenv("START_PUBLIC_API") == "yes" {
 		go startPublicAPI(redisStorage, cloudStorage)
 	}
@@ -203,6 +222,10 @@ func main() {
 		go jobDeletionWorker(cloudStorage)
 	}
 
+	if os.Getenv("START_RETENTION_SWEEPER") == "yes" {
+		startRetentionSweeper(redisStorage, cloudStorage)
+	}
+
 	log.Println("loghub2 is UP.")
 	select {}
 }
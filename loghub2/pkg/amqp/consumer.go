@@ -73,12 +73,12 @@ func (c *AmqpConsumer) processMessage(delivery tackle.Delivery) error {
 		return nil
 	}
 
-	compressedFileName, err := compressLogs(ctx, fileName)
+	compressedFileName, index, err := compressLogs(ctx, fileName)
 	if err != nil {
 		return fmt.Errorf("error compressing logs for %s: %v", jobId, err)
 	}
 
-	err = c.saveInCloudStorage(ctx, compressedFileName, jobId)
+	err = c.saveInCloudStorage(ctx, compressedFileName, index, jobId)
 	if err != nil {
 		_ = watchman.External().IncrementWithTags("export", []string{"result", "failure"})
 		return fmt.Errorf("error saving logs for %s in cloud storage: %v", jobId, err)
@@ -94,6 +94,23 @@ func (c *AmqpConsumer) processMessage(delivery tackle.Delivery) error {
 		return fmt.Errorf("error deleting logs for %s from Redis: %v", jobId, err)
 	}
 
+	err = c.redisStorage.DeleteJobSecrets(ctx, jobId)
+	if err != nil {
+		log.Printf("Error deleting secrets for %s from Redis: %v", jobId, err)
+	}
+
+	err = c.redisStorage.DeleteJobMetadata(ctx, jobId)
+	if err != nil {
+		log.Printf("Error deleting metadata for %s from Redis: %v", jobId, err)
+	}
+
+	// Let live log streams know they should
+	// now look for the logs in cloud storage.
+	err = c.redisStorage.PublishLogEvent(ctx, jobId, storage.LogEventArchived)
+	if err != nil {
+		log.Printf("Error publishing archived event for %s: %v", jobId, err)
+	}
+
 	publishProcessingDelayMetric(jobFinished.GetTimestamp())
 	return nil
 }
@@ -108,11 +125,11 @@ func publishProcessingDelayMetric(finishedAt *timestamppb.Timestamp) {
 	}
 }
 
-func compressLogs(ctx context.Context, fileName string) (string, error) {
+func compressLogs(ctx context.Context, fileName string) (string, *storage.ArchiveIndex, error) {
 	// #nosec
 	fileInfo, err := os.Stat(fileName)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	err = watchman.Submit("log.uncompressed", int(fileInfo.Size()))
@@ -121,17 +138,15 @@ func compressLogs(ctx context.Context, fileName string) (string, error) {
 	}
 
 	log.Printf("Compressing %s before uploading", fileName)
-	err = storage.Gzip(ctx, fileName)
+	gzippedFileName, index, err := storage.GzipChunked(ctx, fileName, storage.DefaultLinesPerChunk)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
-	gzippedFileName := fmt.Sprintf("%s.gz", fileName)
-
 	// #nosec
 	gzippedFileInfo, err := os.Stat(gzippedFileName)
 	if err != nil {
-		return "", err
+		return "", nil, err
 	}
 
 	err = watchman.Submit("log.compressed", int(gzippedFileInfo.Size()))
@@ -139,19 +154,48 @@ func compressLogs(ctx context.Context, fileName string) (string, error) {
 		log.Printf("Error submitting metrics: %v", err)
 	}
 
-	return gzippedFileName, nil
+	return gzippedFileName, index, nil
 }
 
-func (c *AmqpConsumer) saveInCloudStorage(ctx context.Context, fileName, jobId string) error {
+// The index is uploaded after the logs themselves.
+// If we fail to upload it, the logs can still be read, just not in ranges.
+func (c *AmqpConsumer) saveInCloudStorage(ctx context.Context, fileName string, index *storage.ArchiveIndex, jobId string) error {
 	err := c.cloudStorage.SaveFile(ctx, fileName, jobId)
 	if err != nil {
 		return err
 	}
 
+	err = storage.SaveArchiveIndex(ctx, c.cloudStorage, jobId, index)
+	if err != nil {
+		log.Printf("Error saving logs index for %s in cloud storage: %v", jobId, err)
+	}
+
+	c.saveMetadataInCloudStorage(ctx, jobId)
+
 	log.Printf("Saved logs for %s in cloud storage", jobId)
 	return nil
 }
 
+// Retention policies are only applied to logs archived with metadata.
+// Without it, the logs are kept until the job is deleted.
+func (c *AmqpConsumer) saveMetadataInCloudStorage(ctx context.Context, jobId string) {
+	metadata, err := c.redisStorage.FindJobMetadata(ctx, jobId)
+	if err != nil {
+		log.Printf("Error getting metadata for %s from Redis: %v", jobId, err)
+		return
+	}
+
+	if metadata == nil {
+		return
+	}
+
+	metadata.ArchivedAt = time.Now()
+	err = storage.SaveArchivedJobMetadata(ctx, c.cloudStorage, metadata)
+	if err != nil {
+		log.Printf("Error saving metadata for %s in cloud storage: %v", jobId, err)
+	}
+}
+
 func (c *AmqpConsumer) ExecuteWhenMessageIsDead(delivery tackle.Delivery) {
 	jobFinished := &protos.JobFinished{}
 	if err := proto.Unmarshal(delivery.Body(), jobFinished); err != nil {
@@ -162,5 +206,21 @@ func (c *AmqpConsumer) ExecuteWhenMessageIsDead(delivery tackle.Delivery) {
 	_, err := c.redisStorage.DeleteLogs(context.Background(), jobFinished.JobId)
 	if err != nil {
 		log.Printf("Error deleting logs for %s from Redis: %v", jobFinished.JobId, err)
+		return
+	}
+
+	err = c.redisStorage.DeleteJobSecrets(context.Background(), jobFinished.JobId)
+	if err != nil {
+		log.Printf("Error deleting secrets for %s from Redis: %v", jobFinished.JobId, err)
+	}
+
+	err = c.redisStorage.DeleteJobMetadata(context.Background(), jobFinished.JobId)
+	if err != nil {
+		log.Printf("Error deleting metadata for %s from Redis: %v", jobFinished.JobId, err)
+	}
+
+	err = c.redisStorage.PublishLogEvent(context.Background(), jobFinished.JobId, storage.LogEventArchived)
+	if err != nil {
+		log.Printf("Error publishing archived event for %s: %v", jobFinished.JobId, err)
 	}
 }
@@ -2,20 +2,32 @@ package internalapi
 
 import (
 	"context"
+	"errors"
 	"log"
 	"time"
 
 	"github.com/renderedtext/go-watchman"
 	auth "github.com/semaphoreio/semaphore/loghub2/pkg/auth"
 	pb "github.com/semaphoreio/semaphore/loghub2/pkg/protos/loghub2"
+	"github.com/semaphoreio/semaphore/loghub2/pkg/redaction"
+	"github.com/semaphoreio/semaphore/loghub2/pkg/retention"
+	"github.com/semaphoreio/semaphore/loghub2/pkg/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type Loghub2Service struct {
-	privateKey string
+	privateKey   string
+	redisStorage *storage.RedisStorage
+	cloudStorage storage.Storage
 }
 
-func NewLoghub2Service(privateKey string) *Loghub2Service {
-	return &Loghub2Service{privateKey: privateKey}
+func NewLoghub2Service(privateKey string, redisStorage *storage.RedisStorage, cloudStorage storage.Storage) *Loghub2Service {
+	return &Loghub2Service{
+		privateKey:   privateKey,
+		redisStorage: redisStorage,
+		cloudStorage: cloudStorage,
+	}
 }
 
 func (s *Loghub2Service) GenerateToken(ctx context.Context, request *pb.GenerateTokenRequest) (*pb.GenerateTokenResponse, error) {
@@ -40,3 +52,210 @@ func (s *Loghub2Service) GenerateToken(ctx context.Context, request *pb.Generate
 
 	return response, nil
 }
+
+func (s *Loghub2Service) SearchLogs(request *pb.SearchLogsRequest, stream pb.Loghub2_SearchLogsServer) error {
+	defer watchman.Benchmark(time.Now(), "logs.search.grpc")
+
+	if request.GetJobId() == "" {
+		return status.Error(codes.InvalidArgument, "job_id is required")
+	}
+
+	options := storage.SearchOptions{
+		Query:        request.GetQuery(),
+		Regex:        request.GetRegex(),
+		IgnoreCase:   request.GetIgnoreCase(),
+		ContextLines: int(request.GetContextLines()),
+		Limit:        int(request.GetLimit()),
+	}
+
+	if err := options.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	result, err := storage.SearchJobLogs(stream.Context(), s.redisStorage, s.cloudStorage, request.GetJobId(), options, func(match *storage.SearchMatch) error {
+		return stream.Send(&pb.SearchLogsResponse{
+			Match:  toLogEvent(match.SearchLine),
+			Before: toLogEvents(match.Before),
+			After:  toLogEvents(match.After),
+		})
+	})
+
+	if err != nil {
+		if errors.Is(err, storage.ErrLogsNotFound) {
+			return status.Errorf(codes.NotFound, "logs for %s not found", request.GetJobId())
+		}
+
+		log.Printf("Error searching logs for %s: %v", request.GetJobId(), err)
+		return status.Error(codes.Internal, "error searching logs")
+	}
+
+	return stream.Send(&pb.SearchLogsResponse{
+		Summary: &pb.SearchLogsSummary{
+			// #nosec
+			Matches:   uint32(result.Matches),
+			Truncated: result.Truncated,
+		},
+	})
+}
+
+func toLogEvent(line storage.SearchLine) *pb.LogEvent {
+	return &pb.LogEvent{Token: line.Token, Event: string(line.Event)}
+}
+
+func toLogEvents(lines []storage.SearchLine) []*pb.LogEvent {
+	events := make([]*pb.LogEvent, 0, len(lines))
+	for _, line := range lines {
+		events = append(events, toLogEvent(line))
+	}
+
+	return events
+}
+
+// RegisterSecrets saves values to be masked in the logs of a job.
+// Only logs received after the values are registered are redacted.
+func (s *Loghub2Service) RegisterSecrets(ctx context.Context, request *pb.RegisterSecretsRequest) (*pb.RegisterSecretsResponse, error) {
+	defer watchman.Benchmark(time.Now(), "secrets.register")
+
+	if request.GetJobId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "job_id is required")
+	}
+
+	values := []string{}
+	for _, value := range request.GetValues() {
+		if len(value) >= redaction.MinSecretLength {
+			values = append(values, value)
+		}
+	}
+
+	err := s.redisStorage.SaveJobSecrets(ctx, request.GetJobId(), values)
+	if err != nil {
+		log.Printf("Error registering secrets for %s: %v", request.GetJobId(), err)
+		return nil, status.Error(codes.Internal, "error registering secrets")
+	}
+
+	return &pb.RegisterSecretsResponse{}, nil
+}
+
+// RedactArchivedLogs masks secrets in logs that were already archived.
+func (s *Loghub2Service) RedactArchivedLogs(ctx context.Context, request *pb.RedactArchivedLogsRequest) (*pb.RedactArchivedLogsResponse, error) {
+	if request.GetJobId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "job_id is required")
+	}
+
+	exists, err := s.cloudStorage.Exists(ctx, request.GetJobId())
+	if !exists || err != nil {
+		return nil, status.Errorf(codes.NotFound, "logs for %s not found", request.GetJobId())
+	}
+
+	values, err := s.redisStorage.GetJobSecrets(ctx, request.GetJobId())
+	if err != nil {
+		log.Printf("Error getting secrets for %s: %v", request.GetJobId(), err)
+		return nil, status.Error(codes.Internal, "error getting secrets")
+	}
+
+	values = append(values, request.GetValues()...)
+	redacted, err := redaction.RedactArchivedLogs(ctx, s.cloudStorage, request.GetJobId(), redaction.NewRedactor(values))
+	if err != nil {
+		log.Printf("Error redacting archived logs for %s: %v", request.GetJobId(), err)
+		return nil, status.Error(codes.Internal, "error redacting logs")
+	}
+
+	// #nosec
+	return &pb.RedactArchivedLogsResponse{RedactedEvents: uint32(redacted)}, nil
+}
+
+// SetJobMetadata saves what retention policies need to know about a job.
+// It is archived together with the logs when the job finishes.
+func (s *Loghub2Service) SetJobMetadata(ctx context.Context, request *pb.SetJobMetadataRequest) (*pb.SetJobMetadataResponse, error) {
+	if request.GetJobId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "job_id is required")
+	}
+
+	if request.GetOrganizationId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
+	}
+
+	err := s.redisStorage.SaveJobMetadata(ctx, &storage.JobMetadata{
+		JobID:           request.GetJobId(),
+		OrgID:           request.GetOrganizationId(),
+		ProjectID:       request.GetProjectId(),
+		Branch:          request.GetBranch(),
+		ProtectedBranch: request.GetProtectedBranch(),
+	})
+
+	if err != nil {
+		log.Printf("Error saving metadata for %s: %v", request.GetJobId(), err)
+		return nil, status.Error(codes.Internal, "error saving job metadata")
+	}
+
+	return &pb.SetJobMetadataResponse{}, nil
+}
+
+func (s *Loghub2Service) SetRetentionPolicy(ctx context.Context, request *pb.SetRetentionPolicyRequest) (*pb.SetRetentionPolicyResponse, error) {
+	if request.GetOrganizationId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
+	}
+
+	if request.GetPolicy() == nil {
+		return nil, status.Error(codes.InvalidArgument, "policy is required")
+	}
+
+	policy := fromPolicyProto(request.GetPolicy())
+	if err := policy.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err := retention.SavePolicy(ctx, s.cloudStorage, request.GetOrganizationId(), policy)
+	if err != nil {
+		log.Printf("Error saving retention policy for %s: %v", request.GetOrganizationId(), err)
+		return nil, status.Error(codes.Internal, "error saving retention policy")
+	}
+
+	return &pb.SetRetentionPolicyResponse{Policy: toPolicyProto(policy)}, nil
+}
+
+func (s *Loghub2Service) GetRetentionPolicy(ctx context.Context, request *pb.GetRetentionPolicyRequest) (*pb.GetRetentionPolicyResponse, error) {
+	if request.GetOrganizationId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "organization_id is required")
+	}
+
+	policy, err := retention.FindPolicy(ctx, s.cloudStorage, request.GetOrganizationId())
+	if err != nil {
+		log.Printf("Error finding retention policy for %s: %v", request.GetOrganizationId(), err)
+		return nil, status.Error(codes.Internal, "error finding retention policy")
+	}
+
+	if policy == nil {
+		return &pb.GetRetentionPolicyResponse{}, nil
+	}
+
+	return &pb.GetRetentionPolicyResponse{Policy: toPolicyProto(policy)}, nil
+}
+
+func fromPolicyProto(policy *pb.RetentionPolicy) *retention.Policy {
+	rules := make([]retention.Rule, 0, len(policy.GetRules()))
+	for _, rule := range policy.GetRules() {
+		rules = append(rules, retention.Rule{
+			ProjectID:     rule.GetProjectId(),
+			Branch:        rule.GetBranch(),
+			ProtectedOnly: rule.GetProtectedOnly(),
+			Age:           rule.GetAge(),
+		})
+	}
+
+	return &retention.Policy{Rules: rules, DefaultAge: policy.GetDefaultAge()}
+}
+
+func toPolicyProto(policy *retention.Policy) *pb.RetentionPolicy {
+	rules := make([]*pb.RetentionPolicyRule, 0, len(policy.Rules))
+	for _, rule := range policy.Rules {
+		rules = append(rules, &pb.RetentionPolicyRule{
+			ProjectId:     rule.ProjectID,
+			Branch:        rule.Branch,
+			ProtectedOnly: rule.ProtectedOnly,
+			Age:           rule.Age,
+		})
+	}
+
+	return &pb.RetentionPolicy{Rules: rules, DefaultAge: policy.DefaultAge}
+}
@@ -4,12 +4,19 @@ import (
 	"context"
 	"testing"
 
+	"github.com/google/uuid"
 	pb "github.com/semaphoreio/semaphore/loghub2/pkg/protos/loghub2"
+	"github.com/semaphoreio/semaphore/loghub2/pkg/storage"
 	assert "github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 func Test__GeneratesTokenToPull(t *testing.T) {
-	service := NewLoghub2Service("my-private-key")
+	service := NewLoghub2Service("my-private-key", nil, nil)
 	response, err := service.GenerateToken(context.Background(), &pb.GenerateTokenRequest{
 		JobId:    "job1",
 		Type:     pb.TokenType_PULL,
@@ -25,7 +32,7 @@ func Test__GeneratesTokenToPull(t *testing.T) {
 }
 
 func Test__GeneratesTokenToPush(t *testing.T) {
-	service := NewLoghub2Service("my-private-key")
+	service := NewLoghub2Service("my-private-key", nil, nil)
 	response, err := service.GenerateToken(context.Background(), &pb.GenerateTokenRequest{
 		JobId:    "job1",
 		Type:     pb.TokenType_PUSH,
@@ -41,7 +48,7 @@ func Test__GeneratesTokenToPush(t *testing.T) {
 }
 
 func Test__GeneratesTokenToPullAsDefault(t *testing.T) {
-	service := NewLoghub2Service("my-private-key")
+	service := NewLoghub2Service("my-private-key", nil, nil)
 	response, err := service.GenerateToken(context.Background(), &pb.GenerateTokenRequest{
 		JobId:    "job1",
 		Duration: 60,
@@ -54,3 +61,158 @@ func Test__GeneratesTokenToPullAsDefault(t *testing.T) {
 		assert.NotEmpty(t, response.GetToken())
 	}
 }
+
+type searchLogsStream struct {
+	grpc.ServerStream
+	responses []*pb.SearchLogsResponse
+}
+
+func (s *searchLogsStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *searchLogsStream) Send(response *pb.SearchLogsResponse) error {
+	s.responses = append(s.responses, response)
+	return nil
+}
+
+func Test__SearchLogs(t *testing.T) {
+	redisStorage := storage.NewRedisStorage(storage.RedisConfig{Address: "redis", Port: "6379"})
+	service := NewLoghub2Service("my-private-key", redisStorage, nil)
+
+	jobId := uuid.NewString()
+	err := redisStorage.AppendLogs(jobId, 0, []string{
+		`{"event":"cmd_started","directive":"make test"}`,
+		`{"event":"cmd_output","output":"ok\n"}`,
+		`{"event":"cmd_output","output":"FAIL: Test__Something\n"}`,
+		`{"event":"cmd_finished","directive":"make test"}`,
+	})
+
+	require.NoError(t, err)
+	defer redisStorage.DeleteLogs(context.Background(), jobId)
+
+	t.Run("missing job_id", func(t *testing.T) {
+		err := service.SearchLogs(&pb.SearchLogsRequest{Query: "FAIL"}, &searchLogsStream{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("bad regex", func(t *testing.T) {
+		err := service.SearchLogs(&pb.SearchLogsRequest{JobId: jobId, Query: "FAIL[", Regex: true}, &searchLogsStream{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("matches are streamed", func(t *testing.T) {
+		stream := &searchLogsStream{}
+		err := service.SearchLogs(&pb.SearchLogsRequest{JobId: jobId, Query: "fail", IgnoreCase: true, ContextLines: 1}, stream)
+		require.NoError(t, err)
+		require.Len(t, stream.responses, 2)
+
+		match := stream.responses[0]
+		assert.Equal(t, int64(2), match.Match.Token)
+		assert.Equal(t, `{"event":"cmd_output","output":"FAIL: Test__Something\n"}`, match.Match.Event)
+		require.Len(t, match.Before, 1)
+		assert.Equal(t, int64(1), match.Before[0].Token)
+		require.Len(t, match.After, 1)
+		assert.Equal(t, int64(3), match.After[0].Token)
+		assert.Nil(t, match.Summary)
+
+		summary := stream.responses[1]
+		assert.Nil(t, summary.Match)
+		assert.Equal(t, uint32(1), summary.Summary.Matches)
+		assert.False(t, summary.Summary.Truncated)
+	})
+}
+
+func Test__RegisterSecrets(t *testing.T) {
+	redisStorage := storage.NewRedisStorage(storage.RedisConfig{Address: "redis", Port: "6379", SecretsKey: "my-private-key"})
+	service := NewLoghub2Service("my-private-key", redisStorage, nil)
+
+	t.Run("missing job_id", func(t *testing.T) {
+		_, err := service.RegisterSecrets(context.Background(), &pb.RegisterSecretsRequest{Values: []string{"s3cr3t"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("short values are ignored", func(t *testing.T) {
+		jobId := uuid.NewString()
+		_, err := service.RegisterSecrets(context.Background(), &pb.RegisterSecretsRequest{
+			JobId:  jobId,
+			Values: []string{"s3cr3t", "abc", "other-s3cr3t"},
+		})
+
+		require.NoError(t, err)
+		defer redisStorage.DeleteJobSecrets(context.Background(), jobId)
+
+		values, err := redisStorage.GetJobSecrets(context.Background(), jobId)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"s3cr3t", "other-s3cr3t"}, values)
+	})
+}
+
+func Test__SetJobMetadata(t *testing.T) {
+	redisStorage := storage.NewRedisStorage(storage.RedisConfig{Address: "redis", Port: "6379"})
+	service := NewLoghub2Service("my-private-key", redisStorage, nil)
+
+	t.Run("missing organization_id", func(t *testing.T) {
+		_, err := service.SetJobMetadata(context.Background(), &pb.SetJobMetadataRequest{JobId: uuid.NewString()})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("metadata is saved", func(t *testing.T) {
+		jobId := uuid.NewString()
+		_, err := service.SetJobMetadata(context.Background(), &pb.SetJobMetadataRequest{
+			JobId:           jobId,
+			OrganizationId:  "org-1",
+			ProjectId:       "project-1",
+			Branch:          "main",
+			ProtectedBranch: true,
+		})
+
+		require.NoError(t, err)
+		defer redisStorage.DeleteJobMetadata(context.Background(), jobId)
+
+		metadata, err := redisStorage.FindJobMetadata(context.Background(), jobId)
+		require.NoError(t, err)
+		assert.Equal(t, &storage.JobMetadata{
+			JobID:           jobId,
+			OrgID:           "org-1",
+			ProjectID:       "project-1",
+			Branch:          "main",
+			ProtectedBranch: true,
+		}, metadata)
+	})
+}
+
+func Test__RetentionPolicy(t *testing.T) {
+	redisStorage := storage.NewRedisStorage(storage.RedisConfig{Address: "redis", Port: "6379"})
+	service := NewLoghub2Service("my-private-key", redisStorage, storage.NewMemoryStorage())
+	orgId := uuid.NewString()
+
+	t.Run("no policy", func(t *testing.T) {
+		response, err := service.GetRetentionPolicy(context.Background(), &pb.GetRetentionPolicyRequest{OrganizationId: orgId})
+		require.NoError(t, err)
+		assert.Nil(t, response.Policy)
+	})
+
+	t.Run("invalid policy", func(t *testing.T) {
+		_, err := service.SetRetentionPolicy(context.Background(), &pb.SetRetentionPolicyRequest{
+			OrganizationId: orgId,
+			Policy:         &pb.RetentionPolicy{DefaultAge: 60},
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("policy is saved", func(t *testing.T) {
+		policy := &pb.RetentionPolicy{
+			Rules:      []*pb.RetentionPolicyRule{{Branch: "main", ProtectedOnly: true, Age: 365 * 24 * 3600}},
+			DefaultAge: 30 * 24 * 3600,
+		}
+
+		_, err := service.SetRetentionPolicy(context.Background(), &pb.SetRetentionPolicyRequest{OrganizationId: orgId, Policy: policy})
+		require.NoError(t, err)
+
+		response, err := service.GetRetentionPolicy(context.Background(), &pb.GetRetentionPolicyRequest{OrganizationId: orgId})
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(policy, response.Policy))
+	})
+}
@@ -7,6 +7,7 @@ import (
 
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
 	protos "github.com/semaphoreio/semaphore/loghub2/pkg/protos/loghub2"
+	"github.com/semaphoreio/semaphore/loghub2/pkg/storage"
 	"google.golang.org/grpc"
 	health "google.golang.org/grpc/health/grpc_health_v1"
 )
@@ -15,7 +16,7 @@ var (
 	customFunc recovery.RecoveryHandlerFunc
 )
 
-func RunServer(port int, privateKey string) {
+func RunServer(port int, privateKey string, redisStorage *storage.RedisStorage, cloudStorage storage.Storage) {
 	endpoint := fmt.Sprintf("0.0.0.0:%d", port)
 	lis, err := net.Listen("tcp", endpoint)
 
@@ -42,7 +43,7 @@ func RunServer(port int, privateKey string) {
 	healthService := &HealthCheckServer{}
 	health.RegisterHealthServer(grpcServer, healthService)
 
-	service := NewLoghub2Service(privateKey)
+	service := NewLoghub2Service(privateKey, redisStorage, cloudStorage)
 	protos.RegisterLoghub2Server(grpcServer, service)
 
 	//
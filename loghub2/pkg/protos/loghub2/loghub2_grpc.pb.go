@@ -19,7 +19,13 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Loghub2_GenerateToken_FullMethodName = "/InternalApi.Loghub2.Loghub2/GenerateToken"
+	Loghub2_GenerateToken_FullMethodName      = "/InternalApi.Loghub2.Loghub2/GenerateToken"
+	Loghub2_SearchLogs_FullMethodName         = "/InternalApi.Loghub2.Loghub2/SearchLogs"
+	Loghub2_RegisterSecrets_FullMethodName    = "/InternalApi.Loghub2.Loghub2/RegisterSecrets"
+	Loghub2_RedactArchivedLogs_FullMethodName = "/InternalApi.Loghub2.Loghub2/RedactArchivedLogs"
+	Loghub2_SetJobMetadata_FullMethodName     = "/InternalApi.Loghub2.Loghub2/SetJobMetadata"
+	Loghub2_SetRetentionPolicy_FullMethodName = "/InternalApi.Loghub2.Loghub2/SetRetentionPolicy"
+	Loghub2_GetRetentionPolicy_FullMethodName = "/InternalApi.Loghub2.Loghub2/GetRetentionPolicy"
 )
 
 // Loghub2Client is the client API for Loghub2 service.
@@ -27,6 +33,12 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type Loghub2Client interface {
 	GenerateToken(ctx context.Context, in *GenerateTokenRequest, opts ...grpc.CallOption) (*GenerateTokenResponse, error)
+	SearchLogs(ctx context.Context, in *SearchLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SearchLogsResponse], error)
+	RegisterSecrets(ctx context.Context, in *RegisterSecretsRequest, opts ...grpc.CallOption) (*RegisterSecretsResponse, error)
+	RedactArchivedLogs(ctx context.Context, in *RedactArchivedLogsRequest, opts ...grpc.CallOption) (*RedactArchivedLogsResponse, error)
+	SetJobMetadata(ctx context.Context, in *SetJobMetadataRequest, opts ...grpc.CallOption) (*SetJobMetadataResponse, error)
+	SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*SetRetentionPolicyResponse, error)
+	GetRetentionPolicy(ctx context.Context, in *GetRetentionPolicyRequest, opts ...grpc.CallOption) (*GetRetentionPolicyResponse, error)
 }
 
 type loghub2Client struct {
@@ -47,11 +59,86 @@ func (c *loghub2Client) GenerateToken(ctx context.Context, in *GenerateTokenRequ
 	return out, nil
 }
 
+func (c *loghub2Client) SearchLogs(ctx context.Context, in *SearchLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SearchLogsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Loghub2_ServiceDesc.Streams[0], Loghub2_SearchLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SearchLogsRequest, SearchLogsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Loghub2_SearchLogsClient = grpc.ServerStreamingClient[SearchLogsResponse]
+
+func (c *loghub2Client) RegisterSecrets(ctx context.Context, in *RegisterSecretsRequest, opts ...grpc.CallOption) (*RegisterSecretsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterSecretsResponse)
+	err := c.cc.Invoke(ctx, Loghub2_RegisterSecrets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loghub2Client) RedactArchivedLogs(ctx context.Context, in *RedactArchivedLogsRequest, opts ...grpc.CallOption) (*RedactArchivedLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedactArchivedLogsResponse)
+	err := c.cc.Invoke(ctx, Loghub2_RedactArchivedLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loghub2Client) SetJobMetadata(ctx context.Context, in *SetJobMetadataRequest, opts ...grpc.CallOption) (*SetJobMetadataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetJobMetadataResponse)
+	err := c.cc.Invoke(ctx, Loghub2_SetJobMetadata_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loghub2Client) SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*SetRetentionPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, Loghub2_SetRetentionPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *loghub2Client) GetRetentionPolicy(ctx context.Context, in *GetRetentionPolicyRequest, opts ...grpc.CallOption) (*GetRetentionPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, Loghub2_GetRetentionPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Loghub2Server is the server API for Loghub2 service.
 // All implementations should embed UnimplementedLoghub2Server
 // for forward compatibility.
 type Loghub2Server interface {
 	GenerateToken(context.Context, *GenerateTokenRequest) (*GenerateTokenResponse, error)
+	SearchLogs(*SearchLogsRequest, grpc.ServerStreamingServer[SearchLogsResponse]) error
+	RegisterSecrets(context.Context, *RegisterSecretsRequest) (*RegisterSecretsResponse, error)
+	RedactArchivedLogs(context.Context, *RedactArchivedLogsRequest) (*RedactArchivedLogsResponse, error)
+	SetJobMetadata(context.Context, *SetJobMetadataRequest) (*SetJobMetadataResponse, error)
+	SetRetentionPolicy(context.Context, *SetRetentionPolicyRequest) (*SetRetentionPolicyResponse, error)
+	GetRetentionPolicy(context.Context, *GetRetentionPolicyRequest) (*GetRetentionPolicyResponse, error)
 }
 
 // UnimplementedLoghub2Server should be embedded to have
@@ -64,6 +151,24 @@ type UnimplementedLoghub2Server struct{}
 func (UnimplementedLoghub2Server) GenerateToken(context.Context, *GenerateTokenRequest) (*GenerateTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateToken not implemented")
 }
+func (UnimplementedLoghub2Server) SearchLogs(*SearchLogsRequest, grpc.ServerStreamingServer[SearchLogsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method SearchLogs not implemented")
+}
+func (UnimplementedLoghub2Server) RegisterSecrets(context.Context, *RegisterSecretsRequest) (*RegisterSecretsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSecrets not implemented")
+}
+func (UnimplementedLoghub2Server) RedactArchivedLogs(context.Context, *RedactArchivedLogsRequest) (*RedactArchivedLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedactArchivedLogs not implemented")
+}
+func (UnimplementedLoghub2Server) SetJobMetadata(context.Context, *SetJobMetadataRequest) (*SetJobMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetJobMetadata not implemented")
+}
+func (UnimplementedLoghub2Server) SetRetentionPolicy(context.Context, *SetRetentionPolicyRequest) (*SetRetentionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRetentionPolicy not implemented")
+}
+func (UnimplementedLoghub2Server) GetRetentionPolicy(context.Context, *GetRetentionPolicyRequest) (*GetRetentionPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRetentionPolicy not implemented")
+}
 func (UnimplementedLoghub2Server) testEmbeddedByValue() {}
 
 // UnsafeLoghub2Server may be embedded to opt out of forward compatibility for this service.
@@ -102,6 +207,107 @@ func _Loghub2_GenerateToken_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Loghub2_SearchLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SearchLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(Loghub2Server).SearchLogs(m, &grpc.GenericServerStream[SearchLogsRequest, SearchLogsResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Loghub2_SearchLogsServer = grpc.ServerStreamingServer[SearchLogsResponse]
+
+func _Loghub2_RegisterSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterSecretsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Loghub2Server).RegisterSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Loghub2_RegisterSecrets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Loghub2Server).RegisterSecrets(ctx, req.(*RegisterSecretsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Loghub2_RedactArchivedLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedactArchivedLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Loghub2Server).RedactArchivedLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Loghub2_RedactArchivedLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Loghub2Server).RedactArchivedLogs(ctx, req.(*RedactArchivedLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Loghub2_SetJobMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetJobMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Loghub2Server).SetJobMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Loghub2_SetJobMetadata_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Loghub2Server).SetJobMetadata(ctx, req.(*SetJobMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Loghub2_SetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Loghub2Server).SetRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Loghub2_SetRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Loghub2Server).SetRetentionPolicy(ctx, req.(*SetRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Loghub2_GetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Loghub2Server).GetRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Loghub2_GetRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Loghub2Server).GetRetentionPolicy(ctx, req.(*GetRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Loghub2_ServiceDesc is the grpc.ServiceDesc for Loghub2 service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -113,7 +319,33 @@ var Loghub2_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateToken",
 			Handler:    _Loghub2_GenerateToken_Handler,
 		},
+		{
+			MethodName: "RegisterSecrets",
+			Handler:    _Loghub2_RegisterSecrets_Handler,
+		},
+		{
+			MethodName: "RedactArchivedLogs",
+			Handler:    _Loghub2_RedactArchivedLogs_Handler,
+		},
+		{
+			MethodName: "SetJobMetadata",
+			Handler:    _Loghub2_SetJobMetadata_Handler,
+		},
+		{
+			MethodName: "SetRetentionPolicy",
+			Handler:    _Loghub2_SetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "GetRetentionPolicy",
+			Handler:    _Loghub2_GetRetentionPolicy_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SearchLogs",
+			Handler:       _Loghub2_SearchLogs_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "loghub2.proto",
 }
@@ -0,0 +1,264 @@
+package publicapi
+
+import (
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+)
+
+const ansiEscape = '\x1b'
+
+var ansiColors = []string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+
+type ansiStyle struct {
+	fg        string
+	bg        string
+	bold      bool
+	italic    bool
+	underline bool
+}
+
+// ansiConverter turns command output with ANSI escape codes
+// into HTML or plain text. The style is kept between calls,
+// since colors set in one output event apply to the next ones,
+// and escape sequences split between events are put back together.
+type ansiConverter struct {
+	style   ansiStyle
+	pending string
+}
+
+// ToHTML escapes text, and wraps the parts using colors in styled spans.
+func (c *ansiConverter) ToHTML(text string) string {
+	var b strings.Builder
+	c.scan(text, func(segment string) {
+		classes, style := c.style.attributes()
+		if classes == "" && style == "" {
+			b.WriteString(html.EscapeString(segment))
+			return
+		}
+
+		b.WriteString("<span")
+		if classes != "" {
+			fmt.Fprintf(&b, ` class="%s"`, classes)
+		}
+
+		if style != "" {
+			fmt.Fprintf(&b, ` style="%s"`, style)
+		}
+
+		b.WriteString(">")
+		b.WriteString(html.EscapeString(segment))
+		b.WriteString("</span>")
+	})
+
+	return b.String()
+}
+
+// Strip removes all escape codes from text.
+func (c *ansiConverter) Strip(text string) string {
+	var b strings.Builder
+	c.scan(text, func(segment string) {
+		b.WriteString(segment)
+	})
+
+	return b.String()
+}
+
+// Reset forgets the current style, e.g. when a new command starts.
+func (c *ansiConverter) Reset() {
+	c.style = ansiStyle{}
+	c.pending = ""
+}
+
+func (c *ansiConverter) scan(text string, fn func(segment string)) {
+	text = c.pending + text
+	c.pending = ""
+
+	for len(text) > 0 {
+		i := strings.IndexByte(text, ansiEscape)
+		if i < 0 {
+			fn(text)
+			return
+		}
+
+		if i > 0 {
+			fn(text[:i])
+		}
+
+		text = text[i:]
+		length, complete := escapeSequenceLength(text)
+		if !complete {
+			c.pending = text
+			return
+		}
+
+		if length > 2 && text[1] == '[' && text[length-1] == 'm' {
+			c.style.apply(text[2 : length-1])
+		}
+
+		// Other sequences, like cursor movements, are dropped.
+		text = text[length:]
+	}
+}
+
+// escapeSequenceLength returns the length of the escape sequence
+// at the start of text, or false if text ends before the sequence does.
+func escapeSequenceLength(text string) (int, bool) {
+	if len(text) < 2 {
+		return 0, false
+	}
+
+	if text[1] != '[' {
+		return 2, true
+	}
+
+	for i := 2; i < len(text); i++ {
+		if text[i] >= 0x40 && text[i] <= 0x7e {
+			return i + 1, true
+		}
+	}
+
+	return 0, false
+}
+
+func (s *ansiStyle) apply(params string) {
+	codes := []int{}
+	for _, param := range strings.Split(params, ";") {
+		code, err := strconv.Atoi(param)
+		if err != nil {
+			code = 0
+		}
+
+		codes = append(codes, code)
+	}
+
+	for i := 0; i < len(codes); i++ {
+		code := codes[i]
+		switch {
+		case code == 0:
+			*s = ansiStyle{}
+		case code == 1:
+			s.bold = true
+		case code == 3:
+			s.italic = true
+		case code == 4:
+			s.underline = true
+		case code == 22:
+			s.bold = false
+		case code == 23:
+			s.italic = false
+		case code == 24:
+			s.underline = false
+		case code >= 30 && code <= 37:
+			s.fg = ansiColors[code-30]
+		case code >= 90 && code <= 97:
+			s.fg = "bright-" + ansiColors[code-90]
+		case code == 39:
+			s.fg = ""
+		case code >= 40 && code <= 47:
+			s.bg = ansiColors[code-40]
+		case code >= 100 && code <= 107:
+			s.bg = "bright-" + ansiColors[code-100]
+		case code == 49:
+			s.bg = ""
+		case code == 38 || code == 48:
+			color, used := extendedColor(codes[i+1:])
+			if code == 38 {
+				s.fg = color
+			} else {
+				s.bg = color
+			}
+
+			i += used
+		}
+	}
+}
+
+// extendedColor reads 256 color (5;n) and true color (2;r;g;b) codes.
+// It returns the color as a CSS value, and how many codes it used.
+func extendedColor(codes []int) (string, int) {
+	if len(codes) >= 2 && codes[0] == 5 {
+		n := codes[1]
+		if n >= 0 && n < 8 {
+			return ansiColors[n], 2
+		}
+
+		if n >= 8 && n < 16 {
+			return "bright-" + ansiColors[n-8], 2
+		}
+
+		return color256(n), 2
+	}
+
+	if len(codes) >= 4 && codes[0] == 2 {
+		return fmt.Sprintf("#%02x%02x%02x", clamp(codes[1]), clamp(codes[2]), clamp(codes[3])), 4
+	}
+
+	return "", len(codes)
+}
+
+// color256 converts colors 16-255 of the 256 color palette:
+// a 6x6x6 color cube, followed by 24 shades of gray.
+func color256(n int) string {
+	if n >= 232 && n <= 255 {
+		gray := 8 + (n-232)*10
+		return fmt.Sprintf("#%02x%02x%02x", gray, gray, gray)
+	}
+
+	if n < 16 || n > 231 {
+		return ""
+	}
+
+	n -= 16
+	level := func(v int) int {
+		if v == 0 {
+			return 0
+		}
+
+		return 55 + v*40
+	}
+
+	return fmt.Sprintf("#%02x%02x%02x", level(n/36), level((n/6)%6), level(n%6))
+}
+
+func clamp(v int) int {
+	return min(max(v, 0), 255)
+}
+
+// Named colors are styled by the stylesheet in the HTML export,
+// and other colors with inline styles.
+func (s ansiStyle) attributes() (string, string) {
+	classes := []string{}
+	styles := []string{}
+
+	if s.fg != "" {
+		if strings.HasPrefix(s.fg, "#") {
+			styles = append(styles, "color: "+s.fg)
+		} else {
+			classes = append(classes, "fg-"+s.fg)
+		}
+	}
+
+	if s.bg != "" {
+		if strings.HasPrefix(s.bg, "#") {
+			styles = append(styles, "background-color: "+s.bg)
+		} else {
+			classes = append(classes, "bg-"+s.bg)
+		}
+	}
+
+	if s.bold {
+		classes = append(classes, "bold")
+	}
+
+	if s.italic {
+		classes = append(classes, "italic")
+	}
+
+	if s.underline {
+		classes = append(classes, "underline")
+	}
+
+	return strings.Join(classes, " "), strings.Join(styles, "; ")
+}
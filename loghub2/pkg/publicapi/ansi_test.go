@@ -0,0 +1,41 @@
+package publicapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test__ANSIToHTML(t *testing.T) {
+	type testCase struct {
+		text     string
+		expected string
+	}
+
+	for _, tc := range []testCase{
+		{text: "no colors <here>", expected: "no colors &lt;here&gt;"},
+		{text: "\x1b[31mred\x1b[0m plain", expected: `<span class="fg-red">red</span> plain`},
+		{text: "\x1b[1;92;44mbold\x1b[22m not", expected: `<span class="fg-bright-green bg-blue bold">bold</span><span class="fg-bright-green bg-blue"> not</span>`},
+		{text: "\x1b[38;5;196mred\x1b[39m", expected: `<span style="color: #ff0000">red</span>`},
+		{text: "\x1b[48;2;1;2;3mrgb", expected: `<span style="background-color: #010203">rgb</span>`},
+		{text: "\x1b[2K\x1b[1Gcleared", expected: "cleared"},
+	} {
+		converter := ansiConverter{}
+		assert.Equal(t, tc.expected, converter.ToHTML(tc.text), tc.text)
+	}
+}
+
+func Test__ANSIStateIsKeptBetweenCalls(t *testing.T) {
+	converter := ansiConverter{}
+	assert.Equal(t, "", converter.ToHTML("\x1b[3"))
+	assert.Equal(t, `<span class="fg-yellow">warning</span>`, converter.ToHTML("3mwarning"))
+	assert.Equal(t, `<span class="fg-yellow">still</span>`, converter.ToHTML("still"))
+
+	converter.Reset()
+	assert.Equal(t, "reset", converter.ToHTML("reset"))
+}
+
+func Test__ANSIStrip(t *testing.T) {
+	converter := ansiConverter{}
+	assert.Equal(t, "ok <b>", converter.Strip("\x1b[32mok\x1b[0m <b>"))
+}
@@ -0,0 +1,221 @@
+package publicapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	FormatJSON  = "json"
+	FormatJSONL = "jsonl"
+	FormatText  = "text"
+	FormatHTML  = "html"
+	FormatRaw   = "raw"
+)
+
+// LogsWriter writes log events into a response in one of the supported formats.
+type LogsWriter interface {
+	Begin() error
+	WriteEvent(event []byte) error
+	Finish() error
+}
+
+type logEvent struct {
+	Event      string `json:"event"`
+	Timestamp  int64  `json:"timestamp"`
+	Directive  string `json:"directive"`
+	Output     string `json:"output"`
+	ExitCode   *int   `json:"exit_code"`
+	StartedAt  int64  `json:"started_at"`
+	FinishedAt int64  `json:"finished_at"`
+	Result     string `json:"result"`
+}
+
+func parseLogEvent(event []byte) (*logEvent, error) {
+	e := logEvent{}
+	err := json.Unmarshal(event, &e)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing line '%v': %v", string(event), err)
+	}
+
+	return &e, nil
+}
+
+func (e *logEvent) duration() time.Duration {
+	return time.Duration(e.FinishedAt-e.StartedAt) * time.Second
+}
+
+func formatTimestamp(timestamp int64) string {
+	return time.Unix(timestamp, 0).UTC().Format(time.RFC3339)
+}
+
+func ContentTypeForFormat(format string) string {
+	switch format {
+	case FormatJSON:
+		return "application/json"
+	case FormatJSONL:
+		return "application/x-ndjson"
+	case FormatHTML:
+		return "text/html; charset=utf-8"
+	default:
+		return "text/plain; charset=utf-8"
+	}
+}
+
+// NewLogsWriter returns a writer for format.
+// token is the index of the first event written, and final
+// tells if no more events can be added to the logs.
+func NewLogsWriter(w io.Writer, format, jobId string, token int64, final bool) (LogsWriter, error) {
+	switch format {
+	case FormatJSON:
+		return NewJSONResponseWriter(w, token, final), nil
+	case FormatJSONL:
+		return &JSONLinesResponseWriter{w: w}, nil
+	case FormatText:
+		return &TextResponseWriter{w: w}, nil
+	case FormatHTML:
+		return &HTMLResponseWriter{w: w, jobId: jobId}, nil
+	case FormatRaw:
+		return &RawResponseWriter{w: w}, nil
+	default:
+		return nil, fmt.Errorf("unknown format '%s'", format)
+	}
+}
+
+// JSONLinesResponseWriter writes one event per line.
+type JSONLinesResponseWriter struct {
+	w io.Writer
+}
+
+func (writer *JSONLinesResponseWriter) Begin() error {
+	return nil
+}
+
+func (writer *JSONLinesResponseWriter) WriteEvent(event []byte) error {
+	event = bytes.TrimRight(event, "\n")
+	if _, err := writer.w.Write(event); err != nil {
+		return err
+	}
+
+	_, err := writer.w.Write([]byte("\n"))
+	return err
+}
+
+func (writer *JSONLinesResponseWriter) Finish() error {
+	return nil
+}
+
+// RawResponseWriter writes only the commands and their output,
+// with escape codes kept as they are.
+type RawResponseWriter struct {
+	w io.Writer
+}
+
+func (writer *RawResponseWriter) Begin() error {
+	return nil
+}
+
+func (writer *RawResponseWriter) WriteEvent(event []byte) error {
+	e, err := parseLogEvent(event)
+	if err != nil {
+		return err
+	}
+
+	switch e.Event {
+	case "cmd_started":
+		_, err = io.WriteString(writer.w, e.Directive+"\n")
+	case "cmd_output":
+		_, err = io.WriteString(writer.w, e.Output)
+	}
+
+	return err
+}
+
+func (writer *RawResponseWriter) Finish() error {
+	return nil
+}
+
+// TextResponseWriter writes a plain text version of the logs,
+// with a header for every command, and its exit code and duration after it.
+// Escape codes are removed from the output.
+type TextResponseWriter struct {
+	w         io.Writer
+	ansi      ansiConverter
+	inNewLine bool
+}
+
+func (writer *TextResponseWriter) Begin() error {
+	writer.inNewLine = true
+	return nil
+}
+
+func (writer *TextResponseWriter) WriteEvent(event []byte) error {
+	e, err := parseLogEvent(event)
+	if err != nil {
+		return err
+	}
+
+	switch e.Event {
+	case "job_started":
+		return writer.writeLine(fmt.Sprintf("Job started at %s\n", formatTimestamp(e.Timestamp)))
+	case "cmd_started":
+		writer.ansi.Reset()
+		return writer.writeLine(fmt.Sprintf("\n$ %s\n", e.Directive))
+	case "cmd_output":
+		output := writer.ansi.Strip(e.Output)
+		if output == "" {
+			return nil
+		}
+
+		writer.inNewLine = strings.HasSuffix(output, "\n")
+		_, err := io.WriteString(writer.w, output)
+		return err
+	case "cmd_finished":
+		return writer.writeLine(fmt.Sprintf("[exit code %s, took %s]\n", exitCode(e), e.duration()))
+	case "job_finished":
+		return writer.writeLine(fmt.Sprintf("\nJob %s at %s\n", jobResult(e), formatTimestamp(e.Timestamp)))
+	}
+
+	return nil
+}
+
+// Command output does not always end in a new line,
+// so we add one before writing anything else.
+func (writer *TextResponseWriter) writeLine(line string) error {
+	if !writer.inNewLine {
+		line = "\n" + line
+	}
+
+	writer.inNewLine = true
+	_, err := io.WriteString(writer.w, line)
+	return err
+}
+
+func (writer *TextResponseWriter) Finish() error {
+	if writer.inNewLine {
+		return nil
+	}
+
+	_, err := io.WriteString(writer.w, "\n")
+	return err
+}
+
+func exitCode(e *logEvent) string {
+	if e.ExitCode == nil {
+		return "unknown"
+	}
+
+	return fmt.Sprintf("%d", *e.ExitCode)
+}
+
+func jobResult(e *logEvent) string {
+	if e.Result == "" {
+		return "finished"
+	}
+
+	return e.Result
+}
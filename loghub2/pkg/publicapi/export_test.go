@@ -0,0 +1,115 @@
+package publicapi
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const EXPORT_LOGS = `
+{"event":"job_started","timestamp":1624541916}
+{"event":"cmd_started","timestamp":1624541916,"directive":"make test"}
+{"event":"cmd_output","timestamp":1624541917,"output":"\u001b[32mok\u001b[0m   pkg/a\n"}
+{"event":"cmd_output","timestamp":1624541918,"output":"\u001b[31mFAIL\u001b[0m pkg/<b>"}
+{"event":"cmd_finished","timestamp":1624541920,"directive":"make test","exit_code":2,"started_at":1624541916,"finished_at":1624541920}
+{"event":"job_finished","timestamp":1624541920,"result":"failed"}
+`
+
+func Test__PullLogsInOtherFormats(t *testing.T) {
+	jobId := uuid.NewString()
+	request, _ := http.NewRequest("POST", fmt.Sprintf("/api/v1/logs/%s?start_from=0", jobId), strings.NewReader(EXPORT_LOGS))
+	response := executeRequest(request, generateJwtToken(jobId, "PUSH"))
+	require.Equal(t, 200, response.Code)
+
+	pull := func(query string) (int, string, string) {
+		request, _ := http.NewRequest("GET", fmt.Sprintf("/api/v1/logs/%s?%s", jobId, query), nil)
+		response := executeRequest(request, generateJwtToken(jobId, "PULL"))
+		return response.Code, response.Header().Get("Content-Type"), response.Body.String()
+	}
+
+	t.Run("jsonl", func(t *testing.T) {
+		code, contentType, body := pull("format=jsonl&from=1&to=3")
+		assert.Equal(t, 200, code)
+		assert.Equal(t, "application/x-ndjson", contentType)
+		assert.Equal(t, strings.Join(strings.Split(strings.TrimSpace(EXPORT_LOGS), "\n")[1:3], "\n")+"\n", body)
+	})
+
+	t.Run("text", func(t *testing.T) {
+		code, contentType, body := pull("format=text")
+		assert.Equal(t, 200, code)
+		assert.Equal(t, "text/plain; charset=utf-8", contentType)
+		assert.Equal(t, strings.Join([]string{
+			"Job started at 2021-06-24T13:38:36Z",
+			"",
+			"$ make test",
+			"ok   pkg/a",
+			"FAIL pkg/<b>",
+			"[exit code 2, took 4s]",
+			"",
+			"Job failed at 2021-06-24T13:38:40Z",
+			"",
+		}, "\n"), body)
+	})
+
+	t.Run("html", func(t *testing.T) {
+		code, contentType, body := pull("format=html")
+		assert.Equal(t, 200, code)
+		assert.Equal(t, "text/html; charset=utf-8", contentType)
+		assert.True(t, strings.HasPrefix(body, "<!DOCTYPE html>"))
+		assert.Contains(t, body, "<summary>make test</summary>")
+		assert.Contains(t, body, `<pre><span class="fg-green">ok</span>   pkg/a`+"\n"+`<span class="fg-red">FAIL</span> pkg/&lt;b&gt;</pre>`)
+		assert.Contains(t, body, `<p class="meta failed">Exit code 2, took 4s</p>`)
+		assert.True(t, strings.HasSuffix(body, "</html>\n"))
+	})
+
+	t.Run("raw is still supported", func(t *testing.T) {
+		code, _, body := pull("raw=true")
+		assert.Equal(t, 200, code)
+		assert.Equal(t, "make test\n\u001b[32mok\u001b[0m   pkg/a\n\u001b[31mFAIL\u001b[0m pkg/<b>", body)
+
+		code, _, _ = pull("raw=true&format=html")
+		assert.Equal(t, 400, code)
+	})
+
+	t.Run("bad format => 400", func(t *testing.T) {
+		code, _, _ := pull("format=xml")
+		assert.Equal(t, 400, code)
+	})
+}
+
+func Test__TextResponseWriter(t *testing.T) {
+	t.Run("output without new line is followed by one", func(t *testing.T) {
+		buf := bytes.NewBuffer(nil)
+		writer := &TextResponseWriter{w: buf}
+		require.NoError(t, writer.Begin())
+		require.NoError(t, writer.WriteEvent([]byte(`{"event":"cmd_started","directive":"echo -n hello"}`)))
+		require.NoError(t, writer.WriteEvent([]byte(`{"event":"cmd_output","output":"hello"}`)))
+		require.NoError(t, writer.Finish())
+		assert.Equal(t, "\n$ echo -n hello\nhello\n", buf.String())
+	})
+
+	t.Run("bad events", func(t *testing.T) {
+		writer := &TextResponseWriter{w: bytes.NewBuffer(nil)}
+		assert.Error(t, writer.WriteEvent([]byte(`not JSON`)))
+	})
+}
+
+func Test__HTMLResponseWriter(t *testing.T) {
+	t.Run("commands without cmd_finished are closed", func(t *testing.T) {
+		buf := bytes.NewBuffer(nil)
+		writer := &HTMLResponseWriter{w: buf, jobId: "<job>"}
+		require.NoError(t, writer.Begin())
+		require.NoError(t, writer.WriteEvent([]byte(`{"event":"cmd_started","directive":"sleep 100"}`)))
+		require.NoError(t, writer.WriteEvent([]byte(`{"event":"cmd_output","output":"waiting\n"}`)))
+		require.NoError(t, writer.Finish())
+
+		assert.Contains(t, buf.String(), "<title>Logs for job &lt;job&gt;</title>")
+		assert.Contains(t, buf.String(), "<summary>sleep 100</summary>\n<pre>waiting\n</pre>\n</details>\n</body>")
+	})
+}
@@ -0,0 +1,174 @@
+package publicapi
+
+import (
+	"fmt"
+	"html"
+	"io"
+)
+
+// The HTML export is a single self-contained page,
+// so it can be attached to tickets and opened offline.
+const htmlHeader = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Logs for job %s</title>
+<style>
+body { background: #1e1e1e; color: #d4d4d4; font-family: ui-monospace, Menlo, Consolas, monospace; font-size: 13px; margin: 16px; }
+h1 { font-size: 16px; }
+details { margin: 8px 0; border-left: 3px solid #555; padding-left: 8px; }
+summary { cursor: pointer; font-weight: bold; }
+pre { margin: 4px 0; white-space: pre-wrap; word-break: break-all; }
+.meta { color: #8a8a8a; }
+.meta.passed { color: #3c9d4e; }
+.meta.failed { color: #d9534f; }
+.bold { font-weight: bold; }
+.italic { font-style: italic; }
+.underline { text-decoration: underline; }
+.fg-black { color: #000000; } .bg-black { background-color: #000000; }
+.fg-red { color: #cd3131; } .bg-red { background-color: #cd3131; }
+.fg-green { color: #0dbc79; } .bg-green { background-color: #0dbc79; }
+.fg-yellow { color: #e5e510; } .bg-yellow { background-color: #e5e510; }
+.fg-blue { color: #2472c8; } .bg-blue { background-color: #2472c8; }
+.fg-magenta { color: #bc3fbc; } .bg-magenta { background-color: #bc3fbc; }
+.fg-cyan { color: #11a8cd; } .bg-cyan { background-color: #11a8cd; }
+.fg-white { color: #e5e5e5; } .bg-white { background-color: #e5e5e5; }
+.fg-bright-black { color: #666666; } .bg-bright-black { background-color: #666666; }
+.fg-bright-red { color: #f14c4c; } .bg-bright-red { background-color: #f14c4c; }
+.fg-bright-green { color: #23d18b; } .bg-bright-green { background-color: #23d18b; }
+.fg-bright-yellow { color: #f5f543; } .bg-bright-yellow { background-color: #f5f543; }
+.fg-bright-blue { color: #3b8eea; } .bg-bright-blue { background-color: #3b8eea; }
+.fg-bright-magenta { color: #d670d6; } .bg-bright-magenta { background-color: #d670d6; }
+.fg-bright-cyan { color: #29b8db; } .bg-bright-cyan { background-color: #29b8db; }
+.fg-bright-white { color: #ffffff; } .bg-bright-white { background-color: #ffffff; }
+</style>
+</head>
+<body>
+<h1>Logs for job %s</h1>
+`
+
+const htmlFooter = `</body>
+</html>
+`
+
+// HTMLResponseWriter writes the logs as an HTML page,
+// with a collapsible section for every command,
+// and ANSI colors in the output rendered with CSS.
+type HTMLResponseWriter struct {
+	w         io.Writer
+	jobId     string
+	ansi      ansiConverter
+	inCommand bool
+	inOutput  bool
+}
+
+func (writer *HTMLResponseWriter) Begin() error {
+	jobId := html.EscapeString(writer.jobId)
+	_, err := fmt.Fprintf(writer.w, htmlHeader, jobId, jobId)
+	return err
+}
+
+func (writer *HTMLResponseWriter) WriteEvent(event []byte) error {
+	e, err := parseLogEvent(event)
+	if err != nil {
+		return err
+	}
+
+	switch e.Event {
+	case "job_started":
+		return writer.write(fmt.Sprintf("<p class=\"meta\">Job started at %s</p>\n", formatTimestamp(e.Timestamp)))
+	case "cmd_started":
+		if err := writer.closeCommand(); err != nil {
+			return err
+		}
+
+		writer.ansi.Reset()
+		writer.inCommand = true
+		return writer.write(fmt.Sprintf("<details open>\n<summary>%s</summary>\n", html.EscapeString(e.Directive)))
+	case "cmd_output":
+		return writer.writeOutput(e.Output)
+	case "cmd_finished":
+		if err := writer.closeOutput(); err != nil {
+			return err
+		}
+
+		// A range of logs can start in the middle of a command.
+		if !writer.inCommand {
+			writer.inCommand = true
+			if err := writer.write(fmt.Sprintf("<details open>\n<summary>%s</summary>\n", html.EscapeString(e.Directive))); err != nil {
+				return err
+			}
+		}
+
+		result := "failed"
+		if e.ExitCode != nil && *e.ExitCode == 0 {
+			result = "passed"
+		}
+
+		err := writer.write(fmt.Sprintf(
+			"<p class=\"meta %s\">Exit code %s, took %s</p>\n</details>\n",
+			result, exitCode(e), e.duration(),
+		))
+
+		writer.inCommand = false
+		return err
+	case "job_finished":
+		if err := writer.closeCommand(); err != nil {
+			return err
+		}
+
+		return writer.write(fmt.Sprintf(
+			"<p class=\"meta\">Job %s at %s</p>\n",
+			html.EscapeString(jobResult(e)), formatTimestamp(e.Timestamp),
+		))
+	}
+
+	return nil
+}
+
+func (writer *HTMLResponseWriter) writeOutput(output string) error {
+	if !writer.inOutput {
+		writer.inOutput = true
+		if err := writer.write("<pre>"); err != nil {
+			return err
+		}
+	}
+
+	return writer.write(writer.ansi.ToHTML(output))
+}
+
+func (writer *HTMLResponseWriter) closeOutput() error {
+	if !writer.inOutput {
+		return nil
+	}
+
+	writer.inOutput = false
+	return writer.write("</pre>\n")
+}
+
+// Commands still running, or without a cmd_finished event, are closed here.
+func (writer *HTMLResponseWriter) closeCommand() error {
+	if err := writer.closeOutput(); err != nil {
+		return err
+	}
+
+	if !writer.inCommand {
+		return nil
+	}
+
+	writer.inCommand = false
+	return writer.write("</details>\n")
+}
+
+func (writer *HTMLResponseWriter) write(s string) error {
+	_, err := io.WriteString(writer.w, s)
+	return err
+}
+
+func (writer *HTMLResponseWriter) Finish() error {
+	if err := writer.closeCommand(); err != nil {
+		return err
+	}
+
+	return writer.write(htmlFooter)
+}
@@ -0,0 +1,215 @@
+package publicapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/renderedtext/go-watchman"
+	"github.com/semaphoreio/semaphore/loghub2/pkg/auth"
+	"github.com/semaphoreio/semaphore/loghub2/pkg/storage"
+)
+
+// Searches skip the server timeout handler, see Serve,
+// so they are limited here, both for reading the logs and writing the response.
+const defaultSearchMaxDuration = 5 * time.Minute
+
+type SearchLineResponse struct {
+	Token int64           `json:"token"`
+	Event json.RawMessage `json:"event"`
+}
+
+type SearchMatchResponse struct {
+	SearchLineResponse
+	Before []SearchLineResponse `json:"before"`
+	After  []SearchLineResponse `json:"after"`
+}
+
+func isSearchRequest(r *http.Request) bool {
+	return strings.HasSuffix(strings.TrimSuffix(r.URL.Path, "/"), "/search")
+}
+
+// SearchLogs looks for log events matching the query in the logs of a job.
+// The query parameters are:
+//   - q: the text to look for. Required.
+//   - regex: if true, q is used as a regular expression.
+//   - ignore_case: if true, the search is case-insensitive.
+//   - context: number of events to include before and after each match.
+//   - limit: maximum number of matches to return.
+//
+// Matches are written to the response as they are found.
+func (s *Server) SearchLogs(w http.ResponseWriter, r *http.Request) {
+	defer watchman.Benchmark(time.Now(), "logs.search.request")
+
+	vars := mux.Vars(r)
+	jobId := vars["job_id"]
+	if jobId == "" {
+		log.Printf("job_id is required")
+		http.Error(w, "missing job_id", http.StatusBadRequest)
+		return
+	}
+
+	jwtToken := r.Context().Value(tokenContextKey).(string)
+	err := auth.ValidateToken(jwtToken, s.privateKey, jobId, "PULL")
+	if err != nil {
+		respondWith401(w)
+		return
+	}
+
+	options, err := parseSearchOptions(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), s.searchMaxDuration)
+	defer cancel()
+
+	controller := http.NewResponseController(w)
+	err = controller.SetWriteDeadline(time.Now().Add(s.searchMaxDuration))
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		log.Printf("Error setting write deadline for %s search: %v", jobId, err)
+	}
+
+	writer := &searchResponseWriter{w: w, controller: controller}
+	result, err := storage.SearchJobLogs(ctx, s.redisStorage, s.cloudStorage, jobId, options, writer.WriteMatch)
+	if err != nil {
+		if errors.Is(err, storage.ErrLogsNotFound) {
+			http.Error(w, fmt.Sprintf("Logs for %s not found", jobId), http.StatusNotFound)
+			return
+		}
+
+		log.Printf("Error searching logs for %s: %v", jobId, err)
+		if !writer.started {
+			http.Error(w, "error searching logs", http.StatusInternalServerError)
+		}
+
+		return
+	}
+
+	err = writer.Finish(result.Truncated)
+	if err != nil {
+		log.Printf("Error writing search response for %s: %v", jobId, err)
+	}
+}
+
+func parseSearchOptions(r *http.Request) (storage.SearchOptions, error) {
+	query := r.URL.Query()
+	options := storage.SearchOptions{
+		Query:      query.Get("q"),
+		Regex:      query.Get("regex") == "true",
+		IgnoreCase: query.Get("ignore_case") == "true",
+	}
+
+	if value := query.Get("context"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return options, fmt.Errorf("bad context")
+		}
+
+		options.ContextLines = n
+	}
+
+	if value := query.Get("limit"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n <= 0 {
+			return options, fmt.Errorf("bad limit")
+		}
+
+		options.Limit = n
+	}
+
+	return options, options.Validate()
+}
+
+// searchResponseWriter writes a {"matches": [...], "truncated": bool} response,
+// one match at a time. Nothing is written until the first match is found,
+// so errors happening before that can still be reported with a proper status code.
+type searchResponseWriter struct {
+	w          http.ResponseWriter
+	controller *http.ResponseController
+	started    bool
+}
+
+func (writer *searchResponseWriter) begin() error {
+	writer.w.Header().Set("Content-Type", "application/json")
+	_, err := io.WriteString(writer.w, `{"matches":[`)
+	if err != nil {
+		return fmt.Errorf("error writing beginning of 'matches' field: %v", err)
+	}
+
+	writer.started = true
+	return nil
+}
+
+func (writer *searchResponseWriter) WriteMatch(match *storage.SearchMatch) error {
+	if !writer.started {
+		if err := writer.begin(); err != nil {
+			return err
+		}
+	} else {
+		_, err := io.WriteString(writer.w, ",")
+		if err != nil {
+			return fmt.Errorf("error writing comma: %v", err)
+		}
+	}
+
+	response := SearchMatchResponse{
+		SearchLineResponse: toSearchLineResponse(match.SearchLine),
+		Before:             toSearchLineResponses(match.Before),
+		After:              toSearchLineResponses(match.After),
+	}
+
+	b, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Errorf("error encoding match %d: %v", match.Token, err)
+	}
+
+	_, err = writer.w.Write(b)
+	if err != nil {
+		return fmt.Errorf("error writing match %d: %v", match.Token, err)
+	}
+
+	err = writer.controller.Flush()
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return fmt.Errorf("error flushing match %d: %v", match.Token, err)
+	}
+
+	return nil
+}
+
+func (writer *searchResponseWriter) Finish(truncated bool) error {
+	if !writer.started {
+		if err := writer.begin(); err != nil {
+			return err
+		}
+	}
+
+	_, err := io.WriteString(writer.w, fmt.Sprintf(`],"truncated":%t}`, truncated))
+	if err != nil {
+		return fmt.Errorf("error closing 'matches' field: %v", err)
+	}
+
+	return nil
+}
+
+func toSearchLineResponse(line storage.SearchLine) SearchLineResponse {
+	return SearchLineResponse{Token: line.Token, Event: line.Event}
+}
+
+func toSearchLineResponses(lines []storage.SearchLine) []SearchLineResponse {
+	responses := make([]SearchLineResponse, 0, len(lines))
+	for _, line := range lines {
+		responses = append(responses, toSearchLineResponse(line))
+	}
+
+	return responses
+}
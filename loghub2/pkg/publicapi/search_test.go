@@ -0,0 +1,199 @@
+package publicapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semaphoreio/semaphore/loghub2/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type searchResponse struct {
+	Matches   []SearchMatchResponse `json:"matches"`
+	Truncated bool                  `json:"truncated"`
+}
+
+func Test__SearchLogs(t *testing.T) {
+	jobId := uuid.NewString()
+	request, _ := http.NewRequest("POST", fmt.Sprintf("/api/v1/logs/%s?start_from=0", jobId), strings.NewReader(LOGS))
+	response := executeRequest(request, generateJwtToken(jobId, "PUSH"))
+	require.Equal(t, 200, response.Code)
+
+	search := func(query url.Values, token string) (int, *searchResponse) {
+		request, _ := http.NewRequest("GET", fmt.Sprintf("/api/v1/logs/%s/search?%s", jobId, query.Encode()), nil)
+		response := executeRequest(request, token)
+		if response.Code != 200 {
+			return response.Code, nil
+		}
+
+		body := searchResponse{}
+		require.NoError(t, json.Unmarshal(response.Body.Bytes(), &body))
+		return response.Code, &body
+	}
+
+	t.Run("no token => 401", func(t *testing.T) {
+		code, _ := search(url.Values{"q": {"VAR"}}, "")
+		assert.Equal(t, 401, code)
+	})
+
+	t.Run("push token => 401", func(t *testing.T) {
+		code, _ := search(url.Values{"q": {"VAR"}}, generateJwtToken(jobId, "PUSH"))
+		assert.Equal(t, 401, code)
+	})
+
+	t.Run("bad options => 400", func(t *testing.T) {
+		for _, query := range []url.Values{
+			{},
+			{"q": {"VAR["}, "regex": {"true"}},
+			{"q": {"VAR"}, "context": {"abc"}},
+			{"q": {"VAR"}, "context": {"-1"}},
+			{"q": {"VAR"}, "limit": {"0"}},
+		} {
+			code, _ := search(query, generateJwtToken(jobId, "PULL"))
+			assert.Equal(t, 400, code, query.Encode())
+		}
+	})
+
+	t.Run("logs do not exist => 404", func(t *testing.T) {
+		otherJobId := uuid.NewString()
+		request, _ := http.NewRequest("GET", fmt.Sprintf("/api/v1/logs/%s/search?q=VAR", otherJobId), nil)
+		response := executeRequest(request, generateJwtToken(otherJobId, "PULL"))
+		assert.Equal(t, 404, response.Code)
+	})
+
+	t.Run("no matches => 200", func(t *testing.T) {
+		code, body := search(url.Values{"q": {"nothing"}}, generateJwtToken(jobId, "PULL"))
+		require.Equal(t, 200, code)
+		assert.Empty(t, body.Matches)
+		assert.False(t, body.Truncated)
+	})
+
+	t.Run("matches with context => 200", func(t *testing.T) {
+		code, body := search(url.Values{"q": {"var[13]"}, "regex": {"true"}, "ignore_case": {"true"}, "context": {"1"}}, generateJwtToken(jobId, "PULL"))
+		require.Equal(t, 200, code)
+		require.Len(t, body.Matches, 2)
+
+		assert.Equal(t, int64(2), body.Matches[0].Token)
+		assert.JSONEq(t, `{"event": "cmd_output", "timestamp": 1624541916, "output": "Exporting VAR1\n"}`, string(body.Matches[0].Event))
+		require.Len(t, body.Matches[0].Before, 1)
+		assert.Equal(t, int64(1), body.Matches[0].Before[0].Token)
+		require.Len(t, body.Matches[0].After, 1)
+		assert.Equal(t, int64(3), body.Matches[0].After[0].Token)
+
+		assert.Equal(t, int64(4), body.Matches[1].Token)
+		assert.Empty(t, body.Matches[1].After)
+		assert.False(t, body.Truncated)
+	})
+
+	t.Run("limit => 200", func(t *testing.T) {
+		code, body := search(url.Values{"q": {"Exporting"}, "limit": {"2"}}, generateJwtToken(jobId, "PULL"))
+		require.Equal(t, 200, code)
+		require.Len(t, body.Matches, 2)
+		assert.Equal(t, int64(1), body.Matches[0].Token)
+		assert.Equal(t, int64(2), body.Matches[1].Token)
+		assert.True(t, body.Truncated)
+	})
+}
+
+// blockingStorage serves archived logs, but keeps the readers open
+// after their content is read, until the test releases them.
+type blockingStorage struct {
+	storage.Storage
+	release chan struct{}
+}
+
+type blockingReader struct {
+	io.Reader
+	ctx     context.Context
+	release chan struct{}
+}
+
+func (r *blockingReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if err != io.EOF {
+		return n, err
+	}
+
+	select {
+	case <-r.release:
+		return n, io.EOF
+	case <-r.ctx.Done():
+		return n, r.ctx.Err()
+	}
+}
+
+func (r *blockingReader) Close() error {
+	return nil
+}
+
+func (s *blockingStorage) ReadFileAsReader(ctx context.Context, fileName string) (io.ReadCloser, error) {
+	reader, err := s.Storage.ReadFileAsReader(ctx, fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blockingReader{Reader: reader, ctx: ctx, release: s.release}, nil
+}
+
+func Test__SearchIsStreamed(t *testing.T) {
+	ctx := context.Background()
+	jobId := uuid.NewString()
+	require.NoError(t, redisStorage.AppendLogs(jobId, 0, []string{
+		`{"event":"cmd_output","output":"first match"}`,
+		`{"event":"cmd_output","output":"something else"}`,
+		`{"event":"cmd_output","output":"second match"}`,
+	}))
+
+	cloudStorage := &blockingStorage{Storage: storage.NewMemoryStorage(), release: make(chan struct{})}
+	fileName, _, err := redisStorage.GetLogsAsFile(ctx, jobId, 200)
+	require.NoError(t, err)
+	require.NoError(t, storage.Gzip(ctx, fileName))
+	require.NoError(t, cloudStorage.SaveFile(ctx, fileName+".gz", jobId))
+	_, err = redisStorage.DeleteLogs(ctx, jobId)
+	require.NoError(t, err)
+
+	server, err := NewServer(redisStorage, cloudStorage, privateKey)
+	require.NoError(t, err)
+	server.SetTimeoutHandlerTimeout(time.Second)
+	port := 11000 + rand.Intn(1000)
+	go server.Serve("0.0.0.0", port)
+	defer server.Close()
+
+	require.Eventually(t, func() bool {
+		res, err := runHTTP("GET", "", "", port)
+		return err == nil && res.StatusCode == http.StatusOK
+	}, time.Second, 10*time.Millisecond)
+
+	res, err := runHTTP("GET", fmt.Sprintf("api/v1/logs/%s/search?q=match", jobId), generateJwtToken(jobId, "PULL"), port)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+
+	// the first match arrives while the search is still going
+	buffer := make([]byte, 1024)
+	n, err := res.Body.Read(buffer)
+	require.NoError(t, err)
+	assert.Contains(t, string(buffer[:n]), "first match")
+
+	// and the search is not cut off by the timeout handler
+	time.Sleep(1500 * time.Millisecond)
+	close(cloudStorage.release)
+
+	rest, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+
+	body := searchResponse{}
+	require.NoError(t, json.Unmarshal(append(buffer[:n], rest...), &body))
+	assert.Len(t, body.Matches, 2)
+	assert.False(t, body.Truncated)
+}
@@ -2,7 +2,6 @@ package publicapi
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -17,16 +16,22 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/renderedtext/go-watchman"
 	"github.com/semaphoreio/semaphore/loghub2/pkg/auth"
+	"github.com/semaphoreio/semaphore/loghub2/pkg/redaction"
 	"github.com/semaphoreio/semaphore/loghub2/pkg/storage"
+	"github.com/semaphoreio/semaphore/loghub2/pkg/utils"
 )
 
 type Server struct {
-	httpServer            *http.Server
-	Router                *mux.Router
-	redisStorage          *storage.RedisStorage
-	cloudStorage          storage.Storage
-	privateKey            string
-	timeoutHandlerTimeout time.Duration
+	httpServer              *http.Server
+	Router                  *mux.Router
+	redisStorage            *storage.RedisStorage
+	cloudStorage            storage.Storage
+	redactionStage          *redaction.Stage
+	privateKey              string
+	timeoutHandlerTimeout   time.Duration
+	streamKeepAliveInterval time.Duration
+	streamMaxDuration       time.Duration
+	searchMaxDuration       time.Duration
 }
 
 func NewServer(
@@ -37,8 +42,12 @@ func NewServer(
 	server := &Server{}
 	server.redisStorage = redisStorage
 	server.cloudStorage = cloudStorage
+	server.redactionStage = redaction.NewStage(redisStorage)
 	server.privateKey = privateKey
 	server.timeoutHandlerTimeout = 20 * time.Second
+	server.streamKeepAliveInterval = defaultStreamKeepAliveInterval
+	server.streamMaxDuration = defaultStreamMaxDuration
+	server.searchMaxDuration = defaultSearchMaxDuration
 
 	server.InitRouter(additionalMiddlewares...)
 
@@ -53,6 +62,8 @@ func (s *Server) InitRouter(additionalMiddlewares ...mux.MiddlewareFunc) {
 	authenticatedRoute := r.Methods(http.MethodPost, http.MethodGet).Subrouter()
 	authenticatedRoute.HandleFunc(basePath+"/{job_id}", s.ReceiveLogs).Methods("POST")
 	authenticatedRoute.HandleFunc(basePath+"/{job_id}", s.SendLogs).Methods("GET")
+	authenticatedRoute.HandleFunc(basePath+"/{job_id}/stream", s.StreamLogs).Methods("GET")
+	authenticatedRoute.HandleFunc(basePath+"/{job_id}/search", s.SearchLogs).Methods("GET")
 	authenticatedRoute.Use(authMiddleware)
 	authenticatedRoute.Use(additionalMiddlewares...)
 
@@ -69,16 +80,25 @@ func (s *Server) Serve(host string, port int) error {
 	// than for GET /logs, but I didn't find a good way to do it yet.
 	// Having a bigger timeout than needed is still better than having no timeouts at all,
 	// so this is still an improvement.
+	// Live log streams and searches write their responses as they go,
+	// and the timeout handler would buffer them until the end,
+	// so they skip it, and manage their own write deadlines.
+	loggingHandler := handlers.LoggingHandler(os.Stdout, s.Router)
+	timeoutHandler := http.TimeoutHandler(loggingHandler, s.timeoutHandlerTimeout, "request timed out")
+
 	s.httpServer = &http.Server{
 		Addr:         address,
 		ReadTimeout:  5 * time.Second,
 		WriteTimeout: 30 * time.Second,
 		IdleTimeout:  60 * time.Second,
-		Handler: http.TimeoutHandler(
-			handlers.LoggingHandler(os.Stdout, s.Router),
-			s.timeoutHandlerTimeout,
-			"request timed out",
-		),
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isStreamRequest(r) || isSearchRequest(r) {
+				loggingHandler.ServeHTTP(w, r)
+				return
+			}
+
+			timeoutHandler.ServeHTTP(w, r)
+		}),
 	}
 
 	return s.httpServer.ListenAndServe()
@@ -88,6 +108,18 @@ func (s *Server) SetTimeoutHandlerTimeout(t time.Duration) {
 	s.timeoutHandlerTimeout = t
 }
 
+func (s *Server) SetStreamKeepAliveInterval(t time.Duration) {
+	s.streamKeepAliveInterval = t
+}
+
+func (s *Server) SetStreamMaxDuration(t time.Duration) {
+	s.streamMaxDuration = t
+}
+
+func (s *Server) SetSearchMaxDuration(t time.Duration) {
+	s.searchMaxDuration = t
+}
+
 func (s *Server) Close() {
 	if err := s.httpServer.Close(); err != nil {
 		log.Printf("Error closing server: %v", err)
@@ -137,7 +169,14 @@ func (s *Server) ReceiveLogs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = s.redisStorage.AppendLogsWithContext(r.Context(), jobId, startFrom, strings.Split(string(body), "\n"))
+	logs, err := s.redactionStage.Redact(r.Context(), jobId, startFrom, utils.FilterEmpty(strings.Split(string(body), "\n")))
+	if err != nil {
+		log.Printf("Error redacting logs for %s: %v", jobId, err)
+		http.Error(w, "Error appending logs", http.StatusInternalServerError)
+		return
+	}
+
+	err = s.redisStorage.AppendLogsWithContext(r.Context(), jobId, startFrom, logs)
 	if err == nil {
 		w.WriteHeader(200)
 		return
@@ -186,22 +225,22 @@ func (s *Server) SendLogs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	token := int64(0)
-	tokenString := r.URL.Query().Get("token")
-	if tokenString != "" {
-		token, err = strconv.ParseInt(tokenString, 10, 64)
-		if err != nil {
-			http.Error(w, "bad token", http.StatusBadRequest)
-			return
-		}
+	lineRange, err := parseLineRange(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
 	}
 
-	rawLogs := r.URL.Query().Get("raw") == "true"
+	format, err := parseFormat(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 
 	// If there are logs in Redis for this job,
 	// it means the job did not finish yet, so we grab all the logs from Redis.
 	if s.redisStorage.JobIdExists(r.Context(), jobId) {
-		err := s.streamLogsFromRedis(r.Context(), jobId, token, rawLogs, w)
+		err := s.streamLogsFromRedis(r.Context(), jobId, lineRange, format, w)
 		if err != nil {
 			log.Printf("Error getting logs for %s from Redis: %v", jobId, err)
 			http.Error(w, "error getting logs", http.StatusInternalServerError)
@@ -219,112 +258,185 @@ func (s *Server) SendLogs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = s.streamLogsFromCloudStorage(r.Context(), jobId, token, rawLogs, w)
+	err = s.streamLogsFromCloudStorage(r.Context(), jobId, lineRange, format, w)
 	if err != nil {
 		log.Printf("Error getting logs for %s from cloud storage: %v", jobId, err)
 		http.Error(w, "error getting logs", http.StatusInternalServerError)
 	}
 }
 
-func (s *Server) streamLogsFromRedis(ctx context.Context, jobId string, token int64, rawLogs bool, w http.ResponseWriter) error {
-	logs, err := s.redisStorage.GetLogsUsingRange(ctx, jobId, token, -1)
-	if err != nil {
-		log.Printf("Error getting logs from Redis: %v", err)
-		return err
+// parseFormat reads the format the logs should be returned in.
+// raw=true is still accepted, for clients using it before formats existed.
+func parseFormat(r *http.Request) (string, error) {
+	query := r.URL.Query()
+	format := query.Get("format")
+	raw := query.Get("raw") == "true"
+
+	if raw && format != "" && format != FormatRaw {
+		return "", fmt.Errorf("raw can't be used with format")
 	}
 
-	if rawLogs {
-		return s.streamRawLogsFromRedis(logs, w)
+	if raw {
+		return FormatRaw, nil
 	}
 
-	return s.streamJSONLogsFromRedis(logs, token, w)
+	switch format {
+	case "":
+		return FormatJSON, nil
+	case FormatJSON, FormatJSONL, FormatText, FormatHTML, FormatRaw:
+		return format, nil
+	default:
+		return "", fmt.Errorf("bad format")
+	}
 }
 
-func (s *Server) streamJSONLogsFromRedis(logs []string, token int64, w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
+// parseLineRange reads the lines requested from the query parameters:
+//   - token or from: index of the first line to return.
+//   - to: index of the line to stop at. The line itself is not returned.
+//   - tail: return only the last N lines. Can't be used with from/to.
+func parseLineRange(r *http.Request) (storage.LineRange, error) {
+	lineRange := storage.AllLines()
+	query := r.URL.Query()
+
+	parse := func(name string) (int64, bool, error) {
+		value := query.Get(name)
+		if value == "" {
+			return 0, false, nil
+		}
+
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || n < 0 {
+			return 0, false, fmt.Errorf("bad %s", name)
+		}
+
+		return n, true, nil
+	}
 
-	jsonWriter := NewJSONResponseWriter(w, token, false)
-	err := jsonWriter.Begin()
+	token, hasToken, err := parse("token")
 	if err != nil {
-		return err
+		return lineRange, err
 	}
 
-	for _, line := range logs {
-		err = jsonWriter.WriteEvent([]byte(line))
-		if err != nil {
-			return err
-		}
+	from, hasFrom, err := parse("from")
+	if err != nil {
+		return lineRange, err
 	}
 
-	return jsonWriter.Finish()
-}
+	to, hasTo, err := parse("to")
+	if err != nil {
+		return lineRange, err
+	}
 
-func (s *Server) streamRawLogsFromRedis(logs []string, w http.ResponseWriter) error {
-	for _, log := range logs {
-		err := s.writeRaw(w, []byte(log))
-		if err != nil {
-			return err
+	tail, hasTail, err := parse("tail")
+	if err != nil {
+		return lineRange, err
+	}
+
+	if hasTail {
+		if hasToken || hasFrom || hasTo {
+			return lineRange, fmt.Errorf("tail can't be used with token, from or to")
 		}
+
+		if tail == 0 {
+			return lineRange, fmt.Errorf("bad tail")
+		}
+
+		lineRange.Tail = tail
+		return lineRange, nil
 	}
 
-	return nil
-}
+	if hasToken {
+		lineRange.From = token
+	}
 
-func (s *Server) streamLogsFromCloudStorage(ctx context.Context, jobId string, token int64, rawLogs bool, w http.ResponseWriter) error {
-	zippedReader, err := s.cloudStorage.ReadFileAsReader(ctx, jobId)
-	if err != nil {
-		return err
+	if hasFrom {
+		lineRange.From = from
 	}
 
-	defer zippedReader.Close()
+	if hasTo {
+		if to < lineRange.From {
+			return lineRange, fmt.Errorf("to must not be smaller than from")
+		}
 
-	if rawLogs {
-		return storage.GunzipWithReader(zippedReader, func(line []byte) error {
-			return s.writeRaw(w, line)
-		})
+		lineRange.To = to
 	}
 
-	return s.streamJSONLogsFromCloudStorage(w, zippedReader, token)
+	return lineRange, nil
 }
 
-func (s *Server) streamJSONLogsFromCloudStorage(w http.ResponseWriter, zippedReader io.Reader, token int64) error {
-	w.Header().Set("Content-Type", "application/json")
-	jsonWriter := NewJSONResponseWriter(w, token, true)
-	err := jsonWriter.Begin()
+func (s *Server) streamLogsFromRedis(ctx context.Context, jobId string, lineRange storage.LineRange, format string, w http.ResponseWriter) error {
+	start, end, err := s.redisRange(ctx, jobId, lineRange)
 	if err != nil {
+		log.Printf("Error getting logs count from Redis: %v", err)
 		return err
 	}
 
-	i := int64(0)
-	err = storage.GunzipWithReader(zippedReader, func(line []byte) error {
-		if i >= token {
-			return jsonWriter.WriteEvent(line)
+	logs := []string{}
+	if end < 0 || end >= start {
+		logs, err = s.redisStorage.GetLogsUsingRange(ctx, jobId, start, end)
+		if err != nil {
+			log.Printf("Error getting logs from Redis: %v", err)
+			return err
 		}
+	}
 
-		return nil
-	})
+	logsWriter, err := NewLogsWriter(w, format, jobId, start, false)
+	if err != nil {
+		return err
+	}
 
+	w.Header().Set("Content-Type", ContentTypeForFormat(format))
+	err = logsWriter.Begin()
 	if err != nil {
 		return err
 	}
 
-	return jsonWriter.Finish()
+	for _, line := range logs {
+		err = logsWriter.WriteEvent([]byte(line))
+		if err != nil {
+			return err
+		}
+	}
+
+	return logsWriter.Finish()
 }
 
-func (s *Server) writeRaw(w http.ResponseWriter, line []byte) error {
-	v := make(map[string]interface{})
-	err := json.Unmarshal(line, &v)
+// redisRange converts the line range into LRANGE start/end indexes.
+// Only tailing needs to know how many lines there are.
+func (s *Server) redisRange(ctx context.Context, jobId string, lineRange storage.LineRange) (int64, int64, error) {
+	if lineRange.Tail > 0 {
+		count, err := s.redisStorage.GetLogsCount(ctx, jobId)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		start, _ := lineRange.Resolve(count)
+		return start, -1, nil
+	}
+
+	if lineRange.To < 0 {
+		return lineRange.From, -1, nil
+	}
+
+	return lineRange.From, lineRange.To - 1, nil
+}
+
+func (s *Server) streamLogsFromCloudStorage(ctx context.Context, jobId string, lineRange storage.LineRange, format string, w http.ResponseWriter) error {
+	logsWriter, err := NewLogsWriter(w, format, jobId, lineRange.From, true)
 	if err != nil {
-		return fmt.Errorf("error parsing line '%v': %v", string(line), err)
+		return err
 	}
 
-	// if we are returning only raw logs, we only need a few event types.
-	switch v["event"] {
-	case "cmd_started":
-		_, err = w.Write([]byte(v["directive"].(string) + "\n"))
-	case "cmd_output":
-		_, err = w.Write([]byte(v["output"].(string)))
+	w.Header().Set("Content-Type", ContentTypeForFormat(format))
+	err = logsWriter.Begin()
+	if err != nil {
+		return err
+	}
+
+	err = storage.ReadArchivedLogs(ctx, s.cloudStorage, jobId, lineRange, logsWriter.WriteEvent)
+	if err != nil {
+		return err
 	}
 
-	return err
+	return logsWriter.Finish()
 }
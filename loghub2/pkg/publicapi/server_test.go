@@ -39,6 +39,7 @@ var redisStorage = storage.NewRedisStorage(storage.RedisConfig{
 	Password:       "",
 	MaxAppendItems: 10,
 	MaxKeySize:     1024,
+	SecretsKey:     "test-key",
 })
 
 var testServer, _ = NewServer(redisStorage, gcsStorage, privateKey)
@@ -120,6 +121,21 @@ func Test__PushLogs(t *testing.T) {
 	})
 }
 
+func Test__PushedLogsAreRedacted(t *testing.T) {
+	jobId := uuid.NewString()
+	err := redisStorage.SaveJobSecrets(context.Background(), jobId, []string{"s3cr3t-value"})
+	assert.Nil(t, err)
+
+	logs := `{"event": "cmd_output", "timestamp": 1624541916, "output": "s3cr3t-value\n"}`
+	request, _ := http.NewRequest("POST", fmt.Sprintf("/api/v1/logs/%s?start_from=0", jobId), strings.NewReader(logs))
+	response := executeRequest(request, generateJwtToken(jobId, "PUSH"))
+	assert.Equal(t, response.Code, 200)
+
+	stored, err := redisStorage.GetLogsUsingRange(context.Background(), jobId, 0, -1)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{`{"event":"cmd_output","output":"[REDACTED]\n","timestamp":1624541916}`}, stored)
+}
+
 func Test__PullLogs(t *testing.T) {
 	t.Run("no token => 401", func(t *testing.T) {
 		jobId := "NoTokenWhenPullingLogsYields401"
@@ -188,6 +204,63 @@ func Test__PullLogs(t *testing.T) {
 		assert.Equal(t, string(response.Body.Bytes()[:]), expectedResponse(LOGS, 3))
 	})
 
+	t.Run("using from and to => 200", func(t *testing.T) {
+		jobId := "LogsCanBePulledWithFromAndTo"
+		request, _ := http.NewRequest("POST", fmt.Sprintf("/api/v1/logs/%s?start_from=0", jobId), strings.NewReader(LOGS))
+		executeRequest(request, generateJwtToken(jobId, "PUSH"))
+
+		request, _ = http.NewRequest("GET", fmt.Sprintf("/api/v1/logs/%s?from=1&to=3", jobId), nil)
+		response := executeRequest(request, generateJwtToken(jobId, "PULL"))
+		assert.Equal(t, response.Code, 200)
+		assert.Equal(t, string(response.Body.Bytes()[:]), expectedRangeResponse(LOGS, 1, 3))
+	})
+
+	t.Run("using tail => 200", func(t *testing.T) {
+		jobId := "LogsCanBePulledWithTail"
+		request, _ := http.NewRequest("POST", fmt.Sprintf("/api/v1/logs/%s?start_from=0", jobId), strings.NewReader(LOGS))
+		executeRequest(request, generateJwtToken(jobId, "PUSH"))
+
+		request, _ = http.NewRequest("GET", fmt.Sprintf("/api/v1/logs/%s?tail=2", jobId), nil)
+		response := executeRequest(request, generateJwtToken(jobId, "PULL"))
+		assert.Equal(t, response.Code, 200)
+		assert.Equal(t, string(response.Body.Bytes()[:]), expectedRangeResponse(LOGS, 3, 5))
+	})
+
+	t.Run("using tail from cloud storage => 200", func(t *testing.T) {
+		_ = gcsStorage.CreateBucket(TestBucketName, "whatever")
+
+		jobId := "ArchivedLogsCanBePulledWithTail"
+		request, _ := http.NewRequest("POST", fmt.Sprintf("/api/v1/logs/%s?start_from=0", jobId), strings.NewReader(LOGS))
+		executeRequest(request, generateJwtToken(jobId, "PUSH"))
+
+		fileName, _, err := redisStorage.GetLogsAsFile(context.Background(), jobId, 200)
+		assert.Nil(t, err)
+		gzippedFileName, index, err := storage.GzipChunked(context.Background(), fileName, 2)
+		assert.Nil(t, err)
+		assert.Nil(t, gcsStorage.SaveFile(context.Background(), gzippedFileName, jobId))
+		assert.Nil(t, storage.SaveArchiveIndex(context.Background(), gcsStorage, jobId, index))
+		_, _ = redisStorage.DeleteLogs(context.Background(), jobId)
+		os.Remove(gzippedFileName)
+
+		url := fmt.Sprintf("/api/v1/logs/%s?jwt=%s&raw=true&tail=2", jobId, generateJwtToken(jobId, "PULL"))
+		request, _ = http.NewRequest("GET", url, nil)
+		request.Header.Set("x-semaphore-org-id", uuid.NewString())
+		response := httptest.NewRecorder()
+		testServer.Router.ServeHTTP(response, request)
+
+		assert.Equal(t, response.Code, 200)
+		assert.Equal(t, string(response.Body.Bytes()[:]), "Exporting VAR2\nExporting VAR3\n")
+	})
+
+	t.Run("bad line range => 400", func(t *testing.T) {
+		jobId := "BadLineRangeYields400"
+		for _, query := range []string{"from=-1", "to=abc", "from=3&to=1", "tail=0", "tail=2&from=1"} {
+			request, _ := http.NewRequest("GET", fmt.Sprintf("/api/v1/logs/%s?%s", jobId, query), nil)
+			response := executeRequest(request, generateJwtToken(jobId, "PULL"))
+			assert.Equal(t, response.Code, 400, query)
+		}
+	})
+
 	t.Run("job does not exist => 404", func(t *testing.T) {
 		jobId := "this-job-id-does-not-exist"
 		request, _ := http.NewRequest("GET", fmt.Sprintf("/api/v1/logs/%s", jobId), nil)
@@ -242,6 +315,7 @@ func uploadToRedis(jobId string) error {
 		Password:       "",
 		MaxAppendItems: 2000,
 		MaxKeySize:     16 * 1024 * 1024,
+		SecretsKey:     "test-key",
 	})
 
 	f, err := os.Open("testdata/16mb-logs")
@@ -332,6 +406,20 @@ func expectedResponse(logs string, startFrom int64) string {
 	return buf.String()
 }
 
+func expectedRangeResponse(logs string, from, to int64) string {
+	logEvents := utils.FilterEmpty(strings.Split(logs, "\n"))
+	buf := bytes.NewBuffer(make([]byte, 0))
+	responseWriter := NewJSONResponseWriter(buf, from, false)
+	_ = responseWriter.Begin()
+
+	for _, logEvent := range logEvents[from:to] {
+		_ = responseWriter.WriteEvent([]byte(logEvent))
+	}
+
+	_ = responseWriter.Finish()
+	return buf.String()
+}
+
 func generateJwtToken(subject, action string) string {
 	token, _ := auth.GenerateToken(privateKey, subject, action, time.Minute)
 	return token
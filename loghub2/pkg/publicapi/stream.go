@@ -0,0 +1,314 @@
+package publicapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/renderedtext/go-watchman"
+	"github.com/semaphoreio/semaphore/loghub2/pkg/auth"
+	"github.com/semaphoreio/semaphore/loghub2/pkg/storage"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	defaultStreamKeepAliveInterval = 15 * time.Second
+
+	// Streams are not kept open forever.
+	// Clients are expected to reconnect using the last token they received.
+	defaultStreamMaxDuration = 30 * time.Minute
+)
+
+// LogStreamWriter sends log events to a live log stream client.
+type LogStreamWriter interface {
+	WriteEvent(token int64, event []byte) error
+	Flush() error
+	KeepAlive() error
+	Finish() error
+}
+
+func isStreamRequest(r *http.Request) bool {
+	return strings.HasSuffix(strings.TrimSuffix(r.URL.Path, "/"), "/stream")
+}
+
+// StreamLogs pushes new log events for a job as they are received,
+// using Server-Sent Events, or WebSocket if the client asks for an upgrade.
+// Clients can resume a stream by using the Last-Event-ID header
+// or the token query parameter. The stream is finished when the job logs are archived.
+// Jobs that didn't send any logs yet are streamed too, starting with their first event.
+func (s *Server) StreamLogs(w http.ResponseWriter, r *http.Request) {
+	defer watchman.Benchmark(time.Now(), "logs.stream")
+
+	vars := mux.Vars(r)
+	jobId := vars["job_id"]
+	if jobId == "" {
+		log.Printf("job_id is required")
+		http.Error(w, "missing job_id", http.StatusBadRequest)
+		return
+	}
+
+	jwtToken := r.Context().Value(tokenContextKey).(string)
+	err := auth.ValidateToken(jwtToken, s.privateKey, jobId, "PULL")
+	if err != nil {
+		respondWith401(w)
+		return
+	}
+
+	token, err := streamStartToken(r)
+	if err != nil {
+		http.Error(w, "bad token", http.StatusBadRequest)
+		return
+	}
+
+	if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+		s.streamLogsOverWebSocket(w, r, jobId, token)
+		return
+	}
+
+	s.streamLogsOverSSE(w, r, jobId, token)
+}
+
+func streamStartToken(r *http.Request) (int64, error) {
+	tokenString := r.Header.Get("Last-Event-ID")
+	if tokenString == "" {
+		tokenString = r.URL.Query().Get("token")
+	}
+
+	if tokenString == "" {
+		return 0, nil
+	}
+
+	token, err := strconv.ParseInt(tokenString, 10, 64)
+	if err != nil || token < 0 {
+		return 0, fmt.Errorf("bad token %s", tokenString)
+	}
+
+	return token, nil
+}
+
+func (s *Server) streamLogsOverSSE(w http.ResponseWriter, r *http.Request, jobId string, token int64) {
+	controller := http.NewResponseController(w)
+
+	// The stream lives longer than the server write timeout.
+	err := controller.SetWriteDeadline(time.Time{})
+	if err != nil {
+		log.Printf("Error disabling write deadline for %s stream: %v", jobId, err)
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	// The headers are sent right away, even if the job has no logs yet.
+	writer := &SSEWriter{w: w, controller: controller}
+	err = writer.Flush()
+	if err != nil {
+		log.Printf("Error starting %s stream: %v", jobId, err)
+		return
+	}
+
+	err = s.tailLogs(r.Context(), jobId, token, writer)
+	if err != nil {
+		log.Printf("Error streaming logs for %s: %v", jobId, err)
+	}
+}
+
+func (s *Server) streamLogsOverWebSocket(w http.ResponseWriter, r *http.Request, jobId string, token int64) {
+	server := websocket.Server{
+		// Clients are authenticated with the JWT token,
+		// so we don't need to check the origin.
+		Handshake: func(config *websocket.Config, r *http.Request) error {
+			return nil
+		},
+		Handler: func(conn *websocket.Conn) {
+			defer conn.Close()
+
+			// The connection was hijacked from the HTTP server,
+			// so the server read and write timeouts no longer make sense.
+			_ = conn.SetDeadline(time.Time{})
+
+			// Clients are not supposed to send anything.
+			// We only read from the connection to find out when it is closed.
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			go func() {
+				var discard []byte
+				for {
+					if err := websocket.Message.Receive(conn, &discard); err != nil {
+						cancel()
+						return
+					}
+				}
+			}()
+
+			err := s.tailLogs(ctx, jobId, token, &WebSocketWriter{conn: conn})
+			if err != nil {
+				log.Printf("Error streaming logs for %s: %v", jobId, err)
+			}
+		},
+	}
+
+	server.ServeHTTP(w, r)
+}
+
+// tailLogs sends all the logs after token, and then waits for new ones.
+// It returns when the logs are archived, the client goes away,
+// or the stream reaches its maximum duration.
+func (s *Server) tailLogs(ctx context.Context, jobId string, token int64, writer LogStreamWriter) error {
+	subscription, err := s.redisStorage.SubscribeToLogEvents(ctx, jobId)
+	if err != nil {
+		return err
+	}
+
+	defer subscription.Close()
+
+	keepAlive := time.NewTicker(s.streamKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	maxDuration := time.NewTimer(s.streamMaxDuration)
+	defer maxDuration.Stop()
+
+	events := subscription.Channel()
+	for {
+		var finished bool
+		token, finished, err = s.sendNewLogs(ctx, jobId, token, writer)
+		if err != nil {
+			return err
+		}
+
+		if finished {
+			return writer.Finish()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-maxDuration.C:
+			return nil
+		case <-keepAlive.C:
+			// We also look for new logs here,
+			// in case we missed any pub/sub messages.
+			err = writer.KeepAlive()
+			if err != nil {
+				return err
+			}
+		case _, ok := <-events:
+			if !ok {
+				return fmt.Errorf("log events subscription closed")
+			}
+		}
+	}
+}
+
+// sendNewLogs sends the logs after token, and returns the next token.
+// If the logs are no longer in Redis, but they are in cloud storage, the job is finished,
+// so the remaining logs are sent from there. If they are in neither, no logs were sent yet.
+func (s *Server) sendNewLogs(ctx context.Context, jobId string, token int64, writer LogStreamWriter) (int64, bool, error) {
+	if s.redisStorage.JobIdExists(ctx, jobId) {
+		logs, err := s.redisStorage.GetLogsUsingRange(ctx, jobId, token, -1)
+		if err != nil {
+			return token, false, err
+		}
+
+		for _, line := range logs {
+			err = writer.WriteEvent(token, []byte(line))
+			if err != nil {
+				return token, false, err
+			}
+
+			token++
+		}
+
+		return token, false, writer.Flush()
+	}
+
+	exists, err := s.cloudStorage.Exists(ctx, jobId)
+	if !exists || err != nil {
+		return token, false, nil
+	}
+
+	lineRange := storage.LineRange{From: token, To: -1}
+	err = storage.ReadArchivedLogs(ctx, s.cloudStorage, jobId, lineRange, func(line []byte) error {
+		token++
+		return writer.WriteEvent(token-1, line)
+	})
+
+	if err != nil {
+		return token, false, err
+	}
+
+	return token, true, writer.Flush()
+}
+
+// SSEWriter writes log events as Server-Sent Events.
+// The event ID is the token to use to resume the stream.
+type SSEWriter struct {
+	w          http.ResponseWriter
+	controller *http.ResponseController
+}
+
+func (writer *SSEWriter) WriteEvent(token int64, event []byte) error {
+	_, err := fmt.Fprintf(writer.w, "id: %d\nevent: log\ndata: %s\n\n", token+1, bytes.TrimSpace(event))
+	return err
+}
+
+func (writer *SSEWriter) Flush() error {
+	return writer.controller.Flush()
+}
+
+func (writer *SSEWriter) KeepAlive() error {
+	_, err := writer.w.Write([]byte(": keep-alive\n\n"))
+	if err != nil {
+		return err
+	}
+
+	return writer.Flush()
+}
+
+func (writer *SSEWriter) Finish() error {
+	_, err := writer.w.Write([]byte("event: finished\ndata: {}\n\n"))
+	if err != nil {
+		return err
+	}
+
+	return writer.Flush()
+}
+
+// WebSocketWriter writes every log event as a separate JSON message.
+type WebSocketWriter struct {
+	conn *websocket.Conn
+}
+
+type WebSocketMessage struct {
+	Next     int64           `json:"next,omitempty"`
+	Event    json.RawMessage `json:"event,omitempty"`
+	Finished bool            `json:"finished,omitempty"`
+}
+
+func (writer *WebSocketWriter) WriteEvent(token int64, event []byte) error {
+	return websocket.JSON.Send(writer.conn, WebSocketMessage{
+		Next:  token + 1,
+		Event: json.RawMessage(bytes.TrimSpace(event)),
+	})
+}
+
+func (writer *WebSocketWriter) Flush() error {
+	return nil
+}
+
+func (writer *WebSocketWriter) KeepAlive() error {
+	return websocket.Message.Send(writer.conn, "{}")
+}
+
+func (writer *WebSocketWriter) Finish() error {
+	return websocket.JSON.Send(writer.conn, WebSocketMessage{Finished: true})
+}
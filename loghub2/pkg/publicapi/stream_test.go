@@ -0,0 +1,192 @@
+package publicapi
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semaphoreio/semaphore/loghub2/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+)
+
+type sseEvent struct {
+	id   string
+	name string
+	data string
+}
+
+func Test__StreamLogs(t *testing.T) {
+	_ = gcsStorage.CreateBucket(TestBucketName, "whatever")
+
+	server := httptest.NewServer(testServer.Router)
+	defer server.Close()
+
+	t.Run("no logs yet => first event is waited for", func(t *testing.T) {
+		jobId := uuid.NewString()
+		response := openStream(t, server.URL, jobId, generateJwtToken(jobId, "PULL"), "")
+		defer response.Body.Close()
+		require.Equal(t, http.StatusOK, response.StatusCode)
+
+		events := readSSEEvents(response)
+		require.NoError(t, redisStorage.AppendLogs(jobId, 0, []string{`{"event":"job_started"}`}))
+		assert.Equal(t, sseEvent{id: "1", name: "log", data: `{"event":"job_started"}`}, nextEvent(t, events))
+	})
+
+	t.Run("bad auth token => 401", func(t *testing.T) {
+		jobId := uuid.NewString()
+		response := openStream(t, server.URL, jobId, generateJwtToken(jobId, "PUSH"), "")
+		defer response.Body.Close()
+		assert.Equal(t, http.StatusUnauthorized, response.StatusCode)
+	})
+
+	t.Run("new logs are pushed until logs are archived", func(t *testing.T) {
+		jobId := uuid.NewString()
+		require.NoError(t, redisStorage.AppendLogs(jobId, 0, []string{`{"event":"job_started"}`}))
+
+		response := openStream(t, server.URL, jobId, generateJwtToken(jobId, "PULL"), "")
+		defer response.Body.Close()
+		require.Equal(t, http.StatusOK, response.StatusCode)
+		require.Equal(t, "text/event-stream", response.Header.Get("Content-Type"))
+
+		events := readSSEEvents(response)
+		assert.Equal(t, sseEvent{id: "1", name: "log", data: `{"event":"job_started"}`}, nextEvent(t, events))
+
+		require.NoError(t, redisStorage.AppendLogs(jobId, 1, []string{`{"event":"cmd_started"}`, `{"event":"cmd_output"}`}))
+		assert.Equal(t, sseEvent{id: "2", name: "log", data: `{"event":"cmd_started"}`}, nextEvent(t, events))
+		assert.Equal(t, sseEvent{id: "3", name: "log", data: `{"event":"cmd_output"}`}, nextEvent(t, events))
+
+		archiveLogs(t, jobId)
+		assert.Equal(t, sseEvent{name: "finished", data: "{}"}, nextEvent(t, events))
+
+		_, ok := <-events
+		assert.False(t, ok)
+	})
+
+	t.Run("stream is resumed from Last-Event-ID", func(t *testing.T) {
+		jobId := uuid.NewString()
+		require.NoError(t, redisStorage.AppendLogs(jobId, 0, []string{`{"event":"a"}`, `{"event":"b"}`, `{"event":"c"}`}))
+
+		response := openStream(t, server.URL, jobId, generateJwtToken(jobId, "PULL"), "2")
+		defer response.Body.Close()
+		require.Equal(t, http.StatusOK, response.StatusCode)
+
+		events := readSSEEvents(response)
+		assert.Equal(t, sseEvent{id: "3", name: "log", data: `{"event":"c"}`}, nextEvent(t, events))
+	})
+
+	t.Run("archived logs are sent from cloud storage and stream is finished", func(t *testing.T) {
+		jobId := uuid.NewString()
+		require.NoError(t, redisStorage.AppendLogs(jobId, 0, []string{`{"event":"a"}`, `{"event":"b"}`}))
+		archiveLogs(t, jobId)
+
+		response := openStream(t, server.URL, jobId, generateJwtToken(jobId, "PULL"), "1")
+		defer response.Body.Close()
+		require.Equal(t, http.StatusOK, response.StatusCode)
+
+		events := readSSEEvents(response)
+		assert.Equal(t, sseEvent{id: "2", name: "log", data: `{"event":"b"}`}, nextEvent(t, events))
+		assert.Equal(t, sseEvent{name: "finished", data: "{}"}, nextEvent(t, events))
+	})
+
+	t.Run("logs are streamed over websocket", func(t *testing.T) {
+		jobId := uuid.NewString()
+		require.NoError(t, redisStorage.AppendLogs(jobId, 0, []string{`{"event":"job_started"}`}))
+
+		wsURL := strings.Replace(server.URL, "http://", "ws://", 1) + fmt.Sprintf("/api/v1/logs/%s/stream", jobId)
+		config, err := websocket.NewConfig(wsURL, server.URL)
+		require.NoError(t, err)
+		config.Header.Set("x-semaphore-org-id", uuid.NewString())
+		config.Header.Set("Authorization", "Bearer "+generateJwtToken(jobId, "PULL"))
+
+		conn, err := websocket.DialConfig(config)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		message := WebSocketMessage{}
+		require.NoError(t, websocket.JSON.Receive(conn, &message))
+		assert.Equal(t, int64(1), message.Next)
+		assert.JSONEq(t, `{"event":"job_started"}`, string(message.Event))
+
+		require.NoError(t, redisStorage.AppendLogs(jobId, 1, []string{`{"event":"cmd_started"}`}))
+		message = WebSocketMessage{}
+		require.NoError(t, websocket.JSON.Receive(conn, &message))
+		assert.Equal(t, int64(2), message.Next)
+
+		archiveLogs(t, jobId)
+		message = WebSocketMessage{}
+		require.NoError(t, websocket.JSON.Receive(conn, &message))
+		assert.True(t, message.Finished)
+	})
+}
+
+func openStream(t *testing.T, serverURL, jobId, token, lastEventID string) *http.Response {
+	request, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/logs/%s/stream", serverURL, jobId), nil)
+	require.NoError(t, err)
+	request.Header.Set("x-semaphore-org-id", uuid.NewString())
+	request.Header.Set("Authorization", "Bearer "+token)
+	if lastEventID != "" {
+		request.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	require.NoError(t, err)
+	return response
+}
+
+func readSSEEvents(response *http.Response) chan sseEvent {
+	events := make(chan sseEvent, 100)
+	go func() {
+		defer close(events)
+		scanner := bufio.NewScanner(response.Body)
+		event := sseEvent{}
+		for scanner.Scan() {
+			line := scanner.Text()
+			switch {
+			case line == "":
+				if event.name != "" {
+					events <- event
+				}
+
+				event = sseEvent{}
+			case strings.HasPrefix(line, "id: "):
+				event.id = strings.TrimPrefix(line, "id: ")
+			case strings.HasPrefix(line, "event: "):
+				event.name = strings.TrimPrefix(line, "event: ")
+			case strings.HasPrefix(line, "data: "):
+				event.data = strings.TrimPrefix(line, "data: ")
+			}
+		}
+	}()
+
+	return events
+}
+
+func nextEvent(t *testing.T, events chan sseEvent) sseEvent {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for event")
+		return sseEvent{}
+	}
+}
+
+// archiveLogs does what the archivator does when a job finishes.
+func archiveLogs(t *testing.T, jobId string) {
+	ctx := context.Background()
+	fileName, _, err := redisStorage.GetLogsAsFile(ctx, jobId, 200)
+	require.NoError(t, err)
+	require.NoError(t, storage.Gzip(ctx, fileName))
+	require.NoError(t, gcsStorage.SaveFile(ctx, fileName+".gz", jobId))
+	_, err = redisStorage.DeleteLogs(ctx, jobId)
+	require.NoError(t, err)
+	require.NoError(t, redisStorage.PublishLogEvent(ctx, jobId, storage.LogEventArchived))
+}
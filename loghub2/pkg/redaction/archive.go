@@ -0,0 +1,137 @@
+package redaction
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/renderedtext/go-watchman"
+	"github.com/semaphoreio/semaphore/loghub2/pkg/storage"
+)
+
+// Archived logs are redacted in batches of this many events.
+const archiveBatchSize = 1000
+
+// RedactArchivedLogs goes through the archived logs of a job again,
+// and replaces the archive if anything needed to be masked.
+// It returns the number of events that were changed.
+func RedactArchivedLogs(ctx context.Context, cloudStorage storage.Storage, jobId string, redactor *Redactor) (int, error) {
+	defer watchman.Benchmark(time.Now(), "logs.redact.archive")
+
+	file, err := os.CreateTemp("", jobId)
+	if err != nil {
+		return 0, err
+	}
+
+	fileName := file.Name()
+	defer os.Remove(fileName)
+
+	writer := bufio.NewWriter(file)
+	redacted := 0
+
+	// Events are only written once we know no secret
+	// split between them and the next batch can still change them.
+	originals := []string{}
+	events := []string{}
+	pending := 0
+	flush := func(final bool) error {
+		newEvents, _ := redactor.RedactEvents(events)
+		keep := 0
+		if !final {
+			keep = len(trailingOutputs(newEvents))
+		}
+
+		for i, event := range newEvents[:len(newEvents)-keep] {
+			if event != originals[i] {
+				redacted++
+			}
+
+			if _, err := writer.WriteString(event + "\n"); err != nil {
+				return err
+			}
+		}
+
+		originals = append(originals[:0], originals[len(newEvents)-keep:]...)
+		events = append(events[:0], newEvents[len(newEvents)-keep:]...)
+		return nil
+	}
+
+	err = storage.ReadArchivedLogs(ctx, cloudStorage, jobId, storage.AllLines(), func(line []byte) error {
+		event := strings.TrimSuffix(string(line), "\n")
+		originals = append(originals, event)
+		events = append(events, event)
+
+		// The outputs kept from the previous batch don't count towards the next one,
+		// otherwise, up to lookbackBytes of them would be redacted again on every line.
+		pending++
+		if pending < archiveBatchSize {
+			return nil
+		}
+
+		pending = 0
+		return flush(false)
+	})
+
+	if err == nil {
+		err = flush(true)
+	}
+
+	if err == nil {
+		err = writer.Flush()
+	}
+
+	closeErr := file.Close()
+	if err != nil {
+		return 0, fmt.Errorf("error redacting logs for %s: %v", jobId, err)
+	}
+
+	if closeErr != nil {
+		return 0, closeErr
+	}
+
+	if redacted == 0 {
+		return 0, nil
+	}
+
+	gzippedFileName, index, err := storage.GzipChunked(ctx, fileName, storage.DefaultLinesPerChunk)
+	if err != nil {
+		return 0, fmt.Errorf("error compressing redacted logs for %s: %v", jobId, err)
+	}
+
+	defer os.Remove(gzippedFileName)
+
+	// Readers use the index to seek into the archive, so the old index is removed
+	// before the archive is replaced. Until the new index is saved, or if saving it fails,
+	// readers go through the whole archive, which is slower, but always consistent.
+	err = removeArchiveIndex(ctx, cloudStorage, jobId)
+	if err != nil {
+		return 0, fmt.Errorf("error removing index for logs of %s: %v", jobId, err)
+	}
+
+	err = cloudStorage.SaveFile(ctx, gzippedFileName, jobId)
+	if err != nil {
+		return 0, fmt.Errorf("error saving redacted logs for %s: %v", jobId, err)
+	}
+
+	err = storage.SaveArchiveIndex(ctx, cloudStorage, jobId, index)
+	if err != nil {
+		return 0, fmt.Errorf("error saving index for redacted logs for %s: %v", jobId, err)
+	}
+
+	log.Printf("Redacted %d events in archived logs for %s", redacted, jobId)
+	return redacted, nil
+}
+
+func removeArchiveIndex(ctx context.Context, cloudStorage storage.Storage, jobId string) error {
+	// Some storages report missing files as errors, like everywhere else Exists is used.
+	exists, _ := cloudStorage.Exists(ctx, storage.IndexFileName(jobId))
+	if !exists {
+		return nil
+	}
+
+	return cloudStorage.DeleteFile(ctx, storage.IndexFileName(jobId))
+}
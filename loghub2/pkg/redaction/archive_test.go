@@ -0,0 +1,135 @@
+package redaction
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/semaphoreio/semaphore/loghub2/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__RedactArchivedLogs(t *testing.T) {
+	u, _ := url.Parse("http://gcs:4443/")
+	bucketName := "redaction-test"
+	cloudStorage, err := storage.NewGCSStorageWithClient(&http.Client{Transport: storage.RoundTripper(*u)}, bucketName)
+	require.NoError(t, err)
+	require.NoError(t, cloudStorage.CreateBucket(bucketName, uuid.NewString()))
+	defer cloudStorage.DeleteBucket(bucketName)
+
+	ctx := context.Background()
+
+	// The secret is split between the last event of
+	// the first batch and the first event of the second one.
+	events := []string{}
+	for i := 0; i < archiveBatchSize-1; i++ {
+		events = append(events, fmt.Sprintf(`{"event":"cmd_output","timestamp":%d,"output":"line %d\n"}`, i, i))
+	}
+
+	events = append(events,
+		`{"event":"cmd_output","timestamp":1000,"output":"s3cr3t"}`,
+		`{"event":"cmd_output","timestamp":1001,"output":"-value\n"}`,
+		`{"event":"cmd_finished","timestamp":1002,"directive":"echo"}`,
+	)
+
+	file, err := os.CreateTemp("", "*")
+	require.NoError(t, err)
+	_, err = file.WriteString(strings.Join(events, "\n") + "\n")
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	require.NoError(t, storage.Gzip(ctx, file.Name()))
+	defer os.Remove(file.Name() + ".gz")
+
+	jobId := uuid.NewString()
+	require.NoError(t, cloudStorage.SaveFile(ctx, file.Name()+".gz", jobId))
+
+	t.Run("nothing to redact", func(t *testing.T) {
+		redacted, err := RedactArchivedLogs(ctx, cloudStorage, jobId, NewRedactor([]string{"not-there"}))
+		require.NoError(t, err)
+		assert.Equal(t, 0, redacted)
+		assert.Nil(t, storage.FindArchiveIndex(ctx, cloudStorage, jobId))
+	})
+
+	t.Run("secrets are redacted", func(t *testing.T) {
+		redacted, err := RedactArchivedLogs(ctx, cloudStorage, jobId, NewRedactor([]string{"s3cr3t-value"}))
+		require.NoError(t, err)
+		assert.Equal(t, 2, redacted)
+		assert.NotNil(t, storage.FindArchiveIndex(ctx, cloudStorage, jobId))
+
+		lines := []string{}
+		err = storage.ReadArchivedLogs(ctx, cloudStorage, jobId, storage.AllLines(), func(line []byte) error {
+			lines = append(lines, strings.TrimSuffix(string(line), "\n"))
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Len(t, lines, len(events))
+		assert.Equal(t, events[:archiveBatchSize-1], lines[:archiveBatchSize-1])
+		assert.Equal(t, []string{
+			`{"event":"cmd_output","output":"[REDACTED]","timestamp":1000}`,
+			`{"event":"cmd_output","output":"[REDACTED]\n","timestamp":1001}`,
+			`{"event":"cmd_finished","timestamp":1002,"directive":"echo"}`,
+		}, lines[archiveBatchSize-1:])
+	})
+}
+
+// failingIndexStorage fails to save archive indexes.
+type failingIndexStorage struct {
+	*storage.MemoryStorage
+}
+
+func (s *failingIndexStorage) SaveFile(ctx context.Context, fileName, key string) error {
+	if strings.HasSuffix(key, ".index") {
+		return fmt.Errorf("index not saved")
+	}
+
+	return s.MemoryStorage.SaveFile(ctx, fileName, key)
+}
+
+func Test__RedactArchivedLogsWhenIndexIsNotSaved(t *testing.T) {
+	ctx := context.Background()
+	memoryStorage := storage.NewMemoryStorage()
+
+	events := []string{}
+	for i := 0; i < 3*archiveBatchSize; i++ {
+		events = append(events, fmt.Sprintf(`{"event":"cmd_output","timestamp":%d,"output":"line %d with a-long-secret\n"}`, i, i))
+	}
+
+	file, err := os.CreateTemp("", "*")
+	require.NoError(t, err)
+	_, err = file.WriteString(strings.Join(events, "\n") + "\n")
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	gzipped, index, err := storage.GzipChunked(ctx, file.Name(), 100)
+	require.NoError(t, err)
+	defer os.Remove(gzipped)
+
+	jobId := uuid.NewString()
+	require.NoError(t, memoryStorage.SaveFile(ctx, gzipped, jobId))
+	require.NoError(t, storage.SaveArchiveIndex(ctx, memoryStorage, jobId, index))
+
+	_, err = RedactArchivedLogs(ctx, &failingIndexStorage{memoryStorage}, jobId, NewRedactor([]string{"a-long-secret"}))
+	require.ErrorContains(t, err, "index not saved")
+
+	// the old index doesn't match the new archive anymore, so it is gone
+	assert.Nil(t, storage.FindArchiveIndex(ctx, memoryStorage, jobId))
+
+	lines := []string{}
+	err = storage.ReadArchivedLogs(ctx, memoryStorage, jobId, storage.LineRange{From: 2500, To: 2502}, func(line []byte) error {
+		lines = append(lines, strings.TrimSuffix(string(line), "\n"))
+		return nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		`{"event":"cmd_output","output":"line 2500 with [REDACTED]\n","timestamp":2500}`,
+		`{"event":"cmd_output","output":"line 2501 with [REDACTED]\n","timestamp":2501}`,
+	}, lines)
+}
@@ -0,0 +1,110 @@
+package redaction
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/renderedtext/go-watchman"
+	"github.com/semaphoreio/semaphore/loghub2/pkg/storage"
+)
+
+// When new events are received, the last events already stored are
+// looked at again, in case a secret started in them and ends in the new ones.
+// We only look back this many events, and this many bytes of output.
+const (
+	lookbackEvents = 20
+	lookbackBytes  = 64 * 1024
+)
+
+// Stage redacts log events before they are appended to Redis.
+type Stage struct {
+	redisStorage *storage.RedisStorage
+}
+
+func NewStage(redisStorage *storage.RedisStorage) *Stage {
+	return &Stage{redisStorage: redisStorage}
+}
+
+// Redact returns the events with secrets masked.
+// If a secret was split between the new events and the ones already stored,
+// the stored ones are also updated.
+func (s *Stage) Redact(ctx context.Context, jobId string, startFrom int64, events []string) ([]string, error) {
+	defer watchman.Benchmark(time.Now(), "logs.redact")
+
+	secrets, err := s.redisStorage.GetJobSecrets(ctx, jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without secrets, only the default patterns are used,
+	// so there's no value split between requests to look back for.
+	if len(secrets) == 0 {
+		redacted, _ := NewRedactor(secrets).RedactEvents(events)
+		return redacted, nil
+	}
+
+	previous, err := s.previousOutputs(ctx, jobId, startFrom)
+	if err != nil {
+		return nil, err
+	}
+
+	redactor := NewRedactor(secrets)
+	redacted, changed := redactor.RedactEvents(append(previous, events...))
+	for i := range previous {
+		if !changed[i] {
+			continue
+		}
+
+		index := startFrom - int64(len(previous)) + int64(i)
+		err := s.redisStorage.SetLogEvent(ctx, jobId, index, redacted[i])
+		if err != nil {
+			log.Printf("Error redacting event %d for %s: %v", index, jobId, err)
+		}
+	}
+
+	return redacted[len(previous):], nil
+}
+
+// previousOutputs returns the cmd_output events stored right before startFrom.
+func (s *Stage) previousOutputs(ctx context.Context, jobId string, startFrom int64) ([]string, error) {
+	if startFrom == 0 {
+		return []string{}, nil
+	}
+
+	events, err := s.redisStorage.GetLogsUsingRange(ctx, jobId, max(0, startFrom-lookbackEvents), startFrom-1)
+	if err != nil {
+		return nil, err
+	}
+
+	// If there are fewer events than expected,
+	// we can't know their positions, so we don't touch them.
+	if int64(len(events)) != min(startFrom, lookbackEvents) {
+		return []string{}, nil
+	}
+
+	return trailingOutputs(events), nil
+}
+
+// trailingOutputs returns the last consecutive cmd_output events,
+// up to lookbackBytes of output.
+func trailingOutputs(events []string) []string {
+	size := 0
+	first := len(events)
+	for i := len(events) - 1; i >= 0; i-- {
+		e, ok := parseEvent(events[i])
+		if !ok || e["event"] != "cmd_output" {
+			break
+		}
+
+		output, _ := e["output"].(string)
+		size += len(output)
+		if size > lookbackBytes {
+			break
+		}
+
+		first = i
+	}
+
+	return events[first:]
+}
@@ -0,0 +1,140 @@
+package retention
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	"github.com/semaphoreio/semaphore/loghub2/pkg/storage"
+)
+
+const MaxPolicyRules = 10
+const MaxPolicySelectorLength = 100
+const MinPolicyAge = 24 * 3600 // one day
+
+var ErrPolicyTooLong = fmt.Errorf("log retention policy can have at most %d rules", MaxPolicyRules)
+var ErrPolicySelectorTooLong = fmt.Errorf("log retention rule project and branch can be at most %d characters", MaxPolicySelectorLength)
+var ErrPolicyAgeTooShort = fmt.Errorf("logs must be kept for at least a day")
+
+// Policy decides for how long the archived logs of an organization are kept.
+// The first rule matching a job is used. If no rule matches,
+// DefaultAge is used. An age of zero means logs are kept forever.
+type Policy struct {
+	Rules      []Rule `json:"rules"`
+	DefaultAge int64  `json:"default_age"`
+}
+
+// Rule matches jobs by project and branch.
+// Empty selectors match any job. Branch accepts glob patterns, e.g. "release/*".
+type Rule struct {
+	ProjectID     string `json:"project_id"`
+	Branch        string `json:"branch"`
+	ProtectedOnly bool   `json:"protected_only"`
+
+	// In seconds.
+	Age int64 `json:"age"`
+}
+
+func (p *Policy) Validate() error {
+	if len(p.Rules) > MaxPolicyRules {
+		return ErrPolicyTooLong
+	}
+
+	if p.DefaultAge != 0 && p.DefaultAge < MinPolicyAge {
+		return ErrPolicyAgeTooShort
+	}
+
+	for _, rule := range p.Rules {
+		if err := rule.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *Rule) Validate() error {
+	if len(r.ProjectID) > MaxPolicySelectorLength || len(r.Branch) > MaxPolicySelectorLength {
+		return ErrPolicySelectorTooLong
+	}
+
+	if r.Age < MinPolicyAge {
+		return ErrPolicyAgeTooShort
+	}
+
+	if _, err := path.Match(r.Branch, ""); err != nil {
+		return fmt.Errorf("invalid branch pattern '%s'", r.Branch)
+	}
+
+	return nil
+}
+
+func (r *Rule) IsMatching(metadata *storage.JobMetadata) bool {
+	if r.ProjectID != "" && r.ProjectID != metadata.ProjectID {
+		return false
+	}
+
+	if r.ProtectedOnly && !metadata.ProtectedBranch {
+		return false
+	}
+
+	if r.Branch == "" {
+		return true
+	}
+
+	matched, _ := path.Match(r.Branch, metadata.Branch)
+	return matched
+}
+
+// MaxAge returns for how long the logs of a job should be kept,
+// or zero, if they should be kept forever.
+func (p *Policy) MaxAge(metadata *storage.JobMetadata) time.Duration {
+	for _, rule := range p.Rules {
+		if rule.IsMatching(metadata) {
+			return time.Duration(rule.Age) * time.Second
+		}
+	}
+
+	return time.Duration(p.DefaultAge) * time.Second
+}
+
+func SavePolicy(ctx context.Context, cloudStorage storage.Storage, orgId string, policy *Policy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	return storage.SaveJSONFile(ctx, cloudStorage, storage.RetentionPolicyFileName(orgId), policy)
+}
+
+// FindPolicy returns nil if the organization has no retention policy.
+func FindPolicy(ctx context.Context, cloudStorage storage.Storage, orgId string) (*Policy, error) {
+	fileName := storage.RetentionPolicyFileName(orgId)
+	data, err := cloudStorage.ReadFile(ctx, fileName)
+	if err != nil {
+		if isNotFound(ctx, cloudStorage, fileName) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	policy := Policy{}
+	err = json.Unmarshal(data, &policy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+func DeletePolicy(ctx context.Context, cloudStorage storage.Storage, orgId string) error {
+	fileName := storage.RetentionPolicyFileName(orgId)
+	err := cloudStorage.DeleteFile(ctx, fileName)
+	if err != nil && !isNotFound(ctx, cloudStorage, fileName) {
+		return err
+	}
+
+	return nil
+}
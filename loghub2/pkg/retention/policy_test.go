@@ -0,0 +1,87 @@
+package retention
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semaphoreio/semaphore/loghub2/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const day = 24 * 3600
+
+func Test__PolicyValidation(t *testing.T) {
+	t.Run("empty policy is valid", func(t *testing.T) {
+		assert.NoError(t, (&Policy{}).Validate())
+	})
+
+	t.Run("too many rules", func(t *testing.T) {
+		rules := []Rule{}
+		for i := 0; i < MaxPolicyRules; i++ {
+			rules = append(rules, Rule{Age: day})
+		}
+
+		assert.NoError(t, (&Policy{Rules: rules}).Validate())
+		assert.ErrorIs(t, (&Policy{Rules: append(rules, Rule{Age: day})}).Validate(), ErrPolicyTooLong)
+	})
+
+	t.Run("age shorter than a day", func(t *testing.T) {
+		assert.ErrorIs(t, (&Policy{DefaultAge: 3600}).Validate(), ErrPolicyAgeTooShort)
+		assert.ErrorIs(t, (&Policy{Rules: []Rule{{Age: 3600}}}).Validate(), ErrPolicyAgeTooShort)
+	})
+
+	t.Run("selector too long", func(t *testing.T) {
+		branch := strings.Repeat("a", MaxPolicySelectorLength)
+		assert.NoError(t, (&Policy{Rules: []Rule{{Branch: branch, Age: day}}}).Validate())
+		assert.ErrorIs(t, (&Policy{Rules: []Rule{{Branch: branch + "a", Age: day}}}).Validate(), ErrPolicySelectorTooLong)
+	})
+
+	t.Run("bad branch pattern", func(t *testing.T) {
+		assert.Error(t, (&Policy{Rules: []Rule{{Branch: "release/[", Age: day}}}).Validate())
+	})
+}
+
+func Test__PolicyMaxAge(t *testing.T) {
+	policy := &Policy{
+		Rules: []Rule{
+			{ProtectedOnly: true, Age: 365 * day},
+			{ProjectID: "p1", Branch: "release/*", Age: 90 * day},
+		},
+		DefaultAge: 30 * day,
+	}
+
+	assert.Equal(t, 365*24*time.Hour, policy.MaxAge(&storage.JobMetadata{ProjectID: "p1", Branch: "main", ProtectedBranch: true}))
+	assert.Equal(t, 90*24*time.Hour, policy.MaxAge(&storage.JobMetadata{ProjectID: "p1", Branch: "release/v1"}))
+	assert.Equal(t, 30*24*time.Hour, policy.MaxAge(&storage.JobMetadata{ProjectID: "p2", Branch: "release/v1"}))
+	assert.Equal(t, 30*24*time.Hour, policy.MaxAge(&storage.JobMetadata{ProjectID: "p1", Branch: "feature"}))
+
+	assert.Equal(t, time.Duration(0), (&Policy{}).MaxAge(&storage.JobMetadata{}))
+}
+
+func Test__SaveAndFindPolicy(t *testing.T) {
+	ctx := context.Background()
+	cloudStorage := storage.NewMemoryStorage()
+	orgId := uuid.NewString()
+
+	policy, err := FindPolicy(ctx, cloudStorage, orgId)
+	require.NoError(t, err)
+	assert.Nil(t, policy)
+
+	assert.Error(t, SavePolicy(ctx, cloudStorage, orgId, &Policy{DefaultAge: 1}))
+
+	expected := &Policy{Rules: []Rule{{Branch: "main", Age: 365 * day}}, DefaultAge: 30 * day}
+	require.NoError(t, SavePolicy(ctx, cloudStorage, orgId, expected))
+
+	policy, err = FindPolicy(ctx, cloudStorage, orgId)
+	require.NoError(t, err)
+	assert.Equal(t, expected, policy)
+
+	require.NoError(t, DeletePolicy(ctx, cloudStorage, orgId))
+	policy, err = FindPolicy(ctx, cloudStorage, orgId)
+	require.NoError(t, err)
+	assert.Nil(t, policy)
+}
@@ -0,0 +1,235 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/renderedtext/go-watchman"
+	"github.com/semaphoreio/semaphore/loghub2/pkg/storage"
+)
+
+const DefaultSweepInterval = 24 * time.Hour
+
+// Only one sweeper should go through the bucket at a time.
+// The lock expires after the sweep interval, in case the sweeper holding it dies.
+const sweeperLockKey = "loghub2:retention-sweeper-lock"
+
+// The lock is only released by the sweeper holding it,
+// so a sweep that took longer than the lock TTL can't release somebody else's lock.
+const releaseLockScript = `
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`
+
+// Sweeper goes through all archived logs in cloud storage,
+// and deletes the ones older than the retention policy of their organization allows.
+// Logs archived without metadata, or for organizations without a policy, are kept.
+// A report of every sweep is saved in cloud storage too.
+type Sweeper struct {
+	redisStorage *storage.RedisStorage
+	cloudStorage storage.Storage
+	now          func() time.Time
+}
+
+type Report struct {
+	StartedAt   time.Time `json:"started_at"`
+	FinishedAt  time.Time `json:"finished_at"`
+	Visited     int       `json:"visited"`
+	Deleted     int       `json:"deleted"`
+	Errors      int       `json:"errors"`
+	DeletedJobs []string  `json:"deleted_jobs"`
+
+	// Set if the sweep couldn't go through the whole bucket.
+	Error string `json:"error,omitempty"`
+}
+
+func ReportFileName(startedAt time.Time) string {
+	return "retention-sweep-" + startedAt.UTC().Format("20060102T150405Z") + ".report"
+}
+
+func NewSweeper(redisStorage *storage.RedisStorage, cloudStorage storage.Storage) *Sweeper {
+	return &Sweeper{
+		redisStorage: redisStorage,
+		cloudStorage: cloudStorage,
+		now:          time.Now,
+	}
+}
+
+func (s *Sweeper) Start(ctx context.Context, interval time.Duration) {
+	if interval == 0 {
+		interval = DefaultSweepInterval
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			s.sweepWithLock(ctx, interval)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (s *Sweeper) sweepWithLock(ctx context.Context, interval time.Duration) {
+	token := uuid.NewString()
+	acquired, err := s.redisStorage.Client.SetNX(ctx, sweeperLockKey, token, interval).Result()
+	if err != nil {
+		log.Printf("Retention Sweeper: error acquiring lock: %v", err)
+		return
+	}
+
+	if !acquired {
+		log.Printf("Retention Sweeper: another sweep is already running - skipping")
+		return
+	}
+
+	defer s.releaseLock(token)
+
+	report, err := s.Sweep(ctx)
+	if err != nil {
+		log.Printf("Retention Sweeper: error sweeping archived logs: %v", err)
+		report.Error = err.Error()
+	}
+
+	log.Printf("Retention Sweeper: visited %d archived logs, deleted %d, %d errors", report.Visited, report.Deleted, report.Errors)
+
+	err = storage.SaveJSONFile(ctx, s.cloudStorage, ReportFileName(report.StartedAt), report)
+	if err != nil {
+		log.Printf("Retention Sweeper: error saving report: %v", err)
+	}
+}
+
+// The sweep context might be done already, so the lock is released with a new one.
+func (s *Sweeper) releaseLock(token string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := s.redisStorage.Client.Eval(ctx, releaseLockScript, []string{sweeperLockKey}, token).Err()
+	if err != nil {
+		log.Printf("Retention Sweeper: error releasing lock: %v", err)
+	}
+}
+
+// Sweep goes through the bucket once and returns what was deleted.
+// Failing to process a single job does not stop the sweep.
+func (s *Sweeper) Sweep(ctx context.Context) (*Report, error) {
+	defer watchman.Benchmark(time.Now(), "retention.sweep")
+
+	report := &Report{StartedAt: s.now(), DeletedJobs: []string{}}
+	defer func() { report.FinishedAt = s.now() }()
+	policies := map[string]*Policy{}
+
+	err := s.cloudStorage.ListFiles(ctx, func(file storage.FileInfo) error {
+		// Indexes and metadata are handled together with their logs.
+		// Retention policies and sweep reports are not logs.
+		if strings.Contains(file.Name, ".") {
+			return nil
+		}
+
+		report.Visited++
+		deleted, err := s.sweepJob(ctx, file, policies)
+		if err != nil {
+			log.Printf("Retention Sweeper: error processing %s: %v", file.Name, err)
+			report.Errors++
+			_ = watchman.Increment("retention.sweeper.errors")
+			return nil
+		}
+
+		if deleted {
+			log.Printf("Retention Sweeper: deleted expired logs for %s", file.Name)
+			report.Deleted++
+			report.DeletedJobs = append(report.DeletedJobs, file.Name)
+			_ = watchman.Increment("retention.sweeper.deleted")
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return report, err
+	}
+
+	_ = watchman.Submit("retention.sweeper.visited", report.Visited)
+	return report, nil
+}
+
+func (s *Sweeper) sweepJob(ctx context.Context, file storage.FileInfo, policies map[string]*Policy) (bool, error) {
+	jobId := file.Name
+	exists, err := s.cloudStorage.Exists(ctx, storage.MetadataFileName(jobId))
+	if err != nil || !exists {
+		return false, nil
+	}
+
+	metadata, err := storage.ReadArchivedJobMetadata(ctx, s.cloudStorage, jobId)
+	if err != nil {
+		return false, fmt.Errorf("error reading metadata: %v", err)
+	}
+
+	policy, err := s.findPolicy(ctx, metadata.OrgID, policies)
+	if err != nil {
+		return false, fmt.Errorf("error finding retention policy for %s: %v", metadata.OrgID, err)
+	}
+
+	if policy == nil {
+		return false, nil
+	}
+
+	maxAge := policy.MaxAge(metadata)
+	if maxAge == 0 {
+		return false, nil
+	}
+
+	archivedAt := metadata.ArchivedAt
+	if archivedAt.IsZero() {
+		archivedAt = file.UpdatedAt
+	}
+
+	if s.now().Sub(archivedAt) < maxAge {
+		return false, nil
+	}
+
+	// Metadata is deleted last, so if deleting the logs fails,
+	// the next sweep tries again.
+	for _, fileName := range []string{storage.IndexFileName(jobId), jobId, storage.MetadataFileName(jobId)} {
+		err := s.cloudStorage.DeleteFile(ctx, fileName)
+		if err != nil && !isNotFound(ctx, s.cloudStorage, fileName) {
+			return false, fmt.Errorf("error deleting %s: %v", fileName, err)
+		}
+	}
+
+	return true, nil
+}
+
+// Policies are looked up once per organization in every sweep.
+func (s *Sweeper) findPolicy(ctx context.Context, orgId string, policies map[string]*Policy) (*Policy, error) {
+	if policy, ok := policies[orgId]; ok {
+		return policy, nil
+	}
+
+	policy, err := FindPolicy(ctx, s.cloudStorage, orgId)
+	if err != nil {
+		return nil, err
+	}
+
+	policies[orgId] = policy
+	return policy, nil
+}
+
+// Storages report missing files differently when deleting them,
+// so we check if the file is really gone.
+func isNotFound(ctx context.Context, cloudStorage storage.Storage, fileName string) bool {
+	exists, _ := cloudStorage.Exists(ctx, fileName)
+	return !exists
+}
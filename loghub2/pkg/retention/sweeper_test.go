@@ -0,0 +1,146 @@
+package retention
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semaphoreio/semaphore/loghub2/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__Sweep(t *testing.T) {
+	ctx := context.Background()
+	redisStorage := storage.NewRedisStorage(storage.RedisConfig{Address: "redis", Port: "6379"})
+	cloudStorage := storage.NewMemoryStorage()
+
+	orgWithPolicy := uuid.NewString()
+	orgWithoutPolicy := uuid.NewString()
+	require.NoError(t, SavePolicy(ctx, cloudStorage, orgWithPolicy, &Policy{
+		Rules:      []Rule{{ProtectedOnly: true, Age: 365 * day}},
+		DefaultAge: 30 * day,
+	}))
+
+	now := time.Now()
+	expired := archive(t, cloudStorage, &storage.JobMetadata{OrgID: orgWithPolicy, Branch: "feature", ArchivedAt: now.Add(-31 * 24 * time.Hour)})
+	notExpired := archive(t, cloudStorage, &storage.JobMetadata{OrgID: orgWithPolicy, Branch: "feature", ArchivedAt: now.Add(-29 * 24 * time.Hour)})
+	protected := archive(t, cloudStorage, &storage.JobMetadata{OrgID: orgWithPolicy, Branch: "main", ProtectedBranch: true, ArchivedAt: now.Add(-31 * 24 * time.Hour)})
+	noPolicy := archive(t, cloudStorage, &storage.JobMetadata{OrgID: orgWithoutPolicy, ArchivedAt: now.Add(-1000 * 24 * time.Hour)})
+
+	// Logs archived before retention policies existed have no metadata.
+	noMetadata := archive(t, cloudStorage, nil)
+	cloudStorage.SetUpdatedAt(noMetadata, now.Add(-1000*24*time.Hour))
+
+	// Without archived_at, the time the logs were last written is used.
+	noArchivedAt := archive(t, cloudStorage, &storage.JobMetadata{OrgID: orgWithPolicy})
+	cloudStorage.SetUpdatedAt(noArchivedAt, now.Add(-31*24*time.Hour))
+
+	report, err := NewSweeper(redisStorage, cloudStorage).Sweep(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 6, report.Visited)
+	assert.Equal(t, 2, report.Deleted)
+	assert.Equal(t, 0, report.Errors)
+	assert.ElementsMatch(t, []string{expired, noArchivedAt}, report.DeletedJobs)
+
+	for _, jobId := range []string{expired, noArchivedAt} {
+		for _, fileName := range []string{jobId, storage.IndexFileName(jobId), storage.MetadataFileName(jobId)} {
+			exists, _ := cloudStorage.Exists(ctx, fileName)
+			assert.False(t, exists, fileName)
+		}
+	}
+
+	for _, jobId := range []string{notExpired, protected, noPolicy, noMetadata} {
+		exists, _ := cloudStorage.Exists(ctx, jobId)
+		assert.True(t, exists, jobId)
+	}
+}
+
+func Test__SweepReportsErrors(t *testing.T) {
+	ctx := context.Background()
+	redisStorage := storage.NewRedisStorage(storage.RedisConfig{Address: "redis", Port: "6379"})
+	cloudStorage := storage.NewMemoryStorage()
+
+	jobId := archive(t, cloudStorage, nil)
+	file, err := os.CreateTemp(t.TempDir(), "*")
+	require.NoError(t, err)
+	_, err = file.WriteString("not JSON")
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	require.NoError(t, cloudStorage.SaveFile(ctx, file.Name(), storage.MetadataFileName(jobId)))
+
+	report, err := NewSweeper(redisStorage, cloudStorage).Sweep(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.Visited)
+	assert.Equal(t, 0, report.Deleted)
+	assert.Equal(t, 1, report.Errors)
+}
+
+func archive(t *testing.T, cloudStorage storage.Storage, metadata *storage.JobMetadata) string {
+	ctx := context.Background()
+	jobId := uuid.NewString()
+
+	file, err := os.CreateTemp(t.TempDir(), "*")
+	require.NoError(t, err)
+	_, err = file.WriteString(`{"event":"job_started","timestamp":1}` + "\n")
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	gzippedFileName, index, err := storage.GzipChunked(ctx, file.Name(), storage.DefaultLinesPerChunk)
+	require.NoError(t, err)
+	require.NoError(t, cloudStorage.SaveFile(ctx, gzippedFileName, jobId))
+	require.NoError(t, storage.SaveArchiveIndex(ctx, cloudStorage, jobId, index))
+
+	if metadata != nil {
+		metadata.JobID = jobId
+		require.NoError(t, storage.SaveArchivedJobMetadata(ctx, cloudStorage, metadata))
+	}
+
+	return jobId
+}
+
+func Test__SweepWithLock(t *testing.T) {
+	ctx := context.Background()
+	redisStorage := storage.NewRedisStorage(storage.RedisConfig{Address: "redis", Port: "6379"})
+	cloudStorage := storage.NewMemoryStorage()
+	archive(t, cloudStorage, nil)
+
+	startedAt := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	sweeper := NewSweeper(redisStorage, cloudStorage)
+	sweeper.now = func() time.Time { return startedAt }
+
+	t.Run("lock is released and report is saved", func(t *testing.T) {
+		sweeper.sweepWithLock(ctx, time.Hour)
+
+		exists, err := redisStorage.Client.Exists(ctx, sweeperLockKey).Result()
+		require.NoError(t, err)
+		assert.Zero(t, exists)
+
+		data, err := cloudStorage.ReadFile(ctx, ReportFileName(startedAt))
+		require.NoError(t, err)
+
+		report := Report{}
+		require.NoError(t, json.Unmarshal(data, &report))
+		assert.Equal(t, 1, report.Visited)
+		assert.Equal(t, startedAt, report.StartedAt)
+	})
+
+	t.Run("lock held by another sweeper is kept", func(t *testing.T) {
+		require.NoError(t, redisStorage.Client.Set(ctx, sweeperLockKey, "another-sweeper", time.Hour).Err())
+		defer redisStorage.Client.Del(ctx, sweeperLockKey)
+
+		startedAt = startedAt.Add(time.Hour)
+		sweeper.sweepWithLock(ctx, time.Hour)
+
+		exists, _ := cloudStorage.Exists(ctx, ReportFileName(startedAt))
+		assert.False(t, exists)
+
+		sweeper.releaseLock("not-the-owner")
+		owner, err := redisStorage.Client.Get(ctx, sweeperLockKey).Result()
+		require.NoError(t, err)
+		assert.Equal(t, "another-sweeper", owner)
+	})
+}
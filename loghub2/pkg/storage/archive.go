@@ -0,0 +1,366 @@
+package storage
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	pgzip "github.com/klauspost/pgzip"
+	"github.com/renderedtext/go-watchman"
+)
+
+// Archived logs are stored as a sequence of gzip members,
+// each one holding up to DefaultLinesPerChunk lines.
+// A sequence of gzip members is still a valid gzip file,
+// so an archive can still be read from start to end with GunzipWithReader.
+// Next to the archive, we store an index object with the position of each member,
+// so a range of lines can be read without decompressing the whole archive.
+const DefaultLinesPerChunk = 1000
+
+const archiveIndexVersion = 1
+
+type ArchiveIndex struct {
+	Version int            `json:"version"`
+	Lines   int64          `json:"lines"`
+	Chunks  []ArchiveChunk `json:"chunks"`
+}
+
+type ArchiveChunk struct {
+	FirstLine int64 `json:"first_line"`
+	Lines     int64 `json:"lines"`
+	Offset    int64 `json:"offset"`
+	Size      int64 `json:"size"`
+}
+
+// LineRange selects the lines [From, To) of a job log.
+// A negative To means until the end of the log.
+// If Tail is set, only the last Tail lines are selected, and From/To are ignored.
+type LineRange struct {
+	From int64
+	To   int64
+	Tail int64
+}
+
+func AllLines() LineRange {
+	return LineRange{From: 0, To: -1}
+}
+
+// Resolve turns the range into absolute [from, to) positions
+// for a log with the given number of lines.
+func (r LineRange) Resolve(lines int64) (int64, int64) {
+	if r.Tail > 0 {
+		return max(0, lines-r.Tail), lines
+	}
+
+	from := min(max(0, r.From), lines)
+	to := lines
+	if r.To >= 0 && r.To < lines {
+		to = r.To
+	}
+
+	return from, max(from, to)
+}
+
+func IndexFileName(jobId string) string {
+	return jobId + ".index"
+}
+
+// GzipChunked compresses fileName into fileName.gz as a sequence of gzip members,
+// and returns the index for it. The original file is removed, just like gzip does.
+func GzipChunked(ctx context.Context, fileName string, linesPerChunk int64) (string, *ArchiveIndex, error) {
+	defer watchman.Benchmark(time.Now(), "logs.compress")
+
+	// #nosec
+	input, err := os.Open(fileName)
+	if err != nil {
+		return "", nil, err
+	}
+
+	defer input.Close()
+
+	gzippedFileName := fmt.Sprintf("%s.gz", fileName)
+
+	// #nosec
+	output, err := os.Create(gzippedFileName)
+	if err != nil {
+		return "", nil, err
+	}
+
+	index, err := writeChunks(ctx, bufio.NewReader(input), output, linesPerChunk)
+	if err != nil {
+		_ = output.Close()
+		return "", nil, err
+	}
+
+	err = output.Close()
+	if err != nil {
+		return "", nil, err
+	}
+
+	err = os.Remove(fileName)
+	if err != nil {
+		log.Printf("Error removing %s: %v", fileName, err)
+	}
+
+	return gzippedFileName, index, nil
+}
+
+func writeChunks(ctx context.Context, input *bufio.Reader, output io.Writer, linesPerChunk int64) (*ArchiveIndex, error) {
+	index := &ArchiveIndex{Version: archiveIndexVersion, Chunks: []ArchiveChunk{}}
+	offset := int64(0)
+	chunk := bytes.Buffer{}
+
+	flush := func(lines int64) error {
+		if lines == 0 {
+			return nil
+		}
+
+		compressed := bytes.Buffer{}
+		writer := pgzip.NewWriter(&compressed)
+		if _, err := writer.Write(chunk.Bytes()); err != nil {
+			return err
+		}
+
+		if err := writer.Close(); err != nil {
+			return err
+		}
+
+		size, err := output.Write(compressed.Bytes())
+		if err != nil {
+			return err
+		}
+
+		index.Chunks = append(index.Chunks, ArchiveChunk{
+			FirstLine: index.Lines,
+			Lines:     lines,
+			Offset:    offset,
+			Size:      int64(size),
+		})
+
+		index.Lines += lines
+		offset += int64(size)
+		chunk.Reset()
+		return nil
+	}
+
+	lines := int64(0)
+	for {
+		line, err := input.ReadBytes('\n')
+		if len(line) > 0 {
+			chunk.Write(line)
+			lines++
+		}
+
+		if lines == linesPerChunk {
+			if err := flush(lines); err != nil {
+				return nil, err
+			}
+
+			lines = 0
+
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+		}
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := flush(lines); err != nil {
+		return nil, err
+	}
+
+	return index, nil
+}
+
+// SaveArchiveIndex uploads the index for the archived logs of a job.
+func SaveArchiveIndex(ctx context.Context, storage Storage, jobId string, index *ArchiveIndex) error {
+	file, err := os.CreateTemp("", jobId+"-index")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(file.Name())
+
+	err = json.NewEncoder(file).Encode(index)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+
+	return storage.SaveFile(ctx, file.Name(), IndexFileName(jobId))
+}
+
+// FindArchiveIndex returns the index for the archived logs of a job,
+// or nil, if the logs were archived before indexes existed.
+func FindArchiveIndex(ctx context.Context, storage Storage, jobId string) *ArchiveIndex {
+	data, err := storage.ReadFile(ctx, IndexFileName(jobId))
+	if err != nil {
+		return nil
+	}
+
+	index := ArchiveIndex{}
+	err = json.Unmarshal(data, &index)
+	if err != nil || index.Version != archiveIndexVersion {
+		log.Printf("Ignoring invalid archive index for %s: %v", jobId, err)
+		return nil
+	}
+
+	return &index
+}
+
+// ReadArchivedLogs calls fn for every line of the archived logs of a job in the range.
+// If an index exists for the archive, only the gzip members
+// holding the lines in the range are fetched and decompressed.
+func ReadArchivedLogs(ctx context.Context, storage Storage, jobId string, lineRange LineRange, fn func(line []byte) error) error {
+	index := FindArchiveIndex(ctx, storage, jobId)
+	if index == nil {
+		return readArchivedLogsWithoutIndex(ctx, storage, jobId, lineRange, fn)
+	}
+
+	from, to := lineRange.Resolve(index.Lines)
+	if from >= to {
+		return nil
+	}
+
+	var first, last *ArchiveChunk
+	for i := range index.Chunks {
+		chunk := &index.Chunks[i]
+		if chunk.FirstLine+chunk.Lines <= from {
+			continue
+		}
+
+		if chunk.FirstLine >= to {
+			break
+		}
+
+		if first == nil {
+			first = chunk
+		}
+
+		last = chunk
+	}
+
+	if first == nil {
+		return nil
+	}
+
+	reader, err := storage.ReadFileRange(ctx, jobId, first.Offset, last.Offset+last.Size-first.Offset)
+	if err != nil {
+		return err
+	}
+
+	defer reader.Close()
+
+	i := first.FirstLine
+	return gunzipUntil(reader, func(line []byte) (bool, error) {
+		defer func() { i++ }()
+		if i < from {
+			return true, nil
+		}
+
+		if i >= to {
+			return false, nil
+		}
+
+		return true, fn(line)
+	})
+}
+
+func readArchivedLogsWithoutIndex(ctx context.Context, storage Storage, jobId string, lineRange LineRange, fn func(line []byte) error) error {
+	reader, err := storage.ReadFileAsReader(ctx, jobId)
+	if err != nil {
+		return err
+	}
+
+	defer reader.Close()
+
+	// Without an index, we don't know how many lines there are,
+	// so we keep the last lines around until we reach the end.
+	if lineRange.Tail > 0 {
+		lines := make([][]byte, 0, lineRange.Tail)
+		err := GunzipWithReader(reader, func(line []byte) error {
+			if int64(len(lines)) == lineRange.Tail {
+				lines = lines[1:]
+			}
+
+			lines = append(lines, bytes.Clone(line))
+			return nil
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, line := range lines {
+			if err := fn(line); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	i := int64(0)
+	return gunzipUntil(reader, func(line []byte) (bool, error) {
+		defer func() { i++ }()
+		if i < lineRange.From {
+			return true, nil
+		}
+
+		if lineRange.To >= 0 && i >= lineRange.To {
+			return false, nil
+		}
+
+		return true, fn(line)
+	})
+}
+
+// gunzipUntil works like GunzipWithReader,
+// but stops reading when processFn returns false.
+func gunzipUntil(zippedReader io.Reader, processFn func([]byte) (bool, error)) error {
+	rawReader, err := pgzip.NewReader(zippedReader)
+	if err != nil {
+		return err
+	}
+
+	defer rawReader.Close()
+
+	bufferedReader := bufio.NewReader(rawReader)
+	for {
+		line, err := bufferedReader.ReadBytes('\n')
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		more, err := processFn(line)
+		if err != nil {
+			return err
+		}
+
+		if !more {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__LineRangeResolve(t *testing.T) {
+	type testCase struct {
+		lineRange LineRange
+		from      int64
+		to        int64
+	}
+
+	for _, tc := range []testCase{
+		{lineRange: AllLines(), from: 0, to: 10},
+		{lineRange: LineRange{From: 3, To: -1}, from: 3, to: 10},
+		{lineRange: LineRange{From: 3, To: 5}, from: 3, to: 5},
+		{lineRange: LineRange{From: 3, To: 50}, from: 3, to: 10},
+		{lineRange: LineRange{From: 30, To: -1}, from: 10, to: 10},
+		{lineRange: LineRange{Tail: 4}, from: 6, to: 10},
+		{lineRange: LineRange{Tail: 40}, from: 0, to: 10},
+	} {
+		from, to := tc.lineRange.Resolve(10)
+		assert.Equal(t, tc.from, from, "%+v", tc.lineRange)
+		assert.Equal(t, tc.to, to, "%+v", tc.lineRange)
+	}
+}
+
+func Test__GzipChunked(t *testing.T) {
+	fileName := writeLogLines(t, 25)
+
+	gzippedFileName, index, err := GzipChunked(context.Background(), fileName, 10)
+	require.NoError(t, err)
+	defer os.Remove(gzippedFileName)
+
+	assert.Equal(t, int64(25), index.Lines)
+	require.Len(t, index.Chunks, 3)
+	assert.Equal(t, ArchiveChunk{FirstLine: 0, Lines: 10, Offset: 0, Size: index.Chunks[0].Size}, index.Chunks[0])
+	assert.Equal(t, int64(10), index.Chunks[1].FirstLine)
+	assert.Equal(t, index.Chunks[0].Size, index.Chunks[1].Offset)
+	assert.Equal(t, int64(5), index.Chunks[2].Lines)
+
+	// original file is removed
+	_, err = os.Stat(fileName)
+	assert.True(t, os.IsNotExist(err))
+
+	// chunked archive can still be read from start to end
+	file, err := os.Open(gzippedFileName)
+	require.NoError(t, err)
+	defer file.Close()
+
+	lines := []string{}
+	require.NoError(t, GunzipWithReader(file, func(line []byte) error {
+		lines = append(lines, string(line))
+		return nil
+	}))
+
+	assert.Equal(t, logLines(0, 25), lines)
+}
+
+func Test__ReadArchivedLogs(t *testing.T) {
+	bucketName := "archive-test"
+	storage, err := NewGCSStorageWithClient(httpClient, bucketName)
+	require.NoError(t, err)
+	require.NoError(t, storage.CreateBucket(bucketName, TestProjectID))
+	defer storage.DeleteBucket(bucketName)
+
+	ctx := context.Background()
+
+	indexedJobID := uuid.NewString()
+	gzippedFileName, index, err := GzipChunked(ctx, writeLogLines(t, 25), 10)
+	require.NoError(t, err)
+	require.NoError(t, storage.SaveFile(ctx, gzippedFileName, indexedJobID))
+	require.NoError(t, SaveArchiveIndex(ctx, storage, indexedJobID, index))
+	os.Remove(gzippedFileName)
+
+	legacyJobID := uuid.NewString()
+	fileName := writeLogLines(t, 25)
+	require.NoError(t, Gzip(ctx, fileName))
+	require.NoError(t, storage.SaveFile(ctx, fileName+".gz", legacyJobID))
+	os.Remove(fileName + ".gz")
+
+	assert.NotNil(t, FindArchiveIndex(ctx, storage, indexedJobID))
+	assert.Nil(t, FindArchiveIndex(ctx, storage, legacyJobID))
+
+	type testCase struct {
+		lineRange LineRange
+		expected  []string
+	}
+
+	for _, tc := range []testCase{
+		{lineRange: AllLines(), expected: logLines(0, 25)},
+		{lineRange: LineRange{From: 12, To: -1}, expected: logLines(12, 25)},
+		{lineRange: LineRange{From: 8, To: 13}, expected: logLines(8, 13)},
+		{lineRange: LineRange{From: 20, To: 21}, expected: logLines(20, 21)},
+		{lineRange: LineRange{From: 30, To: -1}, expected: []string{}},
+		{lineRange: LineRange{Tail: 7}, expected: logLines(18, 25)},
+		{lineRange: LineRange{Tail: 100}, expected: logLines(0, 25)},
+	} {
+		for _, jobID := range []string{indexedJobID, legacyJobID} {
+			lines := []string{}
+			err := ReadArchivedLogs(ctx, storage, jobID, tc.lineRange, func(line []byte) error {
+				lines = append(lines, string(line))
+				return nil
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, lines, "%+v", tc.lineRange)
+		}
+	}
+}
+
+func writeLogLines(t *testing.T, count int) string {
+	file, err := os.CreateTemp("", "*")
+	require.NoError(t, err)
+	_, err = file.WriteString(strings.Join(logLines(0, count), ""))
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	return file.Name()
+}
+
+func logLines(from, to int) []string {
+	lines := []string{}
+	for i := from; i < to; i++ {
+		lines = append(lines, fmt.Sprintf(`{"event":"cmd_output","output":"line %d"}`+"\n", i))
+	}
+
+	return lines
+}
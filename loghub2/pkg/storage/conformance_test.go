@@ -0,0 +1,237 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Every Storage implementation should pass this suite.
+func testStorageConformance(t *testing.T, storage Storage) {
+	ctx := context.Background()
+	content := "line1\nline2\nline3\nline4\n"
+
+	t.Run("saved file can be read", func(t *testing.T) {
+		fileName := uuid.NewString()
+		require.NoError(t, storage.SaveFile(ctx, writeTempFile(t, content), fileName))
+		defer storage.DeleteFile(ctx, fileName)
+
+		exists, err := storage.Exists(ctx, fileName)
+		require.NoError(t, err)
+		assert.True(t, exists)
+
+		read, err := storage.ReadFile(ctx, fileName)
+		require.NoError(t, err)
+		assert.Equal(t, content, string(read))
+
+		reader, err := storage.ReadFileAsReader(ctx, fileName)
+		require.NoError(t, err)
+		read, err = io.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		assert.Equal(t, content, string(read))
+	})
+
+	t.Run("range of saved file can be read", func(t *testing.T) {
+		fileName := uuid.NewString()
+		require.NoError(t, storage.SaveFile(ctx, writeTempFile(t, content), fileName))
+		defer storage.DeleteFile(ctx, fileName)
+
+		reader, err := storage.ReadFileRange(ctx, fileName, 6, 12)
+		require.NoError(t, err)
+		read, err := io.ReadAll(reader)
+		require.NoError(t, err)
+		require.NoError(t, reader.Close())
+		assert.Equal(t, "line2\nline3\n", string(read))
+	})
+
+	t.Run("saving again replaces the file", func(t *testing.T) {
+		fileName := uuid.NewString()
+		require.NoError(t, storage.SaveFile(ctx, writeTempFile(t, content), fileName))
+		require.NoError(t, storage.SaveFile(ctx, writeTempFile(t, "replaced\n"), fileName))
+		defer storage.DeleteFile(ctx, fileName)
+
+		read, err := storage.ReadFile(ctx, fileName)
+		require.NoError(t, err)
+		assert.Equal(t, "replaced\n", string(read))
+	})
+
+	t.Run("deleted file does not exist", func(t *testing.T) {
+		fileName := uuid.NewString()
+		require.NoError(t, storage.SaveFile(ctx, writeTempFile(t, content), fileName))
+		require.NoError(t, storage.DeleteFile(ctx, fileName))
+
+		exists, _ := storage.Exists(ctx, fileName)
+		assert.False(t, exists)
+
+		_, err := storage.ReadFile(ctx, fileName)
+		assert.Error(t, err)
+	})
+
+	t.Run("file that does not exist cannot be read", func(t *testing.T) {
+		fileName := uuid.NewString()
+		exists, _ := storage.Exists(ctx, fileName)
+		assert.False(t, exists)
+
+		read, err := storage.ReadFile(ctx, fileName)
+		assert.Error(t, err)
+		assert.Nil(t, read)
+
+		_, err = storage.ReadFileAsReader(ctx, fileName)
+		assert.Error(t, err)
+
+		_, err = storage.ReadFileRange(ctx, fileName, 0, 10)
+		assert.Error(t, err)
+	})
+
+	t.Run("saved files are listed", func(t *testing.T) {
+		fileName := uuid.NewString()
+		require.NoError(t, storage.SaveFile(ctx, writeTempFile(t, content), fileName))
+		defer storage.DeleteFile(ctx, fileName)
+
+		found := []FileInfo{}
+		err := storage.ListFiles(ctx, func(file FileInfo) error {
+			if file.Name == fileName {
+				found = append(found, file)
+			}
+
+			return nil
+		})
+
+		require.NoError(t, err)
+		require.Len(t, found, 1)
+		assert.Equal(t, int64(len(content)), found[0].Size)
+		assert.WithinDuration(t, time.Now(), found[0].UpdatedAt, time.Minute)
+	})
+
+	t.Run("archived logs can be read", func(t *testing.T) {
+		jobId := uuid.NewString()
+		gzippedFileName, index, err := GzipChunked(ctx, writeLogLines(t, 25), 10)
+		require.NoError(t, err)
+		defer os.Remove(gzippedFileName)
+
+		require.NoError(t, storage.SaveFile(ctx, gzippedFileName, jobId))
+		require.NoError(t, SaveArchiveIndex(ctx, storage, jobId, index))
+		defer storage.DeleteFile(ctx, jobId)
+		defer storage.DeleteFile(ctx, IndexFileName(jobId))
+
+		lines := []string{}
+		err = ReadArchivedLogs(ctx, storage, jobId, LineRange{From: 8, To: 13}, func(line []byte) error {
+			lines = append(lines, string(line))
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, logLines(8, 13), lines)
+	})
+}
+
+func Test__MemoryStorageConformance(t *testing.T) {
+	testStorageConformance(t, NewMemoryStorage())
+}
+
+func Test__FilesystemStorageConformance(t *testing.T) {
+	storage, err := NewFilesystemStorage(FilesystemStorageOptions{Path: t.TempDir()})
+	require.NoError(t, err)
+	testStorageConformance(t, storage)
+}
+
+func Test__GCSStorageConformance(t *testing.T) {
+	bucketName := "conformance-test"
+	storage, err := NewGCSStorageWithClient(httpClient, bucketName)
+	require.NoError(t, err)
+	require.NoError(t, storage.CreateBucket(bucketName, TestProjectID))
+	defer storage.DeleteBucket(bucketName)
+
+	testStorageConformance(t, storage)
+}
+
+func Test__S3StorageConformance(t *testing.T) {
+	require.NoError(t, s3Storage.CreateBucket(S3BucketName))
+	defer s3Storage.DeleteBucket(S3BucketName)
+
+	testStorageConformance(t, s3Storage)
+}
+
+func Test__FilesystemStorage(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("files are sharded", func(t *testing.T) {
+		storage, err := NewFilesystemStorage(FilesystemStorageOptions{Path: t.TempDir()})
+		require.NoError(t, err)
+
+		require.NoError(t, storage.SaveFile(ctx, writeTempFile(t, "hello"), "job-1"))
+		path, err := storage.filePath("job-1")
+		require.NoError(t, err)
+
+		relative, err := filepath.Rel(storage.Path, path)
+		require.NoError(t, err)
+		assert.Regexp(t, `^[0-9a-f]{2}/[0-9a-f]{2}/job-1$`, relative)
+
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(content))
+	})
+
+	t.Run("bad file names are rejected", func(t *testing.T) {
+		storage, err := NewFilesystemStorage(FilesystemStorageOptions{Path: t.TempDir()})
+		require.NoError(t, err)
+
+		for _, fileName := range []string{"", ".", "..", "../etc/passwd", "a/b", `a\b`} {
+			assert.Error(t, storage.SaveFile(ctx, writeTempFile(t, "hello"), fileName), fileName)
+			_, err := storage.ReadFile(ctx, fileName)
+			assert.Error(t, err, fileName)
+		}
+	})
+
+	t.Run("missing files return ErrFileNotFound", func(t *testing.T) {
+		storage, err := NewFilesystemStorage(FilesystemStorageOptions{Path: t.TempDir()})
+		require.NoError(t, err)
+
+		_, err = storage.ReadFile(ctx, "does-not-exist")
+		assert.ErrorIs(t, err, ErrFileNotFound)
+		assert.NoError(t, storage.DeleteFile(ctx, "does-not-exist"))
+	})
+
+	t.Run("old files are deleted", func(t *testing.T) {
+		storage, err := NewFilesystemStorage(FilesystemStorageOptions{Path: t.TempDir(), Retention: time.Hour})
+		require.NoError(t, err)
+
+		require.NoError(t, storage.SaveFile(ctx, writeTempFile(t, "old"), "old"))
+		require.NoError(t, storage.SaveFile(ctx, writeTempFile(t, "new"), "new"))
+		require.NoError(t, storage.SaveFile(ctx, writeTempFile(t, "{}"), RetentionPolicyFileName("org")))
+
+		twoHoursAgo := time.Now().Add(-2 * time.Hour)
+		for _, fileName := range []string{"old", RetentionPolicyFileName("org")} {
+			path, _ := storage.filePath(fileName)
+			require.NoError(t, os.Chtimes(path, twoHoursAgo, twoHoursAgo))
+		}
+
+		deleted, err := storage.DeleteOlderThan(ctx, time.Now().Add(-storage.Retention))
+		require.NoError(t, err)
+		assert.Equal(t, 1, deleted)
+
+		exists, _ := storage.Exists(ctx, "old")
+		assert.False(t, exists)
+		exists, _ = storage.Exists(ctx, "new")
+		assert.True(t, exists)
+		exists, _ = storage.Exists(ctx, RetentionPolicyFileName("org"))
+		assert.True(t, exists)
+	})
+}
+
+func writeTempFile(t *testing.T, content string) string {
+	file, err := os.CreateTemp(t.TempDir(), "*")
+	require.NoError(t, err)
+	_, err = file.WriteString(content)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	return file.Name()
+}
@@ -0,0 +1,307 @@
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/renderedtext/go-watchman"
+)
+
+var ErrFileNotFound = errors.New("file not found")
+
+const defaultRetentionInterval = time.Hour
+
+type FilesystemStorageOptions struct {
+	Path string
+
+	// Files older than this are deleted. Zero means files are kept forever.
+	Retention time.Duration
+}
+
+// FilesystemStorage keeps files in a local directory.
+// To avoid having too many entries in a single directory,
+// files are spread into two levels of subdirectories,
+// based on the hash of their names: <path>/ab/cd/<name>.
+type FilesystemStorage struct {
+	Path      string
+	Retention time.Duration
+}
+
+func NewFilesystemStorage(options FilesystemStorageOptions) (*FilesystemStorage, error) {
+	if options.Path == "" {
+		return nil, fmt.Errorf("path can't be empty")
+	}
+
+	path, err := filepath.Abs(options.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(path, 0700)
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s: %v", path, err)
+	}
+
+	return &FilesystemStorage{Path: path, Retention: options.Retention}, nil
+}
+
+func (s *FilesystemStorage) filePath(fileName string) (string, error) {
+	if fileName == "" || fileName == "." || fileName == ".." || strings.ContainsAny(fileName, `/\`) {
+		return "", fmt.Errorf("invalid file name '%s'", fileName)
+	}
+
+	hash := sha256.Sum256([]byte(fileName))
+	shard := hex.EncodeToString(hash[:2])
+	return filepath.Join(s.Path, shard[0:2], shard[2:4], fileName), nil
+}
+
+// SaveFile writes into a temporary file in the same directory, and renames it,
+// so readers never see a partially written file.
+func (s *FilesystemStorage) SaveFile(ctx context.Context, localFileName, fileName string) error {
+	defer watchman.Benchmark(time.Now(), "fs.write")
+
+	path, err := s.filePath(fileName)
+	if err != nil {
+		return err
+	}
+
+	// #nosec
+	input, err := os.Open(localFileName)
+	if err != nil {
+		return err
+	}
+
+	defer input.Close()
+
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+fileName+".tmp-*")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, input); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+
+	if err := tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (s *FilesystemStorage) Exists(ctx context.Context, fileName string) (bool, error) {
+	path, err := s.filePath(fileName)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = os.Stat(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+func (s *FilesystemStorage) ReadFile(ctx context.Context, fileName string) ([]byte, error) {
+	defer watchman.Benchmark(time.Now(), "fs.read")
+
+	reader, err := s.open(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
+
+func (s *FilesystemStorage) ReadFileAsReader(ctx context.Context, fileName string) (io.ReadCloser, error) {
+	defer watchman.Benchmark(time.Now(), "fs.read")
+
+	return s.open(fileName)
+}
+
+// Note: the caller must close the returned reader.
+func (s *FilesystemStorage) ReadFileRange(ctx context.Context, fileName string, offset, length int64) (io.ReadCloser, error) {
+	defer watchman.Benchmark(time.Now(), "fs.read")
+
+	file, err := s.open(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = file.Seek(offset, io.SeekStart)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	return &limitedReadCloser{Reader: io.LimitReader(file, length), Closer: file}, nil
+}
+
+func (s *FilesystemStorage) DeleteFile(ctx context.Context, fileName string) error {
+	defer watchman.Benchmark(time.Now(), "fs.delete")
+
+	path, err := s.filePath(fileName)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+func (s *FilesystemStorage) open(fileName string) (*os.File, error) {
+	path, err := s.filePath(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	// #nosec
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrFileNotFound, fileName)
+	}
+
+	return file, err
+}
+
+// ListFiles lists the files in all shards.
+// Files still being written are not listed.
+func (s *FilesystemStorage) ListFiles(ctx context.Context, fn func(FileInfo) error) error {
+	defer watchman.Benchmark(time.Now(), "fs.list")
+
+	return filepath.WalkDir(s.Path, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fn(FileInfo{Name: entry.Name(), Size: info.Size(), UpdatedAt: info.ModTime()})
+	})
+}
+
+// StartRetention periodically deletes files older than the retention period.
+// It does nothing if no retention period is configured.
+func (s *FilesystemStorage) StartRetention(ctx context.Context, interval time.Duration) {
+	if s.Retention == 0 {
+		return
+	}
+
+	if interval == 0 {
+		interval = defaultRetentionInterval
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			deleted, err := s.DeleteOlderThan(ctx, time.Now().Add(-s.Retention))
+			if err != nil {
+				log.Printf("Error deleting old files from %s: %v", s.Path, err)
+			} else if deleted > 0 {
+				log.Printf("Deleted %d old files from %s", deleted, s.Path)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// DeleteOlderThan deletes all files last written before cutoff,
+// and returns how many were deleted.
+func (s *FilesystemStorage) DeleteOlderThan(ctx context.Context, cutoff time.Time) (int, error) {
+	defer watchman.Benchmark(time.Now(), "fs.retention")
+
+	deleted := 0
+	err := filepath.WalkDir(s.Path, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		// Retention policies are not logs, and are kept no matter how old they are.
+		if entry.IsDir() || strings.HasSuffix(entry.Name(), retentionPolicySuffix) {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if !info.ModTime().Before(cutoff) {
+			return nil
+		}
+
+		err = os.Remove(path)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+
+		deleted++
+		return nil
+	})
+
+	return deleted, err
+}
+
+type limitedReadCloser struct {
+	io.Reader
+	io.Closer
+}
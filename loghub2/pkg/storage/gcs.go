@@ -150,8 +150,43 @@ func (s *GCSStorage) ReadFileAsReader(ctx context.Context, fileName string) (io.
 	return reader, nil
 }
 
+// Note: the caller must close the returned reader.
+func (s *GCSStorage) ReadFileRange(ctx context.Context, fileName string, offset, length int64) (io.ReadCloser, error) {
+	defer watchman.Benchmark(time.Now(), "gcs.read")
+
+	reader, err := s.Client.Bucket(s.Bucket).
+		Object(fileName).
+		NewRangeReader(ctx, offset, length)
+	if err != nil {
+		return nil, err
+	}
+
+	return reader, nil
+}
+
 func (s *GCSStorage) DeleteFile(ctx context.Context, fileName string) error {
 	defer watchman.Benchmark(time.Now(), "gcs.delete")
 
 	return s.Client.Bucket(s.Bucket).Object(fileName).Delete(ctx)
 }
+
+func (s *GCSStorage) ListFiles(ctx context.Context, fn func(FileInfo) error) error {
+	defer watchman.Benchmark(time.Now(), "gcs.list")
+
+	it := s.Client.Bucket(s.Bucket).Objects(ctx, nil)
+	for {
+		objAttrs, err := it.Next()
+		if err == iterator.Done {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		err = fn(FileInfo{Name: objAttrs.Name, Size: objAttrs.Size, UpdatedAt: objAttrs.Updated})
+		if err != nil {
+			return err
+		}
+	}
+}
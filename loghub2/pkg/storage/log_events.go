@@ -0,0 +1,170 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// LogEventsHub shares a single Redis pub/sub connection between all the log streams of the process.
+// Redis is subscribed to the channel of a job while the job has at least one subscriber,
+// and the events received for it are fanned out to all of them.
+type LogEventsHub struct {
+	client *redis.Client
+	mu     sync.Mutex
+	pubsub *redis.PubSub
+	jobs   map[string]*jobSubscribers
+}
+
+type jobSubscribers struct {
+	confirmed     chan struct{}
+	confirmedOnce sync.Once
+	subscribers   map[*LogEventsSubscription]struct{}
+}
+
+// LogEventsSubscription receives the events published for a job.
+// Events only tell subscribers that something happened, so if a subscriber
+// is not keeping up, events are dropped instead of blocking the others.
+type LogEventsSubscription struct {
+	hub    *LogEventsHub
+	jobId  string
+	events chan string
+	once   sync.Once
+}
+
+func NewLogEventsHub(client *redis.Client) *LogEventsHub {
+	return &LogEventsHub{
+		client: client,
+		jobs:   map[string]*jobSubscribers{},
+	}
+}
+
+// Subscribe returns a subscription to the log events for a job.
+// The subscription is already confirmed by Redis when this function returns,
+// so no events published after it are missed. The caller must close it.
+func (h *LogEventsHub) Subscribe(ctx context.Context, jobId string) (*LogEventsSubscription, error) {
+	h.mu.Lock()
+	if h.pubsub == nil {
+		h.pubsub = h.client.Subscribe(context.Background())
+		go h.receive(h.pubsub)
+	}
+
+	job, ok := h.jobs[jobId]
+	if !ok {
+		err := h.pubsub.Subscribe(ctx, LogEventsChannel(jobId))
+		if err != nil {
+			h.mu.Unlock()
+			return nil, err
+		}
+
+		job = &jobSubscribers{
+			confirmed:   make(chan struct{}),
+			subscribers: map[*LogEventsSubscription]struct{}{},
+		}
+
+		h.jobs[jobId] = job
+	}
+
+	subscription := &LogEventsSubscription{hub: h, jobId: jobId, events: make(chan string, 1)}
+	job.subscribers[subscription] = struct{}{}
+	h.mu.Unlock()
+
+	select {
+	case <-job.confirmed:
+		return subscription, nil
+	case <-ctx.Done():
+		_ = subscription.Close()
+		return nil, ctx.Err()
+	}
+}
+
+func (h *LogEventsHub) unsubscribe(subscription *LogEventsSubscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	job, ok := h.jobs[subscription.jobId]
+	if !ok {
+		return
+	}
+
+	delete(job.subscribers, subscription)
+	close(subscription.events)
+	if len(job.subscribers) > 0 {
+		return
+	}
+
+	// Still holding the lock, so a new subscriber for the job
+	// can't send its SUBSCRIBE before this UNSUBSCRIBE.
+	delete(h.jobs, subscription.jobId)
+	err := h.pubsub.Unsubscribe(context.Background(), LogEventsChannel(subscription.jobId))
+	if err != nil {
+		log.Printf("Error unsubscribing from log events for %s: %v", subscription.jobId, err)
+	}
+}
+
+// receive goes through all the messages of the shared connection.
+// The Redis client reconnects, and subscribes to all the channels again, after errors.
+func (h *LogEventsHub) receive(pubsub *redis.PubSub) {
+	for {
+		message, err := pubsub.Receive(context.Background())
+		if err != nil {
+			if errors.Is(err, redis.ErrClosed) {
+				return
+			}
+
+			log.Printf("Error receiving log events: %v", err)
+			time.Sleep(time.Second)
+			continue
+		}
+
+		switch m := message.(type) {
+		case *redis.Subscription:
+			if m.Kind == "subscribe" {
+				h.confirm(strings.TrimPrefix(m.Channel, logEventsChannelPrefix))
+			}
+
+		case *redis.Message:
+			h.dispatch(strings.TrimPrefix(m.Channel, logEventsChannelPrefix), m.Payload)
+		}
+	}
+}
+
+func (h *LogEventsHub) confirm(jobId string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if job, ok := h.jobs[jobId]; ok {
+		job.confirmedOnce.Do(func() { close(job.confirmed) })
+	}
+}
+
+func (h *LogEventsHub) dispatch(jobId, event string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	job, ok := h.jobs[jobId]
+	if !ok {
+		return
+	}
+
+	for subscription := range job.subscribers {
+		select {
+		case subscription.events <- event:
+		default:
+		}
+	}
+}
+
+func (s *LogEventsSubscription) Channel() <-chan string {
+	return s.events
+}
+
+func (s *LogEventsSubscription) Close() error {
+	s.once.Do(func() { s.hub.unsubscribe(s) })
+	return nil
+}
@@ -0,0 +1,73 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__LogEventsHub(t *testing.T) {
+	ctx := context.Background()
+	hub := NewLogEventsHub(redisStorage.Client)
+
+	first, err := hub.Subscribe(ctx, "log-events-hub-1")
+	require.NoError(t, err)
+	second, err := hub.Subscribe(ctx, "log-events-hub-1")
+	require.NoError(t, err)
+	other, err := hub.Subscribe(ctx, "log-events-hub-2")
+	require.NoError(t, err)
+	defer other.Close()
+
+	// all the subscriptions share the same connection
+	pubsub := hub.pubsub
+	require.NotNil(t, pubsub)
+
+	t.Run("events are fanned out to the subscribers of the job", func(t *testing.T) {
+		require.NoError(t, redisStorage.PublishLogEvent(ctx, "log-events-hub-1", LogEventAppended))
+		assert.Equal(t, LogEventAppended, receiveLogEvent(t, first))
+		assert.Equal(t, LogEventAppended, receiveLogEvent(t, second))
+
+		select {
+		case event := <-other.Channel():
+			t.Fatalf("unexpected event %s", event)
+		case <-time.After(100 * time.Millisecond):
+		}
+	})
+
+	t.Run("closed subscriptions stop receiving events", func(t *testing.T) {
+		require.NoError(t, first.Close())
+		_, ok := <-first.Channel()
+		assert.False(t, ok)
+
+		require.NoError(t, redisStorage.PublishLogEvent(ctx, "log-events-hub-1", LogEventArchived))
+		assert.Equal(t, LogEventArchived, receiveLogEvent(t, second))
+	})
+
+	t.Run("jobs without subscribers are unsubscribed", func(t *testing.T) {
+		require.NoError(t, second.Close())
+		hub.mu.Lock()
+		assert.NotContains(t, hub.jobs, "log-events-hub-1")
+		hub.mu.Unlock()
+
+		again, err := hub.Subscribe(ctx, "log-events-hub-1")
+		require.NoError(t, err)
+		defer again.Close()
+
+		require.NoError(t, redisStorage.PublishLogEvent(ctx, "log-events-hub-1", LogEventAppended))
+		assert.Equal(t, LogEventAppended, receiveLogEvent(t, again))
+		assert.Same(t, pubsub, hub.pubsub)
+	})
+}
+
+func receiveLogEvent(t *testing.T, subscription *LogEventsSubscription) string {
+	select {
+	case event := <-subscription.Channel():
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatalf("timed out waiting for log event")
+		return ""
+	}
+}
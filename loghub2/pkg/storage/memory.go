@@ -0,0 +1,120 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// MemoryStorage keeps files in memory.
+// It is meant for tests and local development only.
+type MemoryStorage struct {
+	mu    sync.RWMutex
+	files map[string]memoryFile
+}
+
+type memoryFile struct {
+	content   []byte
+	updatedAt time.Time
+}
+
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{files: map[string]memoryFile{}}
+}
+
+func (s *MemoryStorage) SaveFile(ctx context.Context, localFileName, fileName string) error {
+	// #nosec
+	content, err := os.ReadFile(localFileName)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.files[fileName] = memoryFile{content: content, updatedAt: time.Now()}
+	return nil
+}
+
+func (s *MemoryStorage) Exists(ctx context.Context, fileName string) (bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.files[fileName]
+	return ok, nil
+}
+
+func (s *MemoryStorage) ReadFile(ctx context.Context, fileName string) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	file, ok := s.files[fileName]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrFileNotFound, fileName)
+	}
+
+	return bytes.Clone(file.content), nil
+}
+
+func (s *MemoryStorage) ReadFileAsReader(ctx context.Context, fileName string) (io.ReadCloser, error) {
+	content, err := s.ReadFile(ctx, fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	return io.NopCloser(bytes.NewReader(content)), nil
+}
+
+func (s *MemoryStorage) ReadFileRange(ctx context.Context, fileName string, offset, length int64) (io.ReadCloser, error) {
+	content, err := s.ReadFile(ctx, fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	start := min(offset, int64(len(content)))
+	end := min(start+length, int64(len(content)))
+	return io.NopCloser(bytes.NewReader(content[start:end])), nil
+}
+
+func (s *MemoryStorage) DeleteFile(ctx context.Context, fileName string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.files, fileName)
+	return nil
+}
+
+// ListFiles lists files sorted by name.
+func (s *MemoryStorage) ListFiles(ctx context.Context, fn func(FileInfo) error) error {
+	s.mu.RLock()
+	files := make([]FileInfo, 0, len(s.files))
+	for name, file := range s.files {
+		files = append(files, FileInfo{Name: name, Size: int64(len(file.content)), UpdatedAt: file.updatedAt})
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+
+	for _, file := range files {
+		if err := fn(file); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetUpdatedAt changes when a file was last written. For tests only.
+func (s *MemoryStorage) SetUpdatedAt(fileName string, updatedAt time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if file, ok := s.files[fileName]; ok {
+		file.updatedAt = updatedAt
+		s.files[fileName] = file
+	}
+}
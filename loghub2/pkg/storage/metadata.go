@@ -0,0 +1,110 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Metadata about a job is kept in Redis while the job is running,
+// and archived next to its logs, so retention policies can be applied to it.
+const metadataKeyPrefix = "loghub2:metadata:"
+
+type JobMetadata struct {
+	JobID           string    `json:"job_id"`
+	OrgID           string    `json:"org_id"`
+	ProjectID       string    `json:"project_id"`
+	Branch          string    `json:"branch"`
+	ProtectedBranch bool      `json:"protected_branch"`
+	ArchivedAt      time.Time `json:"archived_at"`
+}
+
+// Retention policies are kept in the same storage as the logs they apply to,
+// one file per organization.
+const retentionPolicySuffix = ".retention-policy"
+
+func MetadataFileName(jobId string) string {
+	return jobId + ".meta"
+}
+
+func RetentionPolicyFileName(orgId string) string {
+	return orgId + retentionPolicySuffix
+}
+
+func (s *RedisStorage) SaveJobMetadata(ctx context.Context, metadata *JobMetadata) error {
+	data, err := json.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+
+	return s.Client.Set(ctx, metadataKeyPrefix+metadata.JobID, data, defaultTTL*time.Second).Err()
+}
+
+// FindJobMetadata returns nil if no metadata was saved for the job.
+func (s *RedisStorage) FindJobMetadata(ctx context.Context, jobId string) (*JobMetadata, error) {
+	data, err := s.Client.Get(ctx, metadataKeyPrefix+jobId).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := JobMetadata{}
+	err = json.Unmarshal(data, &metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &metadata, nil
+}
+
+func (s *RedisStorage) DeleteJobMetadata(ctx context.Context, jobId string) error {
+	return s.Client.Del(ctx, metadataKeyPrefix+jobId).Err()
+}
+
+func SaveArchivedJobMetadata(ctx context.Context, storage Storage, metadata *JobMetadata) error {
+	return SaveJSONFile(ctx, storage, MetadataFileName(metadata.JobID), metadata)
+}
+
+// SaveJSONFile saves the value encoded as JSON in fileName.
+func SaveJSONFile(ctx context.Context, storage Storage, fileName string, value interface{}) error {
+	file, err := os.CreateTemp("", "loghub2-json")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(file.Name())
+
+	err = json.NewEncoder(file).Encode(value)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+
+	return storage.SaveFile(ctx, file.Name(), fileName)
+}
+
+func ReadArchivedJobMetadata(ctx context.Context, storage Storage, jobId string) (*JobMetadata, error) {
+	data, err := storage.ReadFile(ctx, MetadataFileName(jobId))
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := JobMetadata{}
+	err = json.Unmarshal(data, &metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return &metadata, nil
+}
@@ -30,6 +30,18 @@ const noMoreSpaceLogWarning = "Content of the log is bigger than 16MB. Log is tr
 var ErrNoMoreSpaceForKey = errors.New(noMoreSpaceErrMessage)
 var ErrTooManyAppendItems = errors.New("too many append items")
 
+// Every change to the logs of a job is announced
+// in a Redis pub/sub channel, so live log streams can follow it.
+const logEventsChannelPrefix = "loghub2:log-events:"
+
+// Values to redact from the logs of a job are kept next to them.
+const secretsKeyPrefix = "loghub2:secrets:"
+
+const (
+	LogEventAppended = "appended"
+	LogEventArchived = "archived"
+)
+
 const firstLogEventScript = `
 redis.call('DEL', KEYS[1])
 local result = redis.call('RPUSH', KEYS[1], unpack(ARGV))
@@ -73,6 +85,9 @@ type RedisStorage struct {
 	Client         *redis.Client
 	MaxKeySize     int64
 	MaxAppendItems int
+	secrets        *secretsCipher
+	secretsErr     error
+	logEvents      *LogEventsHub
 }
 
 type RedisConfig struct {
@@ -82,6 +97,10 @@ type RedisConfig struct {
 	Password       string
 	MaxKeySize     int64
 	MaxAppendItems int
+
+	// SecretsKey is used to encrypt the values to redact from the logs of a job.
+	// Without it, job secrets can't be saved or read.
+	SecretsKey string
 }
 
 func NewRedisStorage(config RedisConfig) *RedisStorage {
@@ -102,10 +121,14 @@ func NewRedisStorage(config RedisConfig) *RedisStorage {
 		maxAppendItems = defaultMaxAppendItems
 	}
 
+	secrets, secretsErr := newSecretsCipher(config.SecretsKey)
 	return &RedisStorage{
 		Client:         rdb,
 		MaxKeySize:     maxKeySize,
 		MaxAppendItems: maxAppendItems,
+		secrets:        secrets,
+		secretsErr:     secretsErr,
+		logEvents:      NewLogEventsHub(rdb),
 	}
 }
 
@@ -205,9 +228,31 @@ func (s *RedisStorage) AppendLogsWithOptions(ctx context.Context, options Append
 		return err
 	}
 
+	// Logs were already appended, so a failure to notify
+	// live streams should not fail the request. Streams also
+	// check for new logs periodically, so they will catch up.
+	err = s.PublishLogEvent(ctx, options.Key, LogEventAppended)
+	if err != nil {
+		log.Printf("Error publishing log event for %s: %v", options.Key, err)
+	}
+
 	return nil
 }
 
+func LogEventsChannel(jobId string) string {
+	return logEventsChannelPrefix + jobId
+}
+
+func (s *RedisStorage) PublishLogEvent(ctx context.Context, jobId, event string) error {
+	return s.Client.Publish(ctx, LogEventsChannel(jobId), event).Err()
+}
+
+// SubscribeToLogEvents returns a subscription to the log events for a job.
+// All the subscriptions of the process share the same Redis connection, see LogEventsHub.
+func (s *RedisStorage) SubscribeToLogEvents(ctx context.Context, jobId string) (*LogEventsSubscription, error) {
+	return s.logEvents.Subscribe(ctx, jobId)
+}
+
 func (s *RedisStorage) JobIdExists(ctx context.Context, jobId string) bool {
 	exists, err := s.Client.Exists(ctx, jobId).Result()
 	if err != nil {
@@ -260,6 +305,72 @@ func (s *RedisStorage) GetLogsUsingRange(ctx context.Context, jobId string, star
 	return logs, nil
 }
 
+func (s *RedisStorage) GetLogsCount(ctx context.Context, jobId string) (int64, error) {
+	return s.Client.LLen(ctx, jobId).Result()
+}
+
+// SetLogEvent replaces the log event at position index.
+func (s *RedisStorage) SetLogEvent(ctx context.Context, jobId string, index int64, event string) error {
+	return s.Client.LSet(ctx, jobId, index, event).Err()
+}
+
+func (s *RedisStorage) SaveJobSecrets(ctx context.Context, jobId string, values []string) error {
+	if len(values) == 0 {
+		return nil
+	}
+
+	if s.secretsErr != nil {
+		return s.secretsErr
+	}
+
+	members := make([]interface{}, 0, len(values))
+	for _, value := range values {
+		members = append(members, s.secrets.encrypt(value))
+	}
+
+	key := secretsKeyPrefix + jobId
+	_, err := s.Client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SAdd(ctx, key, members...)
+		pipe.Expire(ctx, key, defaultTTL*time.Second)
+		return nil
+	})
+
+	return err
+}
+
+// GetJobSecrets returns the secret values registered for a job.
+// A missing secrets key is only an error if the job has secrets to decrypt.
+func (s *RedisStorage) GetJobSecrets(ctx context.Context, jobId string) ([]string, error) {
+	members, err := s.Client.SMembers(ctx, secretsKeyPrefix+jobId).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(members) == 0 {
+		return []string{}, nil
+	}
+
+	if s.secretsErr != nil {
+		return nil, s.secretsErr
+	}
+
+	values := make([]string, 0, len(members))
+	for _, member := range members {
+		value, err := s.secrets.decrypt(member)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+func (s *RedisStorage) DeleteJobSecrets(ctx context.Context, jobId string) error {
+	return s.Client.Del(ctx, secretsKeyPrefix+jobId).Err()
+}
+
 func (s *RedisStorage) DeleteLogs(ctx context.Context, jobId string) (int64, error) {
 	return s.Client.Del(ctx, jobId).Result()
 }
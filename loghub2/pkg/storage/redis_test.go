@@ -228,3 +228,43 @@ func DeletingAnExistentKeyWorks(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, keysDeleted, int64(1))
 }
+
+func Test__JobSecretsAreEncrypted(t *testing.T) {
+	ctx := context.Background()
+	secretsStorage := NewRedisStorage(RedisConfig{Address: "redis", Port: "6379", SecretsKey: "test-key"})
+	jobId := "Test__JobSecretsAreEncrypted"
+	defer secretsStorage.DeleteJobSecrets(ctx, jobId)
+
+	assert.Nil(t, secretsStorage.SaveJobSecrets(ctx, jobId, []string{"s3cr3t-value", "other-s3cr3t"}))
+	assert.Nil(t, secretsStorage.SaveJobSecrets(ctx, jobId, []string{"s3cr3t-value"}))
+
+	stored, err := secretsStorage.Client.SMembers(ctx, secretsKeyPrefix+jobId).Result()
+	assert.Nil(t, err)
+	assert.Len(t, stored, 2)
+	for _, member := range stored {
+		assert.NotContains(t, member, "s3cr3t")
+	}
+
+	ttl, err := secretsStorage.Client.TTL(ctx, secretsKeyPrefix+jobId).Result()
+	assert.Nil(t, err)
+	assert.Greater(t, ttl, time.Duration(0))
+
+	values, err := secretsStorage.GetJobSecrets(ctx, jobId)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"s3cr3t-value", "other-s3cr3t"}, values)
+
+	// a different key can't read them
+	otherStorage := NewRedisStorage(RedisConfig{Address: "redis", Port: "6379", SecretsKey: "other-key"})
+	_, err = otherStorage.GetJobSecrets(ctx, jobId)
+	assert.ErrorContains(t, err, "error decrypting secret")
+
+	// and without a key, stored secrets can't be used at all
+	assert.ErrorIs(t, redisStorage.SaveJobSecrets(ctx, jobId, []string{"s3cr3t-value"}), ErrNoSecretsKey)
+	_, err = redisStorage.GetJobSecrets(ctx, jobId)
+	assert.ErrorIs(t, err, ErrNoSecretsKey)
+
+	// but jobs without secrets don't need one
+	values, err = redisStorage.GetJobSecrets(ctx, "Test__JobSecretsAreEncrypted-none")
+	assert.Nil(t, err)
+	assert.Empty(t, values)
+}
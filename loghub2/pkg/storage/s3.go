@@ -3,6 +3,7 @@ package storage
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"log"
@@ -202,6 +203,24 @@ func (s *S3Storage) ReadFileAsReader(ctx context.Context, fileName string) (io.R
 	return response.Body, nil
 }
 
+// Note: the caller must close the returned reader.
+func (s *S3Storage) ReadFileRange(ctx context.Context, fileName string, offset, length int64) (io.ReadCloser, error) {
+	defer watchman.Benchmark(time.Now(), "s3.read")
+
+	byteRange := fmt.Sprintf("bytes=%d-%d", offset, offset+length-1)
+	response, err := s.Client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: &s.Bucket,
+		Key:    &fileName,
+		Range:  &byteRange,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Body, nil
+}
+
 func (s *S3Storage) DeleteFile(ctx context.Context, fileName string) error {
 	defer watchman.Benchmark(time.Now(), "s3.delete")
 
@@ -213,3 +232,29 @@ func (s *S3Storage) DeleteFile(ctx context.Context, fileName string) error {
 	_, err := s.Client.DeleteObject(ctx, &input)
 	return err
 }
+
+func (s *S3Storage) ListFiles(ctx context.Context, fn func(FileInfo) error) error {
+	defer watchman.Benchmark(time.Now(), "s3.list")
+
+	paginator := s3.NewListObjectsV2Paginator(s.Client, &s3.ListObjectsV2Input{Bucket: &s.Bucket})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, object := range page.Contents {
+			err = fn(FileInfo{
+				Name:      aws.ToString(object.Key),
+				Size:      object.Size,
+				UpdatedAt: aws.ToTime(object.LastModified),
+			})
+
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
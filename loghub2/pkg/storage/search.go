@@ -0,0 +1,316 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/renderedtext/go-watchman"
+)
+
+const (
+	MaxSearchContextLines = 50
+	DefaultSearchLimit    = 100
+	MaxSearchLimit        = 10000
+
+	// Lines are read from Redis in batches of this size,
+	// so we never hold the whole job log in memory while searching.
+	searchRedisBatchSize = 1000
+)
+
+var ErrLogsNotFound = errors.New("logs not found")
+
+// errSearchLimitReached is used to stop reading logs
+// once we have found enough matches.
+var errSearchLimitReached = errors.New("search limit reached")
+
+type SearchOptions struct {
+	Query        string
+	Regex        bool
+	IgnoreCase   bool
+	ContextLines int
+	Limit        int
+}
+
+// SearchLine is a log event, together with its token,
+// which is the position of the event in the job log.
+type SearchLine struct {
+	Token int64
+	Event []byte
+}
+
+type SearchMatch struct {
+	SearchLine
+	Before []SearchLine
+	After  []SearchLine
+}
+
+type SearchResult struct {
+	Matches int
+
+	// Truncated is true if the search stopped
+	// because the limit of matches was reached.
+	Truncated bool
+}
+
+// LogSearcher finds the log events whose text matches a query.
+// Only the text of cmd_started (directive) and cmd_output (output) events is searched.
+// Lines are pushed one by one through Process, and matches are
+// handed to the callback as soon as their context lines are known.
+type LogSearcher struct {
+	options SearchOptions
+	regex   *regexp.Regexp
+	query   []byte
+	fn      func(*SearchMatch) error
+	token   int64
+	before  []SearchLine
+	pending []*SearchMatch
+	matches int
+}
+
+// Validate checks the options, without starting a search.
+func (o SearchOptions) Validate() error {
+	_, err := o.compile()
+	if err != nil {
+		return err
+	}
+
+	if o.ContextLines < 0 || o.ContextLines > MaxSearchContextLines {
+		return fmt.Errorf("context lines must be between 0 and %d", MaxSearchContextLines)
+	}
+
+	if o.Limit < 0 || o.Limit > MaxSearchLimit {
+		return fmt.Errorf("limit must be between 0 and %d", MaxSearchLimit)
+	}
+
+	return nil
+}
+
+// compile returns the regex to use for the search,
+// or nil if the query can be matched as a plain substring.
+func (o SearchOptions) compile() (*regexp.Regexp, error) {
+	if o.Query == "" {
+		return nil, fmt.Errorf("query can't be empty")
+	}
+
+	if !o.Regex && !o.IgnoreCase {
+		return nil, nil
+	}
+
+	expr := o.Query
+	if !o.Regex {
+		expr = regexp.QuoteMeta(expr)
+	}
+
+	if o.IgnoreCase {
+		expr = "(?i)" + expr
+	}
+
+	regex, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("bad regex: %v", err)
+	}
+
+	return regex, nil
+}
+
+func NewLogSearcher(options SearchOptions, fn func(*SearchMatch) error) (*LogSearcher, error) {
+	err := options.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if options.Limit == 0 {
+		options.Limit = DefaultSearchLimit
+	}
+
+	regex, _ := options.compile()
+	return &LogSearcher{
+		options: options,
+		fn:      fn,
+		regex:   regex,
+		query:   []byte(options.Query),
+	}, nil
+}
+
+// Process checks the next log event.
+// The line is copied, so the caller can reuse its buffer.
+func (s *LogSearcher) Process(event []byte) error {
+	line := SearchLine{Token: s.token, Event: bytes.Clone(bytes.TrimRight(event, "\n"))}
+	s.token++
+
+	err := s.addAfterContext(line)
+	if err != nil {
+		return err
+	}
+
+	if s.matches < s.options.Limit && s.isMatch(line.Event) {
+		s.matches++
+		match := &SearchMatch{
+			SearchLine: line,
+			Before:     append([]SearchLine{}, s.before...),
+		}
+
+		s.pending = append(s.pending, match)
+		err = s.flush()
+		if err != nil {
+			return err
+		}
+	}
+
+	if s.options.ContextLines > 0 {
+		if len(s.before) == s.options.ContextLines {
+			s.before = s.before[1:]
+		}
+
+		s.before = append(s.before, line)
+	}
+
+	if s.matches >= s.options.Limit && len(s.pending) == 0 {
+		return errSearchLimitReached
+	}
+
+	return nil
+}
+
+// Finish hands the matches still waiting for their
+// after context lines to the callback.
+func (s *LogSearcher) Finish() error {
+	for _, match := range s.pending {
+		if err := s.fn(match); err != nil {
+			return err
+		}
+	}
+
+	s.pending = nil
+	return nil
+}
+
+func (s *LogSearcher) Matches() int {
+	return s.matches
+}
+
+func (s *LogSearcher) addAfterContext(line SearchLine) error {
+	for _, match := range s.pending {
+		match.After = append(match.After, line)
+	}
+
+	return s.flush()
+}
+
+// flush hands the matches with all their context lines to the callback, in order.
+func (s *LogSearcher) flush() error {
+	for len(s.pending) > 0 && len(s.pending[0].After) >= s.options.ContextLines {
+		if err := s.fn(s.pending[0]); err != nil {
+			return err
+		}
+
+		s.pending = s.pending[1:]
+	}
+
+	return nil
+}
+
+func (s *LogSearcher) isMatch(event []byte) bool {
+	text := eventText(event)
+	if text == nil {
+		return false
+	}
+
+	if s.regex != nil {
+		return s.regex.Match(text)
+	}
+
+	return bytes.Contains(text, s.query)
+}
+
+func eventText(event []byte) []byte {
+	var e struct {
+		Event     string `json:"event"`
+		Directive string `json:"directive"`
+		Output    string `json:"output"`
+	}
+
+	if err := json.Unmarshal(event, &e); err != nil {
+		return nil
+	}
+
+	switch e.Event {
+	case "cmd_started":
+		return []byte(e.Directive)
+	case "cmd_output":
+		return []byte(e.Output)
+	default:
+		return nil
+	}
+}
+
+// SearchJobLogs searches the logs of a job, calling fn for every match.
+// Logs for running jobs are read from Redis, and logs for finished jobs
+// are read from the archive in cloud storage. ErrLogsNotFound is returned
+// if the job has no logs in either of them.
+func SearchJobLogs(ctx context.Context, redisStorage *RedisStorage, cloudStorage Storage, jobId string, options SearchOptions, fn func(*SearchMatch) error) (*SearchResult, error) {
+	defer watchman.Benchmark(time.Now(), "logs.search")
+
+	searcher, err := NewLogSearcher(options, fn)
+	if err != nil {
+		return nil, err
+	}
+
+	if redisStorage.JobIdExists(ctx, jobId) {
+		err = searchRedisLogs(ctx, redisStorage, jobId, searcher)
+	} else {
+		// Not all backends report missing objects the same way,
+		// so any error here is treated as missing logs.
+		exists, existsErr := cloudStorage.Exists(ctx, jobId)
+		if !exists || existsErr != nil {
+			return nil, ErrLogsNotFound
+		}
+
+		err = ReadArchivedLogs(ctx, cloudStorage, jobId, AllLines(), searcher.Process)
+	}
+
+	result := &SearchResult{}
+	if errors.Is(err, errSearchLimitReached) {
+		result.Truncated = true
+		err = nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = searcher.Finish()
+	if err != nil {
+		return nil, err
+	}
+
+	result.Matches = searcher.Matches()
+	return result, nil
+}
+
+func searchRedisLogs(ctx context.Context, redisStorage *RedisStorage, jobId string, searcher *LogSearcher) error {
+	start := int64(0)
+	for {
+		logs, err := redisStorage.GetLogsUsingRange(ctx, jobId, start, start+searchRedisBatchSize-1)
+		if err != nil {
+			return err
+		}
+
+		for _, line := range logs {
+			if err := searcher.Process([]byte(line)); err != nil {
+				return err
+			}
+		}
+
+		if len(logs) < searchRedisBatchSize {
+			return nil
+		}
+
+		start += searchRedisBatchSize
+	}
+}
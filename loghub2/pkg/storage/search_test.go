@@ -0,0 +1,159 @@
+package storage
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__SearchOptionsValidate(t *testing.T) {
+	assert.NoError(t, SearchOptions{Query: "line"}.Validate())
+	assert.NoError(t, SearchOptions{Query: "line [0-9]+", Regex: true}.Validate())
+	assert.Error(t, SearchOptions{}.Validate())
+	assert.Error(t, SearchOptions{Query: "line [0-9", Regex: true}.Validate())
+	assert.Error(t, SearchOptions{Query: "line", ContextLines: -1}.Validate())
+	assert.Error(t, SearchOptions{Query: "line", ContextLines: MaxSearchContextLines + 1}.Validate())
+	assert.Error(t, SearchOptions{Query: "line", Limit: MaxSearchLimit + 1}.Validate())
+}
+
+func Test__LogSearcher(t *testing.T) {
+	lines := append(logLines(0, 25), `{"event":"cmd_started","directive":"echo LINE 1"}`, `{"event":"job_finished","result":"line 1"}`)
+
+	search := func(options SearchOptions) ([]*SearchMatch, error) {
+		matches := []*SearchMatch{}
+		searcher, err := NewLogSearcher(options, func(match *SearchMatch) error {
+			matches = append(matches, match)
+			return nil
+		})
+
+		require.NoError(t, err)
+		for _, line := range lines {
+			if err := searcher.Process([]byte(line)); err != nil {
+				return matches, err
+			}
+		}
+
+		return matches, searcher.Finish()
+	}
+
+	t.Run("substring", func(t *testing.T) {
+		matches, err := search(SearchOptions{Query: "line 1"})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19}, matchTokens(matches))
+		assert.Equal(t, `{"event":"cmd_output","output":"line 1"}`, string(matches[0].Event))
+	})
+
+	t.Run("ignore case also looks at directives", func(t *testing.T) {
+		matches, err := search(SearchOptions{Query: "line 1", IgnoreCase: true})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 25}, matchTokens(matches))
+	})
+
+	t.Run("regex", func(t *testing.T) {
+		matches, err := search(SearchOptions{Query: `^line (3|2[0-9])$`, Regex: true})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3, 20, 21, 22, 23, 24}, matchTokens(matches))
+	})
+
+	t.Run("context", func(t *testing.T) {
+		matches, err := search(SearchOptions{Query: `^line (0|5|6|24)$`, Regex: true, ContextLines: 2})
+		require.NoError(t, err)
+		require.Equal(t, []int64{0, 5, 6, 24}, matchTokens(matches))
+
+		assert.Empty(t, matches[0].Before)
+		assert.Equal(t, []int64{1, 2}, lineTokens(matches[0].After))
+		assert.Equal(t, []int64{3, 4}, lineTokens(matches[1].Before))
+		assert.Equal(t, []int64{6, 7}, lineTokens(matches[1].After))
+		assert.Equal(t, []int64{4, 5}, lineTokens(matches[2].Before))
+		assert.Equal(t, []int64{7, 8}, lineTokens(matches[2].After))
+		assert.Equal(t, []int64{22, 23}, lineTokens(matches[3].Before))
+		assert.Equal(t, []int64{25, 26}, lineTokens(matches[3].After))
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		matches, err := search(SearchOptions{Query: "line", Limit: 3, ContextLines: 1})
+		assert.ErrorIs(t, err, errSearchLimitReached)
+		assert.Equal(t, []int64{0, 1, 2}, matchTokens(matches))
+		assert.Equal(t, []int64{3}, lineTokens(matches[2].After))
+	})
+}
+
+func Test__SearchJobLogs(t *testing.T) {
+	bucketName := "search-test"
+	storage, err := NewGCSStorageWithClient(httpClient, bucketName)
+	require.NoError(t, err)
+	require.NoError(t, storage.CreateBucket(bucketName, TestProjectID))
+	defer storage.DeleteBucket(bucketName)
+
+	ctx := context.Background()
+
+	redisJobID := uuid.NewString()
+	require.NoError(t, redisStorage.AppendLogs(redisJobID, 0, trimLines(logLines(0, 1500))))
+	require.NoError(t, redisStorage.AppendLogs(redisJobID, 1500, trimLines(logLines(1500, 2500))))
+	defer redisStorage.DeleteLogs(ctx, redisJobID)
+
+	archivedJobID := uuid.NewString()
+	gzippedFileName, index, err := GzipChunked(ctx, writeLogLines(t, 2500), 100)
+	require.NoError(t, err)
+	require.NoError(t, storage.SaveFile(ctx, gzippedFileName, archivedJobID))
+	require.NoError(t, SaveArchiveIndex(ctx, storage, archivedJobID, index))
+	os.Remove(gzippedFileName)
+
+	for _, jobID := range []string{redisJobID, archivedJobID} {
+		matches := []*SearchMatch{}
+		result, err := SearchJobLogs(ctx, redisStorage, storage, jobID, SearchOptions{Query: "line 1999", ContextLines: 1}, func(match *SearchMatch) error {
+			matches = append(matches, match)
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, &SearchResult{Matches: 1}, result)
+		assert.Equal(t, []int64{1999}, matchTokens(matches))
+		assert.Equal(t, []int64{1998}, lineTokens(matches[0].Before))
+		assert.Equal(t, []int64{2000}, lineTokens(matches[0].After))
+
+		result, err = SearchJobLogs(ctx, redisStorage, storage, jobID, SearchOptions{Query: "line 2", Limit: 5}, func(match *SearchMatch) error {
+			return nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, &SearchResult{Matches: 5, Truncated: true}, result)
+	}
+
+	_, err = SearchJobLogs(ctx, redisStorage, storage, uuid.NewString(), SearchOptions{Query: "line"}, func(match *SearchMatch) error {
+		return nil
+	})
+
+	assert.ErrorIs(t, err, ErrLogsNotFound)
+}
+
+func matchTokens(matches []*SearchMatch) []int64 {
+	tokens := []int64{}
+	for _, match := range matches {
+		tokens = append(tokens, match.Token)
+	}
+
+	return tokens
+}
+
+func lineTokens(lines []SearchLine) []int64 {
+	tokens := []int64{}
+	for _, line := range lines {
+		tokens = append(tokens, line.Token)
+	}
+
+	return tokens
+}
+
+func trimLines(lines []string) []string {
+	for i := range lines {
+		lines[i] = strings.TrimSuffix(lines[i], "\n")
+	}
+
+	return lines
+}
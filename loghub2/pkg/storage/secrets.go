@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+var ErrNoSecretsKey = errors.New("no key configured for job secrets")
+
+// secretsCipher encrypts the values to redact before they are stored in Redis.
+// The values are needed as they are to find them in the logs, so they can't be hashed.
+type secretsCipher struct {
+	aead     cipher.AEAD
+	nonceKey []byte
+}
+
+func newSecretsCipher(key string) (*secretsCipher, error) {
+	if key == "" {
+		return nil, ErrNoSecretsKey
+	}
+
+	encryptionKey := deriveKey(key, "loghub2:secrets:encryption")
+	block, err := aes.NewCipher(encryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &secretsCipher{aead: aead, nonceKey: deriveKey(key, "loghub2:secrets:nonce")}, nil
+}
+
+func deriveKey(key, purpose string) []byte {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(purpose))
+	return mac.Sum(nil)
+}
+
+// The nonce is derived from the value, so the same value is always encrypted
+// the same way, and registering it again doesn't add a new member to the set.
+// That only reveals whether two stored values are equal, which is fine here.
+func (c *secretsCipher) encrypt(value string) string {
+	mac := hmac.New(sha256.New, c.nonceKey)
+	mac.Write([]byte(value))
+	nonce := mac.Sum(nil)[:c.aead.NonceSize()]
+
+	ciphertext := c.aead.Seal(nonce, nonce, []byte(value), nil)
+	return base64.StdEncoding.EncodeToString(ciphertext)
+}
+
+func (c *secretsCipher) decrypt(value string) (string, error) {
+	ciphertext, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", fmt.Errorf("error decoding secret: %v", err)
+	}
+
+	nonceSize := c.aead.NonceSize()
+	if len(ciphertext) < nonceSize {
+		return "", fmt.Errorf("secret is too short")
+	}
+
+	plaintext, err := c.aead.Open(nil, ciphertext[:nonceSize], ciphertext[nonceSize:], nil)
+	if err != nil {
+		return "", fmt.Errorf("error decrypting secret: %v", err)
+	}
+
+	return string(plaintext), nil
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"time"
 
 	"github.com/semaphoreio/semaphore/loghub2/pkg/utils"
 )
@@ -17,7 +18,15 @@ type Storage interface {
 	Exists(ctx context.Context, fileName string) (bool, error)
 	ReadFile(ctx context.Context, fileName string) ([]byte, error)
 	ReadFileAsReader(ctx context.Context, fileName string) (io.ReadCloser, error)
+	ReadFileRange(ctx context.Context, fileName string, offset, length int64) (io.ReadCloser, error)
 	DeleteFile(ctx context.Context, fileName string) error
+	ListFiles(ctx context.Context, fn func(FileInfo) error) error
+}
+
+type FileInfo struct {
+	Name      string
+	Size      int64
+	UpdatedAt time.Time
 }
 
 func InitStorage() (Storage, error) {
@@ -53,6 +62,31 @@ func InitStorage() (Storage, error) {
 		credsFile := utils.AssertEnvVar("GOOGLE_APPLICATION_CREDENTIALS")
 
 		return NewGCSStorage(credsFile, gcsBucket)
+	case "filesystem":
+		var retention time.Duration
+		if value := os.Getenv("LOGS_STORAGE_FS_RETENTION"); value != "" {
+			r, err := time.ParseDuration(value)
+			if err != nil {
+				return nil, fmt.Errorf("bad LOGS_STORAGE_FS_RETENTION '%s': %v", value, err)
+			}
+
+			retention = r
+		}
+
+		fsStorage, err := NewFilesystemStorage(FilesystemStorageOptions{
+			Path:      utils.AssertEnvVar("LOGS_STORAGE_FS_PATH"),
+			Retention: retention,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		fsStorage.StartRetention(context.Background(), defaultRetentionInterval)
+		return fsStorage, nil
+	case "memory":
+		log.Printf("Using in-memory storage - archived logs will be lost on restart")
+		return NewMemoryStorage(), nil
 	default:
 		return nil, fmt.Errorf("cache backend '%s' is not available", backend)
 	}
@@ -104,6 +104,20 @@ func (w *Worker) handleMessage(delivery tackle.Delivery) error {
 		return err
 	}
 
+	// Logs archived before indexes existed do not have one.
+	err = w.storageClient.DeleteFile(ctx, storage.IndexFileName(jobID))
+	if err != nil && !errors.Is(err, gcs.ErrObjectNotExist) {
+		log.Printf("JobDeletion Worker: Error deleting logs index for JobID=%s: %v", jobID, err)
+		return err
+	}
+
+	// Logs archived before retention policies existed do not have metadata.
+	err = w.storageClient.DeleteFile(ctx, storage.MetadataFileName(jobID))
+	if err != nil && !errors.Is(err, gcs.ErrObjectNotExist) {
+		log.Printf("JobDeletion Worker: Error deleting logs metadata for JobID=%s: %v", jobID, err)
+		return err
+	}
+
 	err = watchman.Increment("retention.deleted.success")
 	if err != nil {
 		log.Printf("JobDeletion Worker: Failed to increment retention.deleted.success metric: %+v", err)
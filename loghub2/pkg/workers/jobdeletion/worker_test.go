@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/uuid"
 	server_farm_job "github.com/semaphoreio/semaphore/loghub2/pkg/protos/server_farm.job"
+	"github.com/semaphoreio/semaphore/loghub2/pkg/storage"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -44,6 +45,14 @@ func (m *mockStorage) ReadFileAsReader(ctx context.Context, fileName string) (io
 	return nil, nil
 }
 
+func (m *mockStorage) ReadFileRange(ctx context.Context, fileName string, offset, length int64) (io.ReadCloser, error) {
+	return nil, nil
+}
+
+func (m *mockStorage) ListFiles(ctx context.Context, fn func(storage.FileInfo) error) error {
+	return nil
+}
+
 func (m *mockStorage) DeleteFile(ctx context.Context, fileName string) error {
 	if m.deleteError != nil {
 		return m.deleteError
@@ -116,6 +125,28 @@ func Test__HandleMessage(t *testing.T) {
 		assert.False(t, mockStore.files[jobID])
 	})
 
+	t.Run("deletes index and metadata too", func(t *testing.T) {
+		mockStore := newMockStorage()
+		worker, _ := NewWorker("", mockStore)
+
+		jobID := uuid.New().String()
+		mockStore.files[jobID] = true
+		mockStore.files[storage.IndexFileName(jobID)] = true
+		mockStore.files[storage.MetadataFileName(jobID)] = true
+
+		event := &server_farm_job.JobDeleted{
+			JobId:     jobID,
+			DeletedAt: timestamppb.Now(),
+		}
+		body, _ := proto.Marshal(event)
+		delivery := &mockDelivery{body: body}
+
+		err := worker.handleMessage(delivery)
+
+		assert.Nil(t, err)
+		assert.Empty(t, mockStore.files)
+	})
+
 	t.Run("delete fails returns error", func(t *testing.T) {
 		mockStore := newMockStorage()
 		mockStore.deleteError = errors.New("delete failed")
@@ -15,6 +15,8 @@ import (
 	quotas "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/quotas"
 	agentcleaner "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/workers/agentcleaner"
 	"github.com/semaphoreio/semaphore/self_hosted_hub/pkg/workers/agentcounter"
+	"github.com/semaphoreio/semaphore/self_hosted_hub/pkg/workers/agenteventcleaner"
+	"github.com/semaphoreio/semaphore/self_hosted_hub/pkg/workers/autoscalinghints"
 	disconnected_cleaner "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/workers/disconnectedcleaner"
 	"github.com/semaphoreio/semaphore/self_hosted_hub/pkg/workers/metrics"
 	log "github.com/sirupsen/logrus"
@@ -86,12 +88,23 @@ func startDisconnectedAgentCleaner() {
 	disconnected_cleaner.Start()
 }
 
+func startAgentEventCleaner() {
+	log.Println("Starting Agent Event Cleaner")
+	agenteventcleaner.Start()
+}
+
 func startMetricsCollector() {
 	log.Println("Starting metrics collector")
 	collector := metrics.NewCollector()
 	collector.Start()
 }
 
+func startAutoscalingHints() {
+	log.Println("Starting autoscaling hints notifier")
+	notifier := autoscalinghints.NewNotifier()
+	notifier.Start()
+}
+
 func configureWatchman(metricNamespace string) {
 	onPremEnv, exists := os.LookupEnv("ON_PREM")
 	if !exists {
@@ -171,12 +184,17 @@ func main() {
 	if os.Getenv("START_AGENT_CLEANER") == "yes" {
 		go startAgentCleaner()
 		go startDisconnectedAgentCleaner()
+		go startAgentEventCleaner()
 	}
 
 	if os.Getenv("START_METRICS_COLLECTOR") == "yes" {
 		go startMetricsCollector()
 	}
 
+	if os.Getenv("START_AUTOSCALING_HINTS") == "yes" {
+		go startAutoscalingHints()
+	}
+
 	log.Println("Self Hosted Hub is UP.")
 
 	select {}
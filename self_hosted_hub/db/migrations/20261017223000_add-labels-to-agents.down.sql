@@ -0,0 +1,6 @@
+begin;
+
+ALTER TABLE agents DROP COLUMN labels;
+ALTER TABLE occupation_requests DROP COLUMN labels;
+
+commit;
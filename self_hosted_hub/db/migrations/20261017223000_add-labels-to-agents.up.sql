@@ -0,0 +1,6 @@
+begin;
+
+ALTER TABLE agents ADD COLUMN labels jsonb DEFAULT '{}'::jsonb NOT NULL;
+ALTER TABLE occupation_requests ADD COLUMN labels jsonb DEFAULT '{}'::jsonb NOT NULL;
+
+commit;
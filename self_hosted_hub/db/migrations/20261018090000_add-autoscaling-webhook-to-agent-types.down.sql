@@ -0,0 +1,8 @@
+begin;
+
+ALTER TABLE agent_types DROP COLUMN autoscaling_webhook_url;
+ALTER TABLE agent_types DROP COLUMN autoscaling_webhook_secret;
+ALTER TABLE agent_types DROP COLUMN autoscaling_queued_jobs_threshold;
+ALTER TABLE agent_types DROP COLUMN autoscaling_idle_agents_threshold;
+
+commit;
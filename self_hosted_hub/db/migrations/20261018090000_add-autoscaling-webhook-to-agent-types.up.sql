@@ -0,0 +1,8 @@
+begin;
+
+ALTER TABLE agent_types ADD COLUMN autoscaling_webhook_url text DEFAULT ''::text;
+ALTER TABLE agent_types ADD COLUMN autoscaling_webhook_secret text DEFAULT ''::text;
+ALTER TABLE agent_types ADD COLUMN autoscaling_queued_jobs_threshold integer DEFAULT 0;
+ALTER TABLE agent_types ADD COLUMN autoscaling_idle_agents_threshold integer DEFAULT 0;
+
+commit;
@@ -0,0 +1,5 @@
+begin;
+
+ALTER TABLE agents DROP COLUMN long_poll;
+
+commit;
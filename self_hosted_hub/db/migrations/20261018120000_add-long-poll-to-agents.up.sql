@@ -0,0 +1,5 @@
+begin;
+
+ALTER TABLE agents ADD COLUMN long_poll boolean DEFAULT false NOT NULL;
+
+commit;
@@ -0,0 +1,7 @@
+begin;
+
+ALTER TABLE agent_types DROP COLUMN oidc_issuer;
+ALTER TABLE agent_types DROP COLUMN oidc_audience;
+ALTER TABLE agent_types DROP COLUMN oidc_name_template;
+
+commit;
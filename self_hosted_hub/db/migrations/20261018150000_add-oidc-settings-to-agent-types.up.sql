@@ -0,0 +1,7 @@
+begin;
+
+ALTER TABLE agent_types ADD COLUMN oidc_issuer text DEFAULT ''::text;
+ALTER TABLE agent_types ADD COLUMN oidc_audience text DEFAULT ''::text;
+ALTER TABLE agent_types ADD COLUMN oidc_name_template text DEFAULT ''::text;
+
+commit;
@@ -0,0 +1,5 @@
+begin;
+
+DROP TABLE agent_events;
+
+commit;
@@ -0,0 +1,23 @@
+begin;
+
+CREATE TABLE agent_events (
+  id uuid DEFAULT uuid_generate_v4() PRIMARY KEY,
+  organization_id uuid NOT NULL,
+  agent_type_name character varying(100) NOT NULL,
+  agent_id uuid NOT NULL,
+  agent_name character varying(100) NOT NULL,
+  hostname text DEFAULT '',
+  ip_address text DEFAULT '',
+  event text NOT NULL,
+  job_id uuid,
+  job_result text DEFAULT '',
+  created_at timestamp without time zone NOT NULL DEFAULT now()
+);
+
+CREATE INDEX idx_agent_events_org_agent_name ON agent_events USING btree (organization_id, agent_name, created_at);
+CREATE INDEX idx_agent_events_org_job ON agent_events USING btree (organization_id, job_id);
+CREATE INDEX idx_agent_events_agent ON agent_events USING btree (agent_id, event);
+CREATE INDEX idx_agent_events_org_created_at ON agent_events USING btree (organization_id, created_at);
+CREATE INDEX idx_agent_events_created_at ON agent_events USING btree (created_at);
+
+commit;
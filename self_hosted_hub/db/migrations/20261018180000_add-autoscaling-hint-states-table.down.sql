@@ -0,0 +1,5 @@
+begin;
+
+DROP TABLE autoscaling_hint_states;
+
+commit;
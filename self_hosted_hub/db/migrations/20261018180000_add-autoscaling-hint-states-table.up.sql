@@ -0,0 +1,14 @@
+begin;
+
+CREATE TABLE autoscaling_hint_states (
+  organization_id uuid NOT NULL,
+  agent_type_name character varying(100) NOT NULL,
+  queued_samples jsonb DEFAULT '[]'::jsonb NOT NULL,
+  queue_breached boolean DEFAULT false NOT NULL,
+  idle_breached boolean DEFAULT false NOT NULL,
+  checked_at timestamp without time zone,
+  PRIMARY KEY (organization_id, agent_type_name),
+  FOREIGN KEY (organization_id, agent_type_name) REFERENCES agent_types(organization_id, name) ON DELETE CASCADE
+);
+
+commit;
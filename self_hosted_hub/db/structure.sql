@@ -39,6 +39,25 @@ COMMENT ON EXTENSION "uuid-ossp" IS 'generate universally unique identifiers (UU
 
 SET default_tablespace = '';
 
+--
+-- Name: agent_events; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.agent_events (
+    id uuid DEFAULT public.uuid_generate_v4() NOT NULL,
+    organization_id uuid NOT NULL,
+    agent_type_name character varying(100) NOT NULL,
+    agent_id uuid NOT NULL,
+    agent_name character varying(100) NOT NULL,
+    hostname text DEFAULT ''::text,
+    ip_address text DEFAULT ''::text,
+    event text NOT NULL,
+    job_id uuid,
+    job_result text DEFAULT ''::text,
+    created_at timestamp without time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: agent_types; Type: TABLE; Schema: public; Owner: -
 --
@@ -53,7 +72,14 @@ CREATE TABLE public.agent_types (
     name_assignment_origin text DEFAULT 'ASSIGNMENT_ORIGIN_AGENT'::text,
     release_name_after integer DEFAULT 0,
     aws_account text DEFAULT ''::text,
-    aws_role_name_patterns text DEFAULT ''::text
+    aws_role_name_patterns text DEFAULT ''::text,
+    autoscaling_webhook_url text DEFAULT ''::text,
+    autoscaling_webhook_secret text DEFAULT ''::text,
+    autoscaling_queued_jobs_threshold integer DEFAULT 0,
+    autoscaling_idle_agents_threshold integer DEFAULT 0,
+    oidc_issuer text DEFAULT ''::text,
+    oidc_audience text DEFAULT ''::text,
+    oidc_name_template text DEFAULT ''::text
 );
 
 
@@ -89,7 +115,23 @@ CREATE TABLE public.agents (
     interrupted_at timestamp without time zone,
     interruption_grace_period integer DEFAULT 0,
     state text DEFAULT 'registered'::text,
-    disconnected_at timestamp without time zone
+    disconnected_at timestamp without time zone,
+    labels jsonb DEFAULT '{}'::jsonb NOT NULL,
+    long_poll boolean DEFAULT false NOT NULL
+);
+
+
+--
+-- Name: autoscaling_hint_states; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.autoscaling_hint_states (
+    organization_id uuid NOT NULL,
+    agent_type_name character varying(100) NOT NULL,
+    queued_samples jsonb DEFAULT '[]'::jsonb NOT NULL,
+    queue_breached boolean DEFAULT false NOT NULL,
+    idle_breached boolean DEFAULT false NOT NULL,
+    checked_at timestamp without time zone
 );
 
 
@@ -101,7 +143,8 @@ CREATE TABLE public.occupation_requests (
     organization_id uuid NOT NULL,
     agent_type_name character varying(100) NOT NULL,
     job_id uuid NOT NULL,
-    created_at timestamp without time zone
+    created_at timestamp without time zone,
+    labels jsonb DEFAULT '{}'::jsonb NOT NULL
 );
 
 
@@ -115,6 +158,14 @@ CREATE TABLE public.schema_migrations (
 );
 
 
+--
+-- Name: agent_events agent_events_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.agent_events
+    ADD CONSTRAINT agent_events_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: agent_types agent_types_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -131,6 +182,14 @@ ALTER TABLE ONLY public.agents
     ADD CONSTRAINT agents_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: autoscaling_hint_states autoscaling_hint_states_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.autoscaling_hint_states
+    ADD CONSTRAINT autoscaling_hint_states_pkey PRIMARY KEY (organization_id, agent_type_name);
+
+
 --
 -- Name: occupation_requests occupation_requests_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -147,6 +206,41 @@ ALTER TABLE ONLY public.schema_migrations
     ADD CONSTRAINT schema_migrations_pkey PRIMARY KEY (version);
 
 
+--
+-- Name: idx_agent_events_agent; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_agent_events_agent ON public.agent_events USING btree (agent_id, event);
+
+
+--
+-- Name: idx_agent_events_created_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_agent_events_created_at ON public.agent_events USING btree (created_at);
+
+
+--
+-- Name: idx_agent_events_org_agent_name; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_agent_events_org_agent_name ON public.agent_events USING btree (organization_id, agent_name, created_at);
+
+
+--
+-- Name: idx_agent_events_org_created_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_agent_events_org_created_at ON public.agent_events USING btree (organization_id, created_at);
+
+
+--
+-- Name: idx_agent_events_org_job; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_agent_events_org_job ON public.agent_events USING btree (organization_id, job_id);
+
+
 --
 -- Name: uix_agent_name_in_orgs; Type: INDEX; Schema: public; Owner: -
 --
@@ -183,6 +277,14 @@ ALTER TABLE ONLY public.agents
     ADD CONSTRAINT agents_organization_id_fkey FOREIGN KEY (organization_id, agent_type_name) REFERENCES public.agent_types(organization_id, name);
 
 
+--
+-- Name: autoscaling_hint_states autoscaling_hint_states_organization_id_agent_type_name_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.autoscaling_hint_states
+    ADD CONSTRAINT autoscaling_hint_states_organization_id_agent_type_name_fkey FOREIGN KEY (organization_id, agent_type_name) REFERENCES public.agent_types(organization_id, name) ON DELETE CASCADE;
+
+
 --
 -- Name: occupation_requests occupation_requests_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -218,7 +320,7 @@ SET row_security = off;
 --
 
 COPY public.schema_migrations (version, dirty) FROM stdin;
-20230930195246	f
+20261018180000	f
 \.
 
 
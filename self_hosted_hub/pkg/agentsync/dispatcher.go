@@ -0,0 +1,107 @@
+package agentsync
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+	database "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/database"
+	models "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/models"
+)
+
+// Dispatcher wakes up the long-polling sync requests of agents
+// when an occupation request for their agent type is created.
+type Dispatcher struct {
+	mu sync.Mutex
+
+	// waiters are kept in the order they subscribed,
+	// so the agents waiting for the longest are woken up first.
+	waiters map[string][]chan *WakeUp
+}
+
+// WakeUp is sent to one agent waiting for jobs at a time.
+// If the agent can't take the occupation request, because it doesn't
+// have its labels or another agent took it first, it passes it on.
+type WakeUp struct {
+	dispatcher *Dispatcher
+	key        string
+	visited    map[chan *WakeUp]struct{}
+}
+
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{
+		waiters: map[string][]chan *WakeUp{},
+	}
+}
+
+// Start listens for occupation requests created by any instance, until the context is done.
+func (d *Dispatcher) Start(ctx context.Context) {
+	database.Listen(ctx, models.OccupationRequestsChannel, d.Notify)
+}
+
+// Notify wakes up one of the agents waiting for jobs for the agent type in the key.
+func (d *Dispatcher) Notify(key string) {
+	d.wake(&WakeUp{
+		dispatcher: d,
+		key:        key,
+		visited:    map[chan *WakeUp]struct{}{},
+	})
+}
+
+// Pass wakes up the next agent that wasn't woken up for this occupation request yet.
+func (w *WakeUp) Pass() {
+	w.dispatcher.wake(w)
+}
+
+func (d *Dispatcher) wake(w *WakeUp) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, ch := range d.waiters[w.key] {
+		if _, ok := w.visited[ch]; ok {
+			continue
+		}
+
+		// Agents with a pending wake up are skipped,
+		// since they will look for an occupation request anyway.
+		w.visited[ch] = struct{}{}
+		select {
+		case ch <- w:
+			return
+		default:
+		}
+	}
+}
+
+// Subscribe returns a channel receiving a wake up when an occupation request
+// for the agent type is created, and a function to stop receiving them.
+func (d *Dispatcher) Subscribe(orgID uuid.UUID, agentTypeName string) (<-chan *WakeUp, func()) {
+	key := models.OccupationRequestKey(orgID, agentTypeName)
+	ch := make(chan *WakeUp, 1)
+
+	d.mu.Lock()
+	d.waiters[key] = append(d.waiters[key], ch)
+	d.mu.Unlock()
+
+	return ch, func() {
+		d.mu.Lock()
+		for i, waiter := range d.waiters[key] {
+			if waiter == ch {
+				d.waiters[key] = append(d.waiters[key][:i:i], d.waiters[key][i+1:]...)
+				break
+			}
+		}
+
+		if len(d.waiters[key]) == 0 {
+			delete(d.waiters, key)
+		}
+		d.mu.Unlock()
+
+		// A wake up the agent didn't get to handle goes to the next agent.
+		select {
+		case w := <-ch:
+			w.Pass()
+		default:
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package agentsync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/semaphoreio/semaphore/self_hosted_hub/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__Dispatcher(t *testing.T) {
+	d := NewDispatcher()
+	orgID := uuid.New()
+	key := models.OccupationRequestKey(orgID, "s1-test")
+
+	first, unsubscribeFirst := d.Subscribe(orgID, "s1-test")
+	second, unsubscribeSecond := d.Subscribe(orgID, "s1-test")
+	third, unsubscribeThird := d.Subscribe(orgID, "s1-test")
+	other, unsubscribeOther := d.Subscribe(orgID, "s1-other")
+	defer unsubscribeSecond()
+	defer unsubscribeThird()
+	defer unsubscribeOther()
+
+	// only the agent waiting for the longest is woken up
+	d.Notify(key)
+	require.Len(t, first, 1)
+	require.Len(t, second, 0)
+	require.Len(t, third, 0)
+	require.Len(t, other, 0)
+
+	// agents with a pending wake up are skipped
+	d.Notify(key)
+	require.Len(t, first, 1)
+	require.Len(t, second, 1)
+
+	// a wake up passed on goes to the agents not woken up for it yet
+	(<-first).Pass()
+	require.Len(t, first, 0)
+	require.Len(t, third, 1)
+
+	// and stops once every agent was woken up for it
+	(<-third).Pass()
+	require.Len(t, first, 0)
+	require.Len(t, second, 1)
+	require.Len(t, third, 0)
+
+	// a pending wake up of an agent that stops waiting goes to the next agent
+	<-second
+	d.Notify(key)
+	require.Len(t, first, 1)
+	unsubscribeFirst()
+	require.Len(t, second, 1)
+
+	// unsubscribed agents are not woken up anymore
+	<-second
+	d.Notify(key)
+	require.Len(t, first, 0)
+	require.Len(t, second, 1)
+}
+
+func Test__LongPollTimeout(t *testing.T) {
+	assert.Equal(t, 10*time.Second, longPollTimeout())
+
+	t.Setenv("LONG_POLL_TIMEOUT", "2000")
+	assert.Equal(t, 2*time.Second, longPollTimeout())
+
+	t.Setenv("LONG_POLL_TIMEOUT", "60000")
+	assert.Equal(t, 12*time.Second, longPollTimeout())
+
+	t.Setenv("LONG_POLL_TIMEOUT", "-1")
+	assert.Equal(t, time.Duration(0), longPollTimeout())
+}
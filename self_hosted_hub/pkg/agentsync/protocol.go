@@ -59,6 +59,15 @@ const ShutdownReasonJobFinished = "job-finished"
 const defaultIntervalFloorMillis = 4000
 const defaultIntervalCeilMillis = 6000
 
+// Agents using long polling are kept waiting for a job for up to 10s,
+// which needs to stay below the 15s timeout of the public API requests,
+// so LONG_POLL_TIMEOUT can't set it past 12s.
+// When nothing arrives, they sync again after 100-500ms.
+const defaultLongPollTimeoutMillis = 10000
+const maxLongPollTimeoutMillis = 12000
+const longPollIntervalFloorMillis = 100
+const longPollIntervalCeilMillis = 500
+
 type Request struct {
 	State         AgentState `json:"state"`
 	JobID         string     `json:"job_id"`
@@ -73,7 +82,7 @@ type Response struct {
 	NextSyncAfter  int            `json:"next_sync_after"`
 }
 
-func Process(ctx context.Context, quotaClient *quotas.QuotaClient, agentCounter *agentcounter.AgentCounter, publisher *amqp.Publisher, agent *models.Agent, request *Request) (*Response, error) {
+func Process(ctx context.Context, quotaClient *quotas.QuotaClient, agentCounter *agentcounter.AgentCounter, publisher *amqp.Publisher, dispatcher *Dispatcher, agent *models.Agent, request *Request) (*Response, error) {
 	var err error
 
 	// If the self_hosted_agents feature was disabled for the organization,
@@ -94,7 +103,7 @@ func Process(ctx context.Context, quotaClient *quotas.QuotaClient, agentCounter
 		agentCounter.Refresh()
 	}
 
-	return answer(ctx, publisher, agent, request)
+	return answer(ctx, publisher, dispatcher, agent, request)
 }
 
 func hasEnoughQuota(ctx context.Context, quotaClient *quotas.QuotaClient, agentCounter *agentcounter.AgentCounter, agent *models.Agent) bool {
@@ -126,10 +135,10 @@ func hasEnoughQuota(ctx context.Context, quotaClient *quotas.QuotaClient, agentC
 	return true
 }
 
-func answer(ctx context.Context, publisher *amqp.Publisher, agent *models.Agent, req *Request) (*Response, error) {
+func answer(ctx context.Context, publisher *amqp.Publisher, dispatcher *Dispatcher, agent *models.Agent, req *Request) (*Response, error) {
 	switch req.State {
 	case AgentStateWaitingForJobs:
-		return handleWaitingForJobsState(ctx, publisher, agent, req)
+		return handleWaitingForJobsState(ctx, publisher, dispatcher, agent, req)
 
 	case AgentStateRunningJob:
 		return handleRunningJobState(agent, req)
@@ -150,7 +159,7 @@ func answer(ctx context.Context, publisher *amqp.Publisher, agent *models.Agent,
 	panic("invalid state - this should never happen")
 }
 
-func handleWaitingForJobsState(ctx context.Context, publisher *amqp.Publisher, agent *models.Agent, req *Request) (*Response, error) {
+func handleWaitingForJobsState(ctx context.Context, publisher *amqp.Publisher, dispatcher *Dispatcher, agent *models.Agent, req *Request) (*Response, error) {
 
 	// If the agent has been disconnected from the UI, tell it to shut down.
 	if agent.DisabledAt != nil {
@@ -173,9 +182,23 @@ func handleWaitingForJobsState(ctx context.Context, publisher *amqp.Publisher, a
 		return actionRunJob(agent.AssignedJobID.String()), nil
 	}
 
+	// Agents that support long polling subscribe before looking for an occupation request,
+	// so requests created right after the lookup also wake them up.
+	var wakeUps <-chan *WakeUp
+	if agent.LongPoll && dispatcher != nil {
+		ch, unsubscribe := dispatcher.Subscribe(agent.OrganizationID, agent.AgentTypeName)
+		defer unsubscribe()
+		wakeUps = ch
+	}
+
 	// If the agent hasn't been assigned to a job yet,
 	// but an occupation request exists, tell it to run it.
-	if jobID, err := models.OccupyAgent(agent); err == nil {
+	jobID, err := models.OccupyAgent(agent)
+	if err != nil && wakeUps != nil {
+		jobID, err = waitForJob(ctx, wakeUps, agent)
+	}
+
+	if err == nil {
 		if err := publisher.PublishStartedCallback(ctx, jobID, agent); err != nil {
 			log.Errorf("Error publishing started message for %s: %v", jobID, err)
 		}
@@ -186,7 +209,7 @@ func handleWaitingForJobsState(ctx context.Context, publisher *amqp.Publisher, a
 	// If the agent is not configured to shut down due to idleness,
 	// it should continue waiting for more jobs.
 	if agent.IdleTimeout == 0 {
-		return actionContinue(req), nil
+		return continueWaiting(req, wakeUps != nil), nil
 	}
 
 	// If the agent is configured to shut down due to idleness,
@@ -194,13 +217,49 @@ func handleWaitingForJobsState(ctx context.Context, publisher *amqp.Publisher, a
 	// for idleness happens after the check for a new job assignment.
 	idleFor := int(time.Since(*agent.LastStateChangeAt) / time.Second)
 	if idleFor < agent.IdleTimeout {
-		return actionContinue(req), nil
+		return continueWaiting(req, wakeUps != nil), nil
 	}
 
 	// If there's no error disabling the agent, the agent can safely shut down.
 	return actionShutdown(ShutdownReasonIdle), nil
 }
 
+// waitForJob keeps the agent waiting until it takes an occupation request,
+// the long polling timeout expires, or the agent would become idle for too long.
+func waitForJob(ctx context.Context, wakeUps <-chan *WakeUp, agent *models.Agent) (string, error) {
+	timeout := longPollTimeout()
+	if agent.IdleTimeout > 0 {
+		idleDeadline := agent.LastStateChangeAt.Add(time.Duration(agent.IdleTimeout) * time.Second)
+		timeout = min(timeout, time.Until(idleDeadline))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+
+		// Other agents might take the request first, or be the only ones with its labels,
+		// so if this one doesn't get it, it lets the next agent try, and keeps waiting.
+		case wakeUp := <-wakeUps:
+			jobID, err := models.OccupyAgent(agent)
+			if err == nil {
+				return jobID, nil
+			}
+
+			wakeUp.Pass()
+		}
+	}
+}
+
+func longPollTimeout() time.Duration {
+	millis := valueFromEnv(os.Getenv("LONG_POLL_TIMEOUT"), defaultLongPollTimeoutMillis)
+	millis = min(max(millis, 0), maxLongPollTimeoutMillis)
+	return time.Duration(millis) * time.Millisecond
+}
+
 func handleRunningJobState(agent *models.Agent, req *Request) (*Response, error) {
 	// If the job the agent is running was stopped, tell it to stop it.
 	if agent.JobStopRequestedAt != nil {
@@ -230,6 +289,12 @@ func handleFinishedJobState(ctx context.Context, publisher *amqp.Publisher, agen
 		return nil, err
 	}
 
+	err = models.RecordJobFinished(ctx, agent, jobUUID, string(result))
+	if err != nil {
+		logging.ForAgent(agent).Errorf("Error recording %s finished: %v", jobID, err)
+		return nil, err
+	}
+
 	/*
 	 * We only release agents that will be assigned to more jobs, and that haven't been interrupted.
 	 * The other agents will be told to shut down, so we don't need to release them.
@@ -317,6 +382,20 @@ func actionContinue(req *Request) *Response {
 	}
 }
 
+func continueWaiting(req *Request, longPolled bool) *Response {
+	// The agent was already kept waiting for a while,
+	// so it can start waiting again right away.
+	if longPolled {
+		millis, _ := millisInRange(longPollIntervalFloorMillis, longPollIntervalCeilMillis)
+		return &Response{
+			Action:        AgentActionContinue,
+			NextSyncAfter: millis,
+		}
+	}
+
+	return actionContinue(req)
+}
+
 func actionShutdown(reason ShutdownReason) *Response {
 	return &Response{
 		Action:         AgentActionShutdown,
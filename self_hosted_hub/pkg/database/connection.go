@@ -45,25 +45,6 @@ func dbPoolSize() int {
 }
 
 func connect() *gorm.DB {
-	postgresDbSSL := os.Getenv("POSTGRES_DB_SSL")
-	sslMode := "disable"
-	if postgresDbSSL == "true" {
-		sslMode = "require"
-	}
-
-	c := Config{
-		Host:            os.Getenv("DB_HOST"),
-		Port:            os.Getenv("DB_PORT"),
-		Name:            os.Getenv("DB_NAME"),
-		Pass:            os.Getenv("DB_PASSWORD"),
-		User:            os.Getenv("DB_USERNAME"),
-		Ssl:             sslMode,
-		ApplicationName: os.Getenv("APPLICATION_NAME"),
-	}
-
-	dsnTemplate := "host=%s port=%s user=%s password=%s dbname=%s sslmode=%s application_name=%s"
-	dsn := fmt.Sprintf(dsnTemplate, c.Host, c.Port, c.User, c.Pass, c.Name, c.Ssl, c.ApplicationName)
-
 	logger := gormLogger.New(log.New(os.Stdout, "\r\n", log.LstdFlags), gormLogger.Config{
 		SlowThreshold:             200 * time.Millisecond,
 		LogLevel:                  gormLogger.Warn,
@@ -71,7 +52,7 @@ func connect() *gorm.DB {
 		IgnoreRecordNotFoundError: true,
 	})
 
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger})
+	db, err := gorm.Open(postgres.Open(dsn()), &gorm.Config{Logger: logger})
 	if err != nil {
 		panic(err)
 	}
@@ -88,9 +69,30 @@ func connect() *gorm.DB {
 	return db
 }
 
+func dsn() string {
+	postgresDbSSL := os.Getenv("POSTGRES_DB_SSL")
+	sslMode := "disable"
+	if postgresDbSSL == "true" {
+		sslMode = "require"
+	}
+
+	c := Config{
+		Host:            os.Getenv("DB_HOST"),
+		Port:            os.Getenv("DB_PORT"),
+		Name:            os.Getenv("DB_NAME"),
+		Pass:            os.Getenv("DB_PASSWORD"),
+		User:            os.Getenv("DB_USERNAME"),
+		Ssl:             sslMode,
+		ApplicationName: os.Getenv("APPLICATION_NAME"),
+	}
+
+	dsnTemplate := "host=%s port=%s user=%s password=%s dbname=%s sslmode=%s application_name=%s"
+	return fmt.Sprintf(dsnTemplate, c.Host, c.Port, c.User, c.Pass, c.Name, c.Ssl, c.ApplicationName)
+}
+
 func TruncateTables() {
 	err := Conn().Exec(`
-	  truncate table occupation_requests, agents, agent_types;
+	  truncate table autoscaling_hint_states, agent_events, occupation_requests, agents, agent_types;
 	`).Error
 
 	if err != nil {
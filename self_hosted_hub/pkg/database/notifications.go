@@ -0,0 +1,57 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	pgx "github.com/jackc/pgx/v5"
+	log "github.com/sirupsen/logrus"
+)
+
+// Notify sends a Postgres notification to everyone listening on the channel.
+func Notify(channel, payload string) error {
+	return Conn().Exec("SELECT pg_notify(?, ?)", channel, payload).Error
+}
+
+// Listen calls handler for every notification sent to the channel, until the context is done.
+// Listening needs a connection of its own, so it doesn't use the connection pool.
+// Notifications sent while reconnecting are lost, so listeners can't rely only on them.
+func Listen(ctx context.Context, channel string, handler func(payload string)) {
+	for {
+		err := listen(ctx, channel, handler)
+		if ctx.Err() != nil {
+			return
+		}
+
+		log.Errorf("Error listening on %s, reconnecting: %v", channel, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
+func listen(ctx context.Context, channel string, handler func(payload string)) error {
+	conn, err := pgx.Connect(ctx, dsn())
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize())
+	if err != nil {
+		return err
+	}
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+
+		handler(notification.Payload)
+	}
+}
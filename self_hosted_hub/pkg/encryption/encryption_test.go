@@ -0,0 +1,36 @@
+package encryption
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__EncryptAndDecrypt(t *testing.T) {
+	t.Setenv("ENCRYPTION_KEY", "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=")
+
+	encrypted, err := Encrypt("very-secret")
+	require.NoError(t, err)
+	assert.NotContains(t, encrypted, "very-secret")
+
+	other, err := Encrypt("very-secret")
+	require.NoError(t, err)
+	assert.NotEqual(t, encrypted, other)
+
+	decrypted, err := Decrypt(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "very-secret", decrypted)
+
+	t.Run("tampered values are refused", func(t *testing.T) {
+		_, err := Decrypt(other[:len(other)-4] + "AAAA")
+		assert.ErrorContains(t, err, "error decrypting value")
+	})
+
+	t.Run("no key", func(t *testing.T) {
+		t.Setenv("ENCRYPTION_KEY", "")
+
+		_, err := Encrypt("very-secret")
+		assert.ErrorIs(t, err, ErrNoKey)
+	})
+}
@@ -228,7 +228,12 @@ func (s *SelfHostedService) Create(ctx context.Context, request *pb.CreateReques
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	agentType, token, err := models.CreateAgentTypeWithSettings(orgID, &requesterID, agentTypeName, *nameSettings)
+	autoscalingSettings, err := models.NewAutoscalingSettings(request.AutoscalingSettings, nil)
+	if err != nil {
+		return nil, autoscalingSettingsError(err)
+	}
+
+	agentType, token, err := models.CreateAgentTypeWithSettings(orgID, &requesterID, agentTypeName, *nameSettings, *autoscalingSettings)
 	if err != nil {
 		log.Errorf("Error on Create for %v: %v", request, err)
 		if err.Error() == "agent type name must by unique in the organization" {
@@ -282,7 +287,13 @@ func (s *SelfHostedService) Update(ctx context.Context, request *pb.UpdateReques
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
+	newAutoscalingSettings, err := models.NewAutoscalingSettings(request.AgentType.AutoscalingSettings, &agentType.AutoscalingSettings)
+	if err != nil {
+		return nil, autoscalingSettingsError(err)
+	}
+
 	agentType.AgentNameSettings = *newNameSettings
+	agentType.AutoscalingSettings = *newAutoscalingSettings
 	agentType.RequesterID = &requesterID
 
 	err = agentType.Update()
@@ -320,7 +331,12 @@ func (s *SelfHostedService) OccupyAgent(ctx context.Context, request *pb.OccupyA
 		return nil, err
 	}
 
-	err = models.CreateOccupationRequest(orgID, request.AgentType, jobID)
+	labels := models.AgentLabels(request.AgentLabels)
+	if err := labels.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = models.CreateOccupationRequestWithLabels(orgID, request.AgentType, jobID, labels)
 	if err != nil {
 		log.Errorf("Error on OccupyAgent for %v: %v", request, err)
 		return nil, err
@@ -469,6 +485,76 @@ func (s *SelfHostedService) StopJob(ctx context.Context, request *pb.StopJobRequ
 	return &pb.StopJobResponse{}, nil
 }
 
+func (s *SelfHostedService) ListAgentEvents(ctx context.Context, request *pb.ListAgentEventsRequest) (*pb.ListAgentEventsResponse, error) {
+	defer watchman.BenchmarkWithTags(time.Now(), "internalapi.ListAgentEvents", []string{})
+
+	log.Infof("ListAgentEvents: %v", request)
+
+	orgID, err := uuid.Parse(request.OrganizationId)
+	if err != nil {
+		log.Errorf("Error reading organization id on %v for ListAgentEvents: %v", request, err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pageSize := request.PageSize
+	if pageSize == 0 {
+		pageSize = maxAgentPageSize
+	}
+
+	if pageSize < minAgentPageSize || pageSize > maxAgentPageSize {
+		return nil, status.Error(
+			codes.InvalidArgument,
+			fmt.Sprintf(
+				"page size of %d is invalid: must be greater than 0 and between %d-%d",
+				request.PageSize,
+				minAgentPageSize,
+				maxAgentPageSize,
+			),
+		)
+	}
+
+	filter := models.AgentEventFilter{
+		AgentTypeName: request.AgentTypeName,
+		AgentName:     request.AgentName,
+	}
+
+	if request.JobId != "" {
+		jobID, err := uuid.Parse(request.JobId)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid job id: %v", err))
+		}
+
+		filter.JobID = &jobID
+	}
+
+	if request.From != nil {
+		from := request.From.AsTime()
+		filter.From = &from
+	}
+
+	if request.To != nil {
+		to := request.To.AsTime()
+		filter.To = &to
+	}
+
+	events, nextCursor, err := models.ListAgentEventsWithCursor(orgID, filter, pageSize, request.Cursor)
+	if err != nil {
+		log.Errorf("Error on ListAgentEvents for %v: %v", request, err)
+		return nil, status.Error(codes.Unknown, err.Error())
+	}
+
+	res := &pb.ListAgentEventsResponse{
+		Events: []*pb.AgentEvent{},
+		Cursor: nextCursor,
+	}
+
+	for i := range events {
+		res.Events = append(res.Events, serializeAgentEvent(&events[i]))
+	}
+
+	return res, nil
+}
+
 func (s *SelfHostedService) ResetToken(ctx context.Context, request *pb.ResetTokenRequest) (*pb.ResetTokenResponse, error) {
 	defer watchman.BenchmarkWithTags(time.Now(), "internalapi.ResetToken", []string{})
 
@@ -524,12 +610,23 @@ func (s *SelfHostedService) serializeAgentType(agentType *models.AgentTypeWithAg
 		UpdatedAt:       timestamppb.New(*agentType.AgentType.UpdatedAt),
 	}
 
-	if agentType.AgentType.NameAssignmentOrigin == models.NameAssignmentOriginFromAgent {
+	switch agentType.AgentType.NameAssignmentOrigin {
+	case models.NameAssignmentOriginFromAgent:
 		t.AgentNameSettings = &pb.AgentNameSettings{
 			AssignmentOrigin: pb.AgentNameSettings_ASSIGNMENT_ORIGIN_AGENT,
 			ReleaseAfter:     agentType.AgentType.ReleaseNameAfter,
 		}
-	} else {
+	case models.NameAssignmentOriginFromOIDC:
+		t.AgentNameSettings = &pb.AgentNameSettings{
+			AssignmentOrigin: pb.AgentNameSettings_ASSIGNMENT_ORIGIN_OIDC,
+			ReleaseAfter:     agentType.AgentType.ReleaseNameAfter,
+			Oidc: &pb.AgentNameSettings_OIDC{
+				Issuer:       agentType.AgentType.OIDCIssuer,
+				Audience:     agentType.AgentType.OIDCAudience,
+				NameTemplate: agentType.AgentType.OIDCNameTemplate,
+			},
+		}
+	default:
 		t.AgentNameSettings = &pb.AgentNameSettings{
 			AssignmentOrigin: pb.AgentNameSettings_ASSIGNMENT_ORIGIN_AWS_STS,
 			ReleaseAfter:     agentType.AgentType.ReleaseNameAfter,
@@ -540,6 +637,15 @@ func (s *SelfHostedService) serializeAgentType(agentType *models.AgentTypeWithAg
 		}
 	}
 
+	// the webhook secret is never returned
+	if agentType.AgentType.HasAutoscalingWebhook() {
+		t.AutoscalingSettings = &pb.AutoscalingSettings{
+			WebhookUrl:          agentType.AgentType.AutoscalingWebhookURL,
+			QueuedJobsThreshold: int32(agentType.AgentType.AutoscalingQueuedJobsThreshold),
+			IdleAgentsThreshold: int32(agentType.AgentType.AutoscalingIdleAgentsThreshold),
+		}
+	}
+
 	if agentType.AgentType.RequesterID != nil {
 		t.RequesterId = agentType.AgentType.RequesterID.String()
 	}
@@ -581,6 +687,7 @@ func (s *SelfHostedService) serializeAgent(agent *models.Agent) *pb.Agent {
 		State:          s.serializeAgentState(agent),
 		ConnectedAt:    timestamppb.New(*agent.CreatedAt),
 		TypeName:       agent.AgentTypeName,
+		Labels:         agent.Labels,
 	}
 
 	if agent.DisabledAt != nil {
@@ -598,3 +705,43 @@ func (s *SelfHostedService) serializeAgentState(agent *models.Agent) pb.Agent_St
 
 	return pb.Agent_RUNNING_JOB
 }
+
+var agentEventTypes = map[string]pb.AgentEvent_Type{
+	models.AgentEventRegistered:       pb.AgentEvent_TYPE_REGISTERED,
+	models.AgentEventJobAssigned:      pb.AgentEvent_TYPE_JOB_ASSIGNED,
+	models.AgentEventJobFinished:      pb.AgentEvent_TYPE_JOB_FINISHED,
+	models.AgentEventJobStopRequested: pb.AgentEvent_TYPE_JOB_STOP_REQUESTED,
+	models.AgentEventInterrupted:      pb.AgentEvent_TYPE_INTERRUPTED,
+	models.AgentEventDisabled:         pb.AgentEvent_TYPE_DISABLED,
+	models.AgentEventDisconnected:     pb.AgentEvent_TYPE_DISCONNECTED,
+}
+
+func serializeAgentEvent(event *models.AgentEvent) *pb.AgentEvent {
+	e := &pb.AgentEvent{
+		Type:           agentEventTypes[event.Event],
+		OrganizationId: event.OrganizationID.String(),
+		AgentTypeName:  event.AgentTypeName,
+		AgentId:        event.AgentID.String(),
+		AgentName:      event.AgentName,
+		Hostname:       event.Hostname,
+		IpAddress:      event.IPAddress,
+		JobResult:      event.JobResult,
+		CreatedAt:      timestamppb.New(*event.CreatedAt),
+	}
+
+	if event.JobID != nil {
+		e.JobId = event.JobID.String()
+	}
+
+	return e
+}
+
+// Invalid settings are the caller's fault, but failing to encrypt the secret is not.
+func autoscalingSettingsError(err error) error {
+	if errors.Is(err, models.ErrEncryptingWebhookSecret) {
+		log.Errorf("Error on autoscaling settings: %v", err)
+		return status.Error(codes.Internal, "error saving autoscaling settings")
+	}
+
+	return status.Error(codes.InvalidArgument, err.Error())
+}
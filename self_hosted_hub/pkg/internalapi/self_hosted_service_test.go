@@ -8,6 +8,7 @@ import (
 	"time"
 
 	database "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/database"
+	"github.com/semaphoreio/semaphore/self_hosted_hub/pkg/encryption"
 	"github.com/semaphoreio/semaphore/self_hosted_hub/pkg/feature"
 	models "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/models"
 	pb "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/protos/self_hosted"
@@ -16,6 +17,9 @@ import (
 	"github.com/semaphoreio/semaphore/self_hosted_hub/test/grpcmock"
 	assert "github.com/stretchr/testify/assert"
 	require "github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func Test__Describe__WhenNoAgentTypeExists(t *testing.T) {
@@ -101,6 +105,7 @@ func Test__DescribeAgent__WhenAgentExists(t *testing.T) {
 		Hostname:  "boxbox",
 		IPAddress: "193.1.2.102",
 		UserAgent: "Semaphore Agent/v1.2.3",
+		Labels:    models.AgentLabels{"gpu": "false", "region": "eu"},
 	})
 
 	require.Nil(t, err)
@@ -119,6 +124,7 @@ func Test__DescribeAgent__WhenAgentExists(t *testing.T) {
 	require.Equal(t, "x86_64", response.Agent.Arch)
 	require.Equal(t, "boxbox", response.Agent.Hostname)
 	require.Equal(t, "193.1.2.102", response.Agent.IpAddress)
+	require.Equal(t, map[string]string{"gpu": "false", "region": "eu"}, response.Agent.Labels)
 	require.Equal(t, pb.Agent_WAITING_FOR_JOB, response.Agent.State)
 	require.WithinDuration(t, now, response.Agent.ConnectedAt.AsTime(), 200*time.Millisecond)
 	require.False(t, response.Agent.Disabled)
@@ -344,6 +350,60 @@ func Test__Update(t *testing.T) {
 		require.Equal(t, "role1,role2", agentType.AWSRoleNamePatterns)
 		require.WithinDuration(t, now, *agentType.UpdatedAt, 200*time.Millisecond)
 	})
+
+	t.Run("updates autoscaling settings", func(t *testing.T) {
+		name := "s1-test-3"
+		_, _, err := models.CreateAgentType(orgID, &requesterID, name)
+		require.NoError(t, err)
+
+		update := func(settings *pb.AutoscalingSettings) (*pb.UpdateResponse, error) {
+			return service.Update(context.Background(), &pb.UpdateRequest{
+				OrganizationId: orgID.String(),
+				RequesterId:    requesterID.String(),
+				Name:           name,
+				AgentType: &pb.AgentType{
+					OrganizationId:      orgID.String(),
+					Name:                name,
+					AutoscalingSettings: settings,
+				},
+			})
+		}
+
+		response, err := update(&pb.AutoscalingSettings{
+			WebhookUrl:          "https://example.com/hook",
+			WebhookSecret:       "very-secret",
+			QueuedJobsThreshold: 10,
+		})
+
+		require.NoError(t, err)
+		require.Equal(t, "https://example.com/hook", response.AgentType.AutoscalingSettings.WebhookUrl)
+		require.Equal(t, int32(10), response.AgentType.AutoscalingSettings.QueuedJobsThreshold)
+		require.Empty(t, response.AgentType.AutoscalingSettings.WebhookSecret)
+
+		// secret is kept when not sent again
+		_, err = update(&pb.AutoscalingSettings{
+			WebhookUrl:          "https://example.com/hook",
+			QueuedJobsThreshold: 10,
+			IdleAgentsThreshold: 2,
+		})
+
+		require.NoError(t, err)
+		agentType, err := models.FindAgentType(orgID, name)
+		require.NoError(t, err)
+		secret, err := encryption.Decrypt(agentType.AutoscalingWebhookSecret)
+		require.NoError(t, err)
+		require.Equal(t, "very-secret", secret)
+		require.Equal(t, 2, agentType.AutoscalingIdleAgentsThreshold)
+
+		// invalid settings are refused
+		_, err = update(&pb.AutoscalingSettings{WebhookUrl: "https://example.com/hook", WebhookSecret: "very-secret"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// no settings disable the webhook
+		response, err = update(nil)
+		require.NoError(t, err)
+		require.Nil(t, response.AgentType.AutoscalingSettings)
+	})
 }
 
 func Test__List(t *testing.T) {
@@ -522,6 +582,35 @@ func Test__OccupyAgent(t *testing.T) {
 		require.Equal(t, "s1-test-1", req.AgentTypeName)
 		require.Equal(t, jobID, req.JobID)
 	})
+
+	t.Run("creates occupation request with label constraints", func(t *testing.T) {
+		jobID := database.UUID()
+		request := &pb.OccupyAgentRequest{
+			OrganizationId: orgID.String(),
+			AgentType:      "s1-test-1",
+			JobId:          jobID.String(),
+			AgentLabels:    map[string]string{"gpu": "true"},
+		}
+
+		_, err := service.OccupyAgent(context.Background(), request)
+		require.Nil(t, err)
+
+		req, err := models.FindOccupationRequest(orgID, "s1-test-1", jobID)
+		require.NoError(t, err)
+		require.Equal(t, models.AgentLabels{"gpu": "true"}, req.Labels)
+	})
+
+	t.Run("invalid label constraints => error", func(t *testing.T) {
+		request := &pb.OccupyAgentRequest{
+			OrganizationId: orgID.String(),
+			AgentType:      "s1-test-1",
+			JobId:          database.UUID().String(),
+			AgentLabels:    map[string]string{"has space": "true"},
+		}
+
+		_, err := service.OccupyAgent(context.Background(), request)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
 }
 
 func Test__ReleaseAgent(t *testing.T) {
@@ -574,6 +663,104 @@ func Test__ReleaseAgent(t *testing.T) {
 	})
 }
 
+func Test__ListAgentEvents(t *testing.T) {
+	database.TruncateTables()
+	var featureHubProvider, _ = feature.NewFeatureHubProvider("0.0.0.0:50052")
+	var quotaClient, _ = quotas.NewQuotaClient(featureHubProvider)
+	service := NewSelfHostedService(quotaClient)
+
+	orgID := database.UUID()
+	requesterID := database.UUID()
+
+	_, _, err := models.CreateAgentType(orgID, &requesterID, "s1-test-1")
+	require.Nil(t, err)
+
+	agent, _, err := models.RegisterAgent(orgID, "s1-test-1", "hello1", models.AgentMetadata{Hostname: "boxbox"})
+	require.Nil(t, err)
+
+	jobID := database.UUID()
+	require.Nil(t, models.CreateOccupationRequest(orgID, "s1-test-1", jobID))
+	_, err = models.OccupyAgent(agent)
+	require.Nil(t, err)
+	require.Nil(t, models.RecordJobFinished(context.Background(), agent, jobID, "failed"))
+	require.Nil(t, agent.Disconnect())
+
+	t.Run("by agent", func(t *testing.T) {
+		response, err := service.ListAgentEvents(context.Background(), &pb.ListAgentEventsRequest{
+			OrganizationId: orgID.String(),
+			AgentName:      "hello1",
+		})
+
+		require.Nil(t, err)
+		require.Len(t, response.Events, 4)
+		require.Empty(t, response.Cursor)
+
+		assert.Equal(t, pb.AgentEvent_TYPE_REGISTERED, response.Events[0].Type)
+		assert.Equal(t, pb.AgentEvent_TYPE_JOB_ASSIGNED, response.Events[1].Type)
+		assert.Equal(t, pb.AgentEvent_TYPE_JOB_FINISHED, response.Events[2].Type)
+		assert.Equal(t, pb.AgentEvent_TYPE_DISCONNECTED, response.Events[3].Type)
+		assert.Equal(t, jobID.String(), response.Events[2].JobId)
+		assert.Equal(t, "failed", response.Events[2].JobResult)
+
+		for _, event := range response.Events {
+			assert.Equal(t, agent.ID.String(), event.AgentId)
+			assert.Equal(t, "s1-test-1", event.AgentTypeName)
+			assert.Equal(t, "boxbox", event.Hostname)
+			assert.NotNil(t, event.CreatedAt)
+		}
+	})
+
+	t.Run("by job", func(t *testing.T) {
+		response, err := service.ListAgentEvents(context.Background(), &pb.ListAgentEventsRequest{
+			OrganizationId: orgID.String(),
+			JobId:          jobID.String(),
+		})
+
+		require.Nil(t, err)
+		require.Len(t, response.Events, 3)
+		assert.Equal(t, "hello1", response.Events[0].AgentName)
+	})
+
+	t.Run("by time range", func(t *testing.T) {
+		response, err := service.ListAgentEvents(context.Background(), &pb.ListAgentEventsRequest{
+			OrganizationId: orgID.String(),
+			From:           timestamppb.New(time.Now().Add(time.Hour)),
+		})
+
+		require.Nil(t, err)
+		assert.Empty(t, response.Events)
+	})
+
+	t.Run("paginated", func(t *testing.T) {
+		response, err := service.ListAgentEvents(context.Background(), &pb.ListAgentEventsRequest{
+			OrganizationId: orgID.String(),
+			PageSize:       5,
+		})
+
+		require.Nil(t, err)
+		require.Len(t, response.Events, 4)
+		assert.Empty(t, response.Cursor)
+	})
+
+	t.Run("invalid job ID", func(t *testing.T) {
+		_, err := service.ListAgentEvents(context.Background(), &pb.ListAgentEventsRequest{
+			OrganizationId: orgID.String(),
+			JobId:          "not-a-job",
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("invalid page size", func(t *testing.T) {
+		_, err := service.ListAgentEvents(context.Background(), &pb.ListAgentEventsRequest{
+			OrganizationId: orgID.String(),
+			PageSize:       1000,
+		})
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
 func Test__DeleteAgentType(t *testing.T) {
 	database.TruncateTables()
 	var featureHubProvider, _ = feature.NewFeatureHubProvider("0.0.0.0:50052")
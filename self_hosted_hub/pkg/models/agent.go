@@ -33,6 +33,8 @@ type AgentMetadata struct {
 	SingleJob               bool
 	IdleTimeout             int
 	InterruptionGracePeriod int
+	Labels                  AgentLabels
+	LongPoll                bool
 }
 
 type Agent struct {
@@ -97,7 +99,12 @@ func RegisterAgentInTransaction(tx *gorm.DB, orgID uuid.UUID, agentTypeName, nam
 	// because if the insert fails, we still need to registerAgentRetry().
 	// If we don't do this, we get a `current transaction is aborted, commands ignored until end of transaction block` error.
 	err = tx.Transaction(func(tx2 *gorm.DB) error {
-		return tx2.Create(&a).Error
+		err := tx2.Create(&a).Error
+		if err != nil {
+			return err
+		}
+
+		return recordAgentEvent(tx2, &a, AgentEventRegistered, nil, "")
 	})
 
 	if err != nil {
@@ -439,6 +446,14 @@ func SyncAgentWithContext(ctx context.Context, orgID, tokenHash, state, jobID st
 		return nil, gorm.ErrRecordNotFound
 	}
 
+	// interrupted agents keep sending the interruption time until they shut down
+	if interruptedAt > 0 {
+		err := recordAgentEventOnce(database.Conn().WithContext(ctx), &agent, AgentEventInterrupted, nil, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &agent, nil
 }
 
@@ -467,6 +482,9 @@ func OccupyAgentInTransaction(tx *gorm.DB, agent *Agent, requestJobID *uuid.UUID
 		query = query.Where("organization_id = ?", agent.OrganizationID)
 		query = query.Where("agent_type_name = ?", agent.AgentTypeName)
 
+		// only requests whose label constraints are all present in the agent's labels
+		query = query.Where("?::jsonb @> labels", agent.Labels)
+
 		if requestJobID != nil {
 			query = query.Where("job_id = ?", requestJobID)
 		} else {
@@ -488,7 +506,20 @@ func OccupyAgentInTransaction(tx *gorm.DB, agent *Agent, requestJobID *uuid.UUID
 			JobStopRequestedAt: nil,
 		}
 
-		err = db.Model(agent).Updates(fieldsToUpdate).Error
+		// agents disabled or interrupted since they were loaded can't take the job anymore
+		result := db.Model(agent).
+			Where("disabled_at IS NULL AND interrupted_at IS NULL").
+			Updates(fieldsToUpdate)
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return gorm.ErrRecordNotFound
+		}
+
+		err = recordAgentEvent(db, agent, AgentEventJobAssigned, &jobID, "")
 		if err != nil {
 			return err
 		}
@@ -556,9 +587,15 @@ func DisableAgent(orgID uuid.UUID, agentTypeName, agentName string) (*Agent, err
 			return err
 		}
 
+		alreadyDisabled := agent.DisabledAt != nil
 		now := time.Now()
 		agent.DisabledAt = &now
-		return db.Save(agent).Error
+		err = db.Save(agent).Error
+		if err != nil || alreadyDisabled {
+			return err
+		}
+
+		return recordAgentEvent(db, agent, AgentEventDisabled, agent.AssignedJobID, "")
 	})
 
 	if err != nil {
@@ -598,7 +635,12 @@ func StopJob(orgID uuid.UUID, jobID uuid.UUID) error {
 		now := time.Now()
 		agent.JobStopRequestedAt = &now
 
-		return db.Save(agent).Error
+		err = db.Save(agent).Error
+		if err != nil {
+			return err
+		}
+
+		return recordAgentEvent(db, &agent, AgentEventJobStopRequested, &jobID, "")
 	})
 
 	if err != nil {
@@ -622,15 +664,22 @@ func (a *Agent) DisconnectWithContext(ctx context.Context) error {
 		return err
 	}
 
-	if agentType.ReleaseNameAfter == 0 {
-		return database.Conn().Delete(a).Error
-	}
+	return database.Conn().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := recordAgentEvent(tx, a, AgentEventDisconnected, a.AssignedJobID, "")
+		if err != nil {
+			return err
+		}
 
-	now := time.Now()
-	return database.Conn().WithContext(ctx).
-		Model(&a).
-		Updates(map[string]interface{}{
-			"state":           AgentStateDisconnected,
-			"disconnected_at": now,
-		}).Error
+		if agentType.ReleaseNameAfter == 0 {
+			return tx.Delete(a).Error
+		}
+
+		now := time.Now()
+		return tx.
+			Model(&a).
+			Updates(map[string]interface{}{
+				"state":           AgentStateDisconnected,
+				"disconnected_at": now,
+			}).Error
+	})
 }
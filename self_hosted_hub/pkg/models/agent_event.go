@@ -0,0 +1,190 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	uuid "github.com/google/uuid"
+	database "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/database"
+	"gorm.io/gorm"
+)
+
+const (
+	AgentEventRegistered       = "registered"
+	AgentEventJobAssigned      = "job_assigned"
+	AgentEventJobFinished      = "job_finished"
+	AgentEventJobStopRequested = "job_stop_requested"
+	AgentEventInterrupted      = "interrupted"
+	AgentEventDisabled         = "disabled"
+	AgentEventDisconnected     = "disconnected"
+)
+
+// AgentEvent is an entry in the append-only history of an agent.
+// Agents are deleted some time after they disconnect, so events
+// copy everything needed to identify the agent and its host.
+type AgentEvent struct {
+	ID uuid.UUID `gorm:"primary_key;default:uuid_generate_v4()"`
+
+	OrganizationID uuid.UUID
+	AgentTypeName  string
+	AgentID        uuid.UUID
+	AgentName      string
+	Hostname       string
+	IPAddress      string
+
+	Event     string
+	JobID     *uuid.UUID
+	JobResult string
+
+	CreatedAt *time.Time
+}
+
+type AgentEventFilter struct {
+	AgentTypeName string
+	AgentName     string
+	JobID         *uuid.UUID
+	From          *time.Time
+	To            *time.Time
+}
+
+func recordAgentEvent(tx *gorm.DB, agent *Agent, event string, jobID *uuid.UUID, jobResult string) error {
+	return tx.Create(&AgentEvent{
+		OrganizationID: agent.OrganizationID,
+		AgentTypeName:  agent.AgentTypeName,
+		AgentID:        agent.ID,
+		AgentName:      agent.Name,
+		Hostname:       agent.Hostname,
+		IPAddress:      agent.IPAddress,
+		Event:          event,
+		JobID:          jobID,
+		JobResult:      jobResult,
+	}).Error
+}
+
+// recordAgentEventOnce is used for events reported on every sync request
+// until the agent moves on, so they are only recorded the first time.
+func recordAgentEventOnce(tx *gorm.DB, agent *Agent, event string, jobID *uuid.UUID, jobResult string) error {
+	return tx.Exec(`
+		INSERT INTO agent_events (organization_id, agent_type_name, agent_id, agent_name, hostname, ip_address, event, job_id, job_result, created_at)
+		SELECT ?::uuid, ?, ?::uuid, ?, ?, ?, ?, ?::uuid, ?, ?::timestamp
+		WHERE NOT EXISTS (
+			SELECT 1 FROM agent_events WHERE agent_id = ? AND event = ? AND job_id IS NOT DISTINCT FROM ?::uuid
+		)`,
+		agent.OrganizationID, agent.AgentTypeName, agent.ID, agent.Name, agent.Hostname, agent.IPAddress, event, jobID, jobResult, time.Now(),
+		agent.ID, event, jobID,
+	).Error
+}
+
+// recordAgentEventsWhere records the same event for all the agents matching the condition,
+// using the job currently assigned to each one of them.
+func recordAgentEventsWhere(tx *gorm.DB, event, jobResult string, condition string, args ...interface{}) error {
+	query := fmt.Sprintf(`
+		INSERT INTO agent_events (organization_id, agent_type_name, agent_id, agent_name, hostname, ip_address, event, job_id, job_result, created_at)
+		SELECT organization_id, agent_type_name, id, name, hostname, ip_address, ?::text, assigned_job_id, ?::text, ?::timestamp
+		FROM agents
+		WHERE %s`, condition,
+	)
+
+	return tx.Exec(query, append([]interface{}{event, jobResult, time.Now()}, args...)...).Error
+}
+
+func RecordJobFinished(ctx context.Context, agent *Agent, jobID uuid.UUID, jobResult string) error {
+	return recordAgentEventOnce(database.Conn().WithContext(ctx), agent, AgentEventJobFinished, &jobID, jobResult)
+}
+
+// RecordAgentsCleanedUp records the agents that stopped syncing as disconnected,
+// and the jobs they were running as failed.
+func RecordAgentsCleanedUp(tx *gorm.DB, ids []string) error {
+	err := recordAgentEventsWhere(tx, AgentEventJobFinished, "failed", "id IN (?) AND assigned_job_id IS NOT NULL", ids)
+	if err != nil {
+		return err
+	}
+
+	return recordAgentEventsWhere(tx, AgentEventDisconnected, "", "id IN (?)", ids)
+}
+
+func ListAgentEventsWithCursor(orgID uuid.UUID, filter AgentEventFilter, count int32, cursor string) ([]AgentEvent, string, error) {
+	events := []AgentEvent{}
+
+	query := database.Conn()
+	query = query.Where("organization_id = ?", orgID)
+
+	if filter.AgentTypeName != "" {
+		query = query.Where("agent_type_name = ?", filter.AgentTypeName)
+	}
+
+	if filter.AgentName != "" {
+		query = query.Where("agent_name = ?", filter.AgentName)
+	}
+
+	if filter.JobID != nil {
+		query = query.Where("job_id = ?", filter.JobID)
+	}
+
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", filter.From)
+	}
+
+	if filter.To != nil {
+		query = query.Where("created_at < ?", filter.To)
+	}
+
+	// Events recorded for many agents at once share the same timestamp,
+	// so the cursor also needs the ID to tell them apart.
+	if cursor != "" {
+		createdAt, id, err := parseAgentEventCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+
+		query = query.Where("(created_at, id) >= (?, ?)", createdAt, id)
+	}
+
+	query = query.Order("created_at ASC, id ASC")
+	query = query.Limit(int(count + 1))
+	err := query.Find(&events).Error
+	if err != nil {
+		return nil, "", err
+	}
+
+	if len(events) <= int(count) {
+		return events, "", nil
+	}
+
+	last := events[len(events)-1]
+	nextCursor := fmt.Sprintf("%d:%s", last.CreatedAt.UnixMicro(), last.ID)
+	return events[0 : len(events)-1], nextCursor, nil
+}
+
+func parseAgentEventCursor(cursor string) (time.Time, uuid.UUID, error) {
+	createdAt, id, found := strings.Cut(cursor, ":")
+	if !found {
+		return time.Time{}, uuid.Nil, fmt.Errorf("invalid cursor '%s'", cursor)
+	}
+
+	micros, err := strconv.ParseInt(createdAt, 10, 64)
+	if err != nil {
+		return time.Time{}, uuid.Nil, fmt.Errorf("invalid cursor '%s'", cursor)
+	}
+
+	parsedID, err := uuid.Parse(id)
+	if err != nil {
+		return time.Time{}, uuid.Nil, fmt.Errorf("invalid cursor '%s'", cursor)
+	}
+
+	return time.UnixMicro(micros).UTC(), parsedID, nil
+}
+
+// DeleteAgentEventsOlderThan deletes up to limit events recorded before the given time,
+// returning how many were deleted.
+func DeleteAgentEventsOlderThan(tx *gorm.DB, before time.Time, limit int) (int64, error) {
+	result := tx.Exec(`
+		DELETE FROM agent_events
+		WHERE id IN (SELECT id FROM agent_events WHERE created_at < ? LIMIT ?)
+	`, before, limit)
+
+	return result.RowsAffected, result.Error
+}
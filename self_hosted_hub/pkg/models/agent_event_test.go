@@ -0,0 +1,177 @@
+package models
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	uuid "github.com/google/uuid"
+	database "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/database"
+	securetoken "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/securetoken"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__AgentEvents(t *testing.T) {
+	database.TruncateTables()
+
+	orgID := database.UUID()
+	requesterID := database.UUID()
+	_, _, err := CreateAgentType(orgID, &requesterID, "s1-test")
+	require.NoError(t, err)
+
+	agent, token, err := RegisterAgent(orgID, "s1-test", "agent-1", AgentMetadata{Hostname: "box-1", IPAddress: "10.0.0.1"})
+	require.NoError(t, err)
+
+	jobID := database.UUID()
+	require.NoError(t, CreateOccupationRequest(orgID, "s1-test", jobID))
+
+	_, err = OccupyAgent(agent)
+	require.NoError(t, err)
+
+	require.NoError(t, StopJob(orgID, jobID))
+
+	// finished jobs are reported until the agent is told to do something else
+	require.NoError(t, RecordJobFinished(context.Background(), agent, jobID, "stopped"))
+	require.NoError(t, RecordJobFinished(context.Background(), agent, jobID, "stopped"))
+
+	// interruptions are reported on every sync
+	interruptedAt := time.Now().Unix()
+	_, err = SyncAgent(orgID.String(), securetoken.Hash(token), "finished-job", jobID.String(), interruptedAt)
+	require.NoError(t, err)
+	_, err = SyncAgent(orgID.String(), securetoken.Hash(token), "finished-job", jobID.String(), interruptedAt)
+	require.NoError(t, err)
+
+	_, err = DisableAgent(orgID, "s1-test", "agent-1")
+	require.NoError(t, err)
+	_, err = DisableAgent(orgID, "s1-test", "agent-1")
+	require.NoError(t, err)
+
+	require.NoError(t, agent.Disconnect())
+
+	// events are kept after the agent is gone
+	_, err = FindAgentByName(orgID.String(), "agent-1")
+	require.Error(t, err)
+
+	events, cursor, err := ListAgentEventsWithCursor(orgID, AgentEventFilter{AgentName: "agent-1"}, 100, "")
+	require.NoError(t, err)
+	assert.Empty(t, cursor)
+	assert.Equal(t, []string{
+		AgentEventRegistered,
+		AgentEventJobAssigned,
+		AgentEventJobStopRequested,
+		AgentEventJobFinished,
+		AgentEventInterrupted,
+		AgentEventDisabled,
+		AgentEventDisconnected,
+	}, eventNames(events))
+
+	for _, event := range events {
+		assert.Equal(t, agent.ID, event.AgentID)
+		assert.Equal(t, "box-1", event.Hostname)
+		assert.Equal(t, "10.0.0.1", event.IPAddress)
+	}
+
+	assert.Equal(t, "stopped", events[3].JobResult)
+
+	t.Run("by job", func(t *testing.T) {
+		events, _, err := ListAgentEventsWithCursor(orgID, AgentEventFilter{JobID: &jobID}, 100, "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{AgentEventJobAssigned, AgentEventJobStopRequested, AgentEventJobFinished}, eventNames(events))
+	})
+
+	t.Run("by time range", func(t *testing.T) {
+		from := *events[1].CreatedAt
+		to := *events[3].CreatedAt
+		inRange, _, err := ListAgentEventsWithCursor(orgID, AgentEventFilter{From: &from, To: &to}, 100, "")
+		require.NoError(t, err)
+		assert.Equal(t, []string{AgentEventJobAssigned, AgentEventJobStopRequested}, eventNames(inRange))
+	})
+
+	t.Run("other organizations are not visible", func(t *testing.T) {
+		events, _, err := ListAgentEventsWithCursor(database.UUID(), AgentEventFilter{}, 100, "")
+		require.NoError(t, err)
+		assert.Empty(t, events)
+	})
+}
+
+func Test__AgentEventsForManyAgents(t *testing.T) {
+	database.TruncateTables()
+
+	orgID := database.UUID()
+	requesterID := database.UUID()
+	_, _, err := CreateAgentType(orgID, &requesterID, "s1-test")
+	require.NoError(t, err)
+
+	ids := []string{}
+	for _, name := range []string{"agent-1", "agent-2", "agent-3", "agent-4", "agent-5", "agent-6", "agent-7"} {
+		agent, _, err := RegisterAgent(orgID, "s1-test", name, AgentMetadata{})
+		require.NoError(t, err)
+		ids = append(ids, agent.ID.String())
+	}
+
+	require.NoError(t, DisableAllAgents(orgID, "s1-test"))
+	require.NoError(t, DisableAllAgents(orgID, "s1-test"))
+	require.NoError(t, RecordAgentsCleanedUp(database.Conn(), ids[:2]))
+
+	// all the events recorded at once share the same timestamp,
+	// and pagination still goes through all of them exactly once.
+	seen := map[uuid.UUID]bool{}
+	names := []string{}
+	cursor := ""
+	for {
+		events, nextCursor, err := ListAgentEventsWithCursor(orgID, AgentEventFilter{}, 3, cursor)
+		require.NoError(t, err)
+
+		for _, event := range events {
+			assert.False(t, seen[event.ID])
+			seen[event.ID] = true
+			names = append(names, event.Event)
+		}
+
+		if nextCursor == "" {
+			break
+		}
+
+		cursor = nextCursor
+	}
+
+	assert.Len(t, names, 7+7+2)
+	assert.Equal(t, 7, countEvents(names, AgentEventDisabled))
+	assert.Equal(t, 2, countEvents(names, AgentEventDisconnected))
+
+	t.Run("invalid cursor", func(t *testing.T) {
+		_, _, err := ListAgentEventsWithCursor(orgID, AgentEventFilter{}, 3, "not-a-cursor")
+		assert.ErrorContains(t, err, "invalid cursor")
+	})
+
+	t.Run("old events are deleted", func(t *testing.T) {
+		deleted, err := DeleteAgentEventsOlderThan(database.Conn(), time.Now().Add(-time.Hour), 100)
+		require.NoError(t, err)
+		assert.Zero(t, deleted)
+
+		deleted, err = DeleteAgentEventsOlderThan(database.Conn(), time.Now().Add(time.Hour), 10)
+		require.NoError(t, err)
+		assert.Equal(t, int64(10), deleted)
+	})
+}
+
+func eventNames(events []AgentEvent) []string {
+	names := []string{}
+	for _, event := range events {
+		names = append(names, event.Event)
+	}
+
+	return names
+}
+
+func countEvents(names []string, event string) int {
+	count := 0
+	for _, name := range names {
+		if name == event {
+			count++
+		}
+	}
+
+	return count
+}
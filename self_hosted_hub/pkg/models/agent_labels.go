@@ -0,0 +1,77 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+const MaxAgentLabels = 32
+const MaxAgentLabelValueLength = 128
+
+var ErrInvalidAgentLabels = errors.New("invalid agent labels")
+var agentLabelRegex = regexp.MustCompile("^[a-zA-Z0-9][a-zA-Z0-9._/-]*$")
+var agentLabelMaxCharacters = 63
+
+// AgentLabels are free-form key/values describing what an agent offers, e.g. gpu=false,docker=true,region=eu.
+// Occupation requests use the same type to describe which labels an agent must have to run the job.
+type AgentLabels map[string]string
+
+func (l AgentLabels) Value() (driver.Value, error) {
+	if l == nil {
+		return "{}", nil
+	}
+
+	b, err := json.Marshal(l)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
+
+func (l *AgentLabels) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, l)
+	case string:
+		return json.Unmarshal([]byte(v), l)
+	default:
+		return errors.New("type assertion to []byte failed")
+	}
+}
+
+func (l AgentLabels) Validate() error {
+	if len(l) > MaxAgentLabels {
+		return fmt.Errorf("%w: an agent can't have more than %d labels", ErrInvalidAgentLabels, MaxAgentLabels)
+	}
+
+	for key, value := range l {
+		if len(key) > agentLabelMaxCharacters {
+			return fmt.Errorf("%w: the label '%s' must be below %d characters", ErrInvalidAgentLabels, key, agentLabelMaxCharacters)
+		}
+
+		if !agentLabelRegex.MatchString(key) {
+			return fmt.Errorf("%w: the label '%s' must follow the pattern %s", ErrInvalidAgentLabels, key, agentLabelRegex.String())
+		}
+
+		if len(value) > MaxAgentLabelValueLength {
+			return fmt.Errorf("%w: the value of label '%s' must be below %d characters", ErrInvalidAgentLabels, key, MaxAgentLabelValueLength)
+		}
+	}
+
+	return nil
+}
+
+// Satisfies returns true if the labels include all the given constraints.
+func (l AgentLabels) Satisfies(constraints AgentLabels) bool {
+	for key, value := range constraints {
+		if v, ok := l[key]; !ok || v != value {
+			return false
+		}
+	}
+
+	return true
+}
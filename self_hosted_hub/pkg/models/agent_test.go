@@ -2,6 +2,7 @@ package models
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -180,6 +181,52 @@ func Test__OccupyAgent(t *testing.T) {
 		require.Error(t, err)
 		require.Nil(t, req)
 	})
+
+	t.Run("only agents having all the requested labels are occupied", func(t *testing.T) {
+		cpuAgent, _, err := RegisterAgent(orgID, "s1-test-1", "cpu1", AgentMetadata{Labels: AgentLabels{"gpu": "false", "region": "eu"}})
+		require.NoError(t, err)
+		gpuAgent, _, err := RegisterAgent(orgID, "s1-test-1", "gpu1", AgentMetadata{Labels: AgentLabels{"gpu": "true", "region": "eu"}})
+		require.NoError(t, err)
+
+		jobID := database.UUID()
+		err = CreateOccupationRequestWithLabels(orgID, "s1-test-1", jobID, AgentLabels{"gpu": "true"})
+		require.NoError(t, err)
+
+		_, err = OccupyAgent(cpuAgent)
+		require.Error(t, err)
+
+		assignedJobID, err := OccupyAgent(gpuAgent)
+		require.NoError(t, err)
+		require.Equal(t, jobID.String(), assignedJobID)
+	})
+
+	t.Run("requests without constraints are taken by any agent", func(t *testing.T) {
+		labeled, _, err := RegisterAgent(orgID, "s1-test-1", "labeled1", AgentMetadata{Labels: AgentLabels{"docker": "true"}})
+		require.NoError(t, err)
+
+		jobID := database.UUID()
+		require.NoError(t, CreateOccupationRequest(orgID, "s1-test-1", jobID))
+
+		assignedJobID, err := OccupyAgent(labeled)
+		require.NoError(t, err)
+		require.Equal(t, jobID.String(), assignedJobID)
+	})
+}
+
+func Test__AgentLabels(t *testing.T) {
+	require.NoError(t, AgentLabels{}.Validate())
+	require.NoError(t, AgentLabels{"gpu": "false", "docker": "true", "region": "eu"}.Validate())
+	require.ErrorIs(t, AgentLabels{"has space": "true"}.Validate(), ErrInvalidAgentLabels)
+	require.ErrorIs(t, AgentLabels{"-dash": "true"}.Validate(), ErrInvalidAgentLabels)
+	require.ErrorIs(t, AgentLabels{strings.Repeat("a", 64): "true"}.Validate(), ErrInvalidAgentLabels)
+	require.ErrorIs(t, AgentLabels{"region": strings.Repeat("a", MaxAgentLabelValueLength+1)}.Validate(), ErrInvalidAgentLabels)
+	require.ErrorContains(t, AgentLabels{"has space": "true"}.Validate(), "the label 'has space' must follow the pattern")
+
+	labels := AgentLabels{"gpu": "false", "region": "eu"}
+	require.True(t, labels.Satisfies(nil))
+	require.True(t, labels.Satisfies(AgentLabels{"region": "eu"}))
+	require.False(t, labels.Satisfies(AgentLabels{"region": "us"}))
+	require.False(t, labels.Satisfies(AgentLabels{"docker": "true"}))
 }
 
 func Test__ReleaseAgent(t *testing.T) {
@@ -242,7 +289,7 @@ func Test__Disconnect(t *testing.T) {
 
 	t.Run("changes record state", func(t *testing.T) {
 		_, _, err := CreateAgentTypeWithSettings(
-			orgID, &requesterID, "s1-test-2", AgentNameSettings{ReleaseNameAfter: 1},
+			orgID, &requesterID, "s1-test-2", AgentNameSettings{ReleaseNameAfter: 1}, AutoscalingSettings{},
 		)
 
 		require.NoError(t, err)
@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
 
 	uuid "github.com/google/uuid"
 	database "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/database"
+	oidc "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/oidc"
 	securetoken "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/securetoken"
 	"gorm.io/gorm"
 
@@ -19,6 +21,7 @@ import (
 var (
 	NameAssignmentOriginFromAgent        = pb.AgentNameSettings_ASSIGNMENT_ORIGIN_AGENT.String()
 	NameAssignmentOriginFromAWSSTS       = pb.AgentNameSettings_ASSIGNMENT_ORIGIN_AWS_STS.String()
+	NameAssignmentOriginFromOIDC         = pb.AgentNameSettings_ASSIGNMENT_ORIGIN_OIDC.String()
 	MinReleaseNameAfter            int64 = 60
 )
 
@@ -29,6 +32,7 @@ type AgentType struct {
 	TokenHash      string
 
 	AgentNameSettings
+	AutoscalingSettings
 
 	CreatedAt *time.Time
 	UpdatedAt *time.Time
@@ -39,6 +43,9 @@ type AgentNameSettings struct {
 	ReleaseNameAfter     int64
 	AWSAccount           string
 	AWSRoleNamePatterns  string
+	OIDCIssuer           string `gorm:"column:oidc_issuer"`
+	OIDCAudience         string `gorm:"column:oidc_audience"`
+	OIDCNameTemplate     string `gorm:"column:oidc_name_template"`
 }
 
 func NewAgentNameSettings(reqSettings *pb.AgentNameSettings) (*AgentNameSettings, error) {
@@ -88,6 +95,31 @@ func NewAgentNameSettings(reqSettings *pb.AgentNameSettings) (*AgentNameSettings
 			AWSAccount:           reqSettings.Aws.AccountId,
 			AWSRoleNamePatterns:  reqSettings.Aws.RoleNamePatterns,
 		}, nil
+	case pb.AgentNameSettings_ASSIGNMENT_ORIGIN_OIDC:
+		if reqSettings.Oidc == nil {
+			return nil, fmt.Errorf("OIDC information missing")
+		}
+
+		issuer, err := url.Parse(reqSettings.Oidc.Issuer)
+		if err != nil || issuer.Scheme != "https" || issuer.Host == "" {
+			return nil, fmt.Errorf("OIDC issuer must be an https URL")
+		}
+
+		if reqSettings.Oidc.Audience == "" {
+			return nil, fmt.Errorf("OIDC audience cannot be empty")
+		}
+
+		if err := oidc.ValidateNameTemplate(reqSettings.Oidc.NameTemplate); err != nil {
+			return nil, err
+		}
+
+		return &AgentNameSettings{
+			NameAssignmentOrigin: reqSettings.AssignmentOrigin.String(),
+			ReleaseNameAfter:     reqSettings.ReleaseAfter,
+			OIDCIssuer:           reqSettings.Oidc.Issuer,
+			OIDCAudience:         reqSettings.Oidc.Audience,
+			OIDCNameTemplate:     reqSettings.Oidc.NameTemplate,
+		}, nil
 	default:
 		return nil, fmt.Errorf("assignment origin not supported")
 	}
@@ -154,21 +186,22 @@ func FindAgentTypeByTokenWithContext(ctx context.Context, orgID string, tokenHas
 func CreateAgentType(orgID uuid.UUID, requesterID *uuid.UUID, name string) (*AgentType, string, error) {
 	return CreateAgentTypeWithSettings(orgID, requesterID, name, AgentNameSettings{
 		NameAssignmentOrigin: pb.AgentNameSettings_ASSIGNMENT_ORIGIN_AGENT.String(),
-	})
+	}, AutoscalingSettings{})
 }
 
-func CreateAgentTypeWithSettings(orgID uuid.UUID, requesterID *uuid.UUID, name string, agentNameSettings AgentNameSettings) (*AgentType, string, error) {
+func CreateAgentTypeWithSettings(orgID uuid.UUID, requesterID *uuid.UUID, name string, agentNameSettings AgentNameSettings, autoscalingSettings AutoscalingSettings) (*AgentType, string, error) {
 	token, err := securetoken.Create()
 	if err != nil {
 		return nil, "", err
 	}
 
 	at := &AgentType{
-		OrganizationID:    orgID,
-		RequesterID:       requesterID,
-		Name:              name,
-		TokenHash:         token.Hash,
-		AgentNameSettings: agentNameSettings,
+		OrganizationID:      orgID,
+		RequesterID:         requesterID,
+		Name:                name,
+		TokenHash:           token.Hash,
+		AgentNameSettings:   agentNameSettings,
+		AutoscalingSettings: autoscalingSettings,
 	}
 
 	err = database.Conn().Create(at).Error
@@ -304,21 +337,28 @@ func ListCursorAgentTypesWithAgentCount(orgID uuid.UUID, count int32, cursor str
 }
 
 func DisableAllAgents(orgID uuid.UUID, agentTypeName string) error {
-	now := time.Now()
-	return database.Conn().
-		Model(&Agent{}).
-		Where("organization_id = ? AND agent_type_name = ?", orgID, agentTypeName).
-		Updates(Agent{DisabledAt: &now}).
-		Error
+	return disableAgentsWhere("organization_id = ? AND agent_type_name = ?", orgID, agentTypeName)
 }
 
 func DisableOnlyIdleAgents(orgID uuid.UUID, agentTypeName string) error {
+	return disableAgentsWhere("organization_id = ? AND agent_type_name = ? AND assigned_job_id IS NULL", orgID, agentTypeName)
+}
+
+func disableAgentsWhere(condition string, args ...interface{}) error {
 	now := time.Now()
-	return database.Conn().
-		Model(&Agent{}).
-		Where("organization_id = ? AND agent_type_name = ? AND assigned_job_id IS NULL", orgID, agentTypeName).
-		Updates(Agent{DisabledAt: &now}).
-		Error
+	return database.Conn().Transaction(func(tx *gorm.DB) error {
+		// agents disabled before keep their original event
+		err := recordAgentEventsWhere(tx, AgentEventDisabled, "", condition+" AND disabled_at IS NULL", args...)
+		if err != nil {
+			return err
+		}
+
+		return tx.
+			Model(&Agent{}).
+			Where(condition, args...).
+			Updates(Agent{DisabledAt: &now}).
+			Error
+	})
 }
 
 var ErrCantDeleteAgentTypeWithExistingAgents = errors.New("can't delete agent type with existing agents")
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	database "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/database"
+	"github.com/semaphoreio/semaphore/self_hosted_hub/pkg/encryption"
 	"github.com/semaphoreio/semaphore/self_hosted_hub/pkg/protos/self_hosted"
 	securetoken "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/securetoken"
 	assert "github.com/stretchr/testify/assert"
@@ -80,7 +81,7 @@ func Test__DeleteAgentType(t *testing.T) {
 		agentTypeName := "s1-test-3"
 		agentType, _, err := CreateAgentTypeWithSettings(orgID, &requesterID, agentTypeName, AgentNameSettings{
 			ReleaseNameAfter: 60,
-		})
+		}, AutoscalingSettings{})
 
 		require.Nil(t, err)
 
@@ -100,7 +101,7 @@ func Test__DeleteAgentType(t *testing.T) {
 		agentTypeName := "s1-test-4"
 		agentType, _, err := CreateAgentTypeWithSettings(orgID, &requesterID, agentTypeName, AgentNameSettings{
 			ReleaseNameAfter: 60,
-		})
+		}, AutoscalingSettings{})
 
 		require.Nil(t, err)
 
@@ -241,6 +242,141 @@ func Test__NewAgentNameSettings(t *testing.T) {
 
 		assert.ErrorContains(t, err, "AWS role name patterns are not allowed for ASSIGNMENT_ORIGIN_AGENT")
 	})
+
+	t.Run("no oidc info", func(t *testing.T) {
+		_, err := NewAgentNameSettings(&self_hosted.AgentNameSettings{
+			AssignmentOrigin: self_hosted.AgentNameSettings_ASSIGNMENT_ORIGIN_OIDC,
+		})
+
+		assert.ErrorContains(t, err, "OIDC information missing")
+	})
+
+	t.Run("oidc issuer is not https", func(t *testing.T) {
+		_, err := NewAgentNameSettings(&self_hosted.AgentNameSettings{
+			AssignmentOrigin: self_hosted.AgentNameSettings_ASSIGNMENT_ORIGIN_OIDC,
+			Oidc: &self_hosted.AgentNameSettings_OIDC{
+				Issuer:       "http://issuer.example.com",
+				Audience:     "semaphore",
+				NameTemplate: "{sub}",
+			},
+		})
+
+		assert.ErrorContains(t, err, "OIDC issuer must be an https URL")
+	})
+
+	t.Run("no oidc audience", func(t *testing.T) {
+		_, err := NewAgentNameSettings(&self_hosted.AgentNameSettings{
+			AssignmentOrigin: self_hosted.AgentNameSettings_ASSIGNMENT_ORIGIN_OIDC,
+			Oidc: &self_hosted.AgentNameSettings_OIDC{
+				Issuer:       "https://issuer.example.com",
+				NameTemplate: "{sub}",
+			},
+		})
+
+		assert.ErrorContains(t, err, "OIDC audience cannot be empty")
+	})
+
+	t.Run("oidc name template without claims", func(t *testing.T) {
+		_, err := NewAgentNameSettings(&self_hosted.AgentNameSettings{
+			AssignmentOrigin: self_hosted.AgentNameSettings_ASSIGNMENT_ORIGIN_OIDC,
+			Oidc: &self_hosted.AgentNameSettings_OIDC{
+				Issuer:       "https://issuer.example.com",
+				Audience:     "semaphore",
+				NameTemplate: "agent",
+			},
+		})
+
+		assert.ErrorContains(t, err, "OIDC name template must use at least one claim")
+	})
+
+	t.Run("valid oidc settings", func(t *testing.T) {
+		settings, err := NewAgentNameSettings(&self_hosted.AgentNameSettings{
+			AssignmentOrigin: self_hosted.AgentNameSettings_ASSIGNMENT_ORIGIN_OIDC,
+			Oidc: &self_hosted.AgentNameSettings_OIDC{
+				Issuer:       "https://issuer.example.com",
+				Audience:     "semaphore",
+				NameTemplate: "{kubernetes.io.namespace}-{kubernetes.io.pod.name}",
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, NameAssignmentOriginFromOIDC, settings.NameAssignmentOrigin)
+		assert.Equal(t, "https://issuer.example.com", settings.OIDCIssuer)
+		assert.Equal(t, "semaphore", settings.OIDCAudience)
+		assert.Equal(t, "{kubernetes.io.namespace}-{kubernetes.io.pod.name}", settings.OIDCNameTemplate)
+	})
+}
+
+func Test__NewAutoscalingSettings(t *testing.T) {
+	t.Run("no webhook URL => no autoscaling webhook", func(t *testing.T) {
+		settings, err := NewAutoscalingSettings(&self_hosted.AutoscalingSettings{QueuedJobsThreshold: 5}, nil)
+		require.NoError(t, err)
+		assert.False(t, settings.HasAutoscalingWebhook())
+	})
+
+	t.Run("invalid webhook URL", func(t *testing.T) {
+		_, err := NewAutoscalingSettings(&self_hosted.AutoscalingSettings{
+			WebhookUrl:          "ftp://example.com/hook",
+			WebhookSecret:       "secret",
+			QueuedJobsThreshold: 5,
+		}, nil)
+
+		assert.ErrorContains(t, err, "autoscaling webhook URL must be an absolute https URL")
+	})
+
+	t.Run("plain http webhook URL", func(t *testing.T) {
+		_, err := NewAutoscalingSettings(&self_hosted.AutoscalingSettings{
+			WebhookUrl:          "http://example.com/hook",
+			WebhookSecret:       "secret",
+			QueuedJobsThreshold: 5,
+		}, nil)
+
+		assert.ErrorContains(t, err, "autoscaling webhook URL must be an absolute https URL")
+	})
+
+	t.Run("secret is encrypted", func(t *testing.T) {
+		settings, err := NewAutoscalingSettings(&self_hosted.AutoscalingSettings{
+			WebhookUrl:          "https://example.com/hook",
+			WebhookSecret:       "secret",
+			QueuedJobsThreshold: 5,
+		}, nil)
+
+		require.NoError(t, err)
+		assert.NotEqual(t, "secret", settings.AutoscalingWebhookSecret)
+
+		secret, err := encryption.Decrypt(settings.AutoscalingWebhookSecret)
+		require.NoError(t, err)
+		assert.Equal(t, "secret", secret)
+	})
+
+	t.Run("no thresholds", func(t *testing.T) {
+		_, err := NewAutoscalingSettings(&self_hosted.AutoscalingSettings{
+			WebhookUrl:    "https://example.com/hook",
+			WebhookSecret: "secret",
+		}, nil)
+
+		assert.ErrorContains(t, err, "autoscaling webhook requires a queued jobs or idle agents threshold")
+	})
+
+	t.Run("no secret", func(t *testing.T) {
+		_, err := NewAutoscalingSettings(&self_hosted.AutoscalingSettings{
+			WebhookUrl:          "https://example.com/hook",
+			IdleAgentsThreshold: 2,
+		}, nil)
+
+		assert.ErrorContains(t, err, "autoscaling webhook secret cannot be empty")
+	})
+
+	t.Run("secret is kept if not sent again", func(t *testing.T) {
+		settings, err := NewAutoscalingSettings(&self_hosted.AutoscalingSettings{
+			WebhookUrl:          "https://example.com/hook",
+			IdleAgentsThreshold: 2,
+		}, &AutoscalingSettings{AutoscalingWebhookSecret: "current"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "current", settings.AutoscalingWebhookSecret)
+		assert.Equal(t, 2, settings.AutoscalingIdleAgentsThreshold)
+	})
 }
 
 func Test__FindAgentTypeByToken(t *testing.T) {
@@ -0,0 +1,96 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	uuid "github.com/google/uuid"
+	database "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/database"
+	"gorm.io/gorm"
+)
+
+// AutoscalingHintState is what the autoscaling hints notifier remembers about an agent type
+// between checks: the last queued jobs counts, and which thresholds were already reported.
+// It lives in the database, so restarts and other replicas don't send the same hints again.
+type AutoscalingHintState struct {
+	OrganizationID uuid.UUID `gorm:"primaryKey"`
+	AgentTypeName  string    `gorm:"primaryKey"`
+	QueuedSamples  QueuedSamples
+	QueueBreached  bool
+	IdleBreached   bool
+	CheckedAt      *time.Time
+}
+
+type QueuedSamples []int
+
+func (s QueuedSamples) Value() (driver.Value, error) {
+	if s == nil {
+		return "[]", nil
+	}
+
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
+
+func (s *QueuedSamples) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, s)
+	case string:
+		return json.Unmarshal([]byte(v), s)
+	default:
+		return errors.New("type assertion to []byte failed")
+	}
+}
+
+// ClaimAutoscalingHintStates returns the states of the agent types with an autoscaling webhook
+// that were not checked for the given interval, and marks them as checked.
+// A state is created the first time its agent type is claimed.
+func ClaimAutoscalingHintStates(tx *gorm.DB, interval time.Duration) ([]AutoscalingHintState, error) {
+	err := tx.Exec(`
+		INSERT INTO autoscaling_hint_states (organization_id, agent_type_name)
+		SELECT organization_id, name FROM agent_types WHERE autoscaling_webhook_url <> ''
+		ON CONFLICT DO NOTHING`).Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	states := []AutoscalingHintState{}
+	err = tx.Raw(`
+		UPDATE autoscaling_hint_states s
+		SET checked_at = ?
+		FROM agent_types t
+		WHERE t.organization_id = s.organization_id
+		  AND t.name = s.agent_type_name
+		  AND t.autoscaling_webhook_url <> ''
+		  AND (s.checked_at IS NULL OR s.checked_at <= ?)
+		RETURNING s.*`, now, now.Add(-interval)).
+		Scan(&states).
+		Error
+
+	if err != nil {
+		return nil, err
+	}
+
+	return states, nil
+}
+
+func (s *AutoscalingHintState) Save() error {
+	return database.Conn().
+		Model(&AutoscalingHintState{}).
+		Where("organization_id = ? AND agent_type_name = ?", s.OrganizationID, s.AgentTypeName).
+		Updates(map[string]interface{}{
+			"queued_samples": s.QueuedSamples,
+			"queue_breached": s.QueueBreached,
+			"idle_breached":  s.IdleBreached,
+		}).
+		Error
+}
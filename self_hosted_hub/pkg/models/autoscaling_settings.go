@@ -0,0 +1,83 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+
+	database "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/database"
+	"github.com/semaphoreio/semaphore/self_hosted_hub/pkg/encryption"
+	pb "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/protos/self_hosted"
+)
+
+var ErrEncryptingWebhookSecret = errors.New("error encrypting autoscaling webhook secret")
+
+// The webhook secret is stored encrypted, and only decrypted to sign the hints.
+type AutoscalingSettings struct {
+	AutoscalingWebhookURL          string
+	AutoscalingWebhookSecret       string
+	AutoscalingQueuedJobsThreshold int
+	AutoscalingIdleAgentsThreshold int
+}
+
+// NewAutoscalingSettings validates the autoscaling settings of a request.
+// The current settings are used to keep the secret when an update does not send it again.
+func NewAutoscalingSettings(reqSettings *pb.AutoscalingSettings, current *AutoscalingSettings) (*AutoscalingSettings, error) {
+	if reqSettings == nil || reqSettings.WebhookUrl == "" {
+		return &AutoscalingSettings{}, nil
+	}
+
+	u, err := url.Parse(reqSettings.WebhookUrl)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("autoscaling webhook URL must be an absolute https URL")
+	}
+
+	if reqSettings.QueuedJobsThreshold < 0 || reqSettings.IdleAgentsThreshold < 0 {
+		return nil, fmt.Errorf("autoscaling thresholds can't be negative")
+	}
+
+	if reqSettings.QueuedJobsThreshold == 0 && reqSettings.IdleAgentsThreshold == 0 {
+		return nil, fmt.Errorf("autoscaling webhook requires a queued jobs or idle agents threshold")
+	}
+
+	secret := ""
+	if reqSettings.WebhookSecret != "" {
+		secret, err = encryption.Encrypt(reqSettings.WebhookSecret)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrEncryptingWebhookSecret, err)
+		}
+	} else if current != nil {
+		secret = current.AutoscalingWebhookSecret
+	}
+
+	if secret == "" {
+		return nil, fmt.Errorf("autoscaling webhook secret cannot be empty")
+	}
+
+	return &AutoscalingSettings{
+		AutoscalingWebhookURL:          reqSettings.WebhookUrl,
+		AutoscalingWebhookSecret:       secret,
+		AutoscalingQueuedJobsThreshold: int(reqSettings.QueuedJobsThreshold),
+		AutoscalingIdleAgentsThreshold: int(reqSettings.IdleAgentsThreshold),
+	}, nil
+}
+
+func (s *AutoscalingSettings) HasAutoscalingWebhook() bool {
+	return s.AutoscalingWebhookURL != ""
+}
+
+func ListAgentTypesWithAutoscalingWebhook() ([]AgentType, error) {
+	var ats []AgentType
+
+	err := database.Conn().
+		Where("autoscaling_webhook_url <> ''").
+		Order("created_at asc").
+		Find(&ats).
+		Error
+
+	if err != nil {
+		return []AgentType{}, err
+	}
+
+	return ats, nil
+}
@@ -5,17 +5,29 @@ import (
 
 	"github.com/google/uuid"
 	database "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/database"
+	log "github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
 
+// Channel notified with "<organization-id>/<agent-type-name>"
+// every time an occupation request is created.
+const OccupationRequestsChannel = "occupation_requests"
+
 type OccupationRequest struct {
 	OrganizationID uuid.UUID `gorm:"primaryKey"`
 	AgentTypeName  string    `gorm:"primaryKey"`
 	JobID          uuid.UUID `gorm:"primaryKey"`
+	Labels         AgentLabels
 	CreatedAt      *time.Time
 }
 
 func CreateOccupationRequest(orgID uuid.UUID, agentTypeName string, jobID uuid.UUID) error {
+	return CreateOccupationRequestWithLabels(orgID, agentTypeName, jobID, nil)
+}
+
+// CreateOccupationRequestWithLabels creates an occupation request
+// that can only be taken by agents having all the given labels.
+func CreateOccupationRequestWithLabels(orgID uuid.UUID, agentTypeName string, jobID uuid.UUID, labels AgentLabels) error {
 	_, err := FindOccupationRequest(orgID, agentTypeName, jobID)
 	if err == nil {
 		return nil
@@ -25,6 +37,7 @@ func CreateOccupationRequest(orgID uuid.UUID, agentTypeName string, jobID uuid.U
 		OrganizationID: orgID,
 		AgentTypeName:  agentTypeName,
 		JobID:          jobID,
+		Labels:         labels,
 	}
 
 	err = database.Conn().Create(&request).Error
@@ -32,9 +45,20 @@ func CreateOccupationRequest(orgID uuid.UUID, agentTypeName string, jobID uuid.U
 		return err
 	}
 
+	// Agents waiting for jobs will find the request on their next sync anyway,
+	// so failing to notify them only delays the job.
+	err = database.Notify(OccupationRequestsChannel, OccupationRequestKey(orgID, agentTypeName))
+	if err != nil {
+		log.Errorf("Error notifying occupation request for %s: %v", jobID, err)
+	}
+
 	return nil
 }
 
+func OccupationRequestKey(orgID uuid.UUID, agentTypeName string) string {
+	return orgID.String() + "/" + agentTypeName
+}
+
 func FindOccupationRequest(orgID uuid.UUID, agentTypeName string, jobID uuid.UUID) (*OccupationRequest, error) {
 	return FindOccupationRequestInTransaction(database.Conn(), orgID, agentTypeName, jobID)
 }
@@ -0,0 +1,333 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+)
+
+// How long the keys of an issuer are used before fetching them again.
+// Unknown key IDs trigger a refresh sooner, but at most once per minRefreshInterval,
+// so tokens with random key IDs can't be used to flood the issuer with requests.
+const keysTTL = 10 * time.Minute
+const minRefreshInterval = time.Minute
+
+var templateRegex = regexp.MustCompile(`\{([^{}]+)\}`)
+
+var validMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// keySet holds the keys of one issuer. The lock is held while the keys are fetched,
+// so requests for the same issuer wait for a single fetch, and other issuers are not blocked.
+type keySet struct {
+	lock      chan struct{}
+	keys      map[string]crypto.PublicKey
+	fetchedAt time.Time
+}
+
+// Verifier validates OIDC tokens with the keys published by their issuers.
+// Issuers are set by users, so the HTTP client should only reach public addresses, see publicnet.
+type Verifier struct {
+	httpClient *http.Client
+	mu         sync.Mutex
+	keySets    map[string]*keySet
+}
+
+func NewVerifier(httpClient *http.Client) *Verifier {
+	return &Verifier{
+		httpClient: httpClient,
+		keySets:    map[string]*keySet{},
+	}
+}
+
+// AssignName validates the token for the issuer and audience,
+// and returns the agent name built from its claims with the name template.
+func (v *Verifier) AssignName(ctx context.Context, issuer, audience, nameTemplate, token string) (string, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return v.key(ctx, issuer, kid)
+	},
+		jwt.WithIssuer(issuer),
+		jwt.WithAudience(audience),
+		jwt.WithExpirationRequired(),
+		jwt.WithValidMethods(validMethods),
+		jwt.WithLeeway(time.Minute),
+	)
+
+	if err != nil {
+		return "", fmt.Errorf("invalid OIDC token: %v", err)
+	}
+
+	return RenderName(nameTemplate, claims)
+}
+
+// ValidateNameTemplate checks the template references at least one claim.
+func ValidateNameTemplate(nameTemplate string) error {
+	if !templateRegex.MatchString(nameTemplate) {
+		return fmt.Errorf("OIDC name template must use at least one claim")
+	}
+
+	return nil
+}
+
+// RenderName replaces the {claim} references in the template with the claim values.
+// Nested claims are referenced with dots, and claim names can also include dots,
+// e.g. {kubernetes.io.pod.name} for {"kubernetes.io": {"pod": {"name": "..."}}}.
+func RenderName(nameTemplate string, claims map[string]interface{}) (string, error) {
+	var missing []string
+	name := templateRegex.ReplaceAllStringFunc(nameTemplate, func(ref string) string {
+		path := strings.TrimSpace(ref[1 : len(ref)-1])
+		value, ok := lookupClaim(claims, path)
+		if !ok {
+			missing = append(missing, path)
+			return ""
+		}
+
+		return value
+	})
+
+	if len(missing) > 0 {
+		return "", fmt.Errorf("OIDC token does not have the claims %v", missing)
+	}
+
+	return name, nil
+}
+
+func lookupClaim(claims map[string]interface{}, path string) (string, bool) {
+	if value, ok := claims[path]; ok {
+		return claimString(value)
+	}
+
+	// Try the longest claim name first, since claim names can include dots.
+	for i := strings.LastIndex(path, "."); i > 0; i = strings.LastIndex(path[:i], ".") {
+		nested, ok := claims[path[:i]].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if value, ok := lookupClaim(nested, path[i+1:]); ok {
+			return value, true
+		}
+	}
+
+	return "", false
+}
+
+func claimString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, v != ""
+	case float64:
+		return fmt.Sprintf("%.0f", v), true
+	case bool:
+		return fmt.Sprintf("%t", v), true
+	default:
+		return "", false
+	}
+}
+
+func (v *Verifier) key(ctx context.Context, issuer, kid string) (crypto.PublicKey, error) {
+	set := v.keySet(issuer)
+
+	select {
+	case set.lock <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	defer func() { <-set.lock }()
+
+	if time.Since(set.fetchedAt) < keysTTL {
+		if key, ok := set.find(kid); ok {
+			return key, nil
+		}
+
+		if time.Since(set.fetchedAt) < minRefreshInterval {
+			return nil, fmt.Errorf("unknown key '%s'", kid)
+		}
+	}
+
+	keys, err := v.fetchKeys(ctx, issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	set.keys = keys
+	set.fetchedAt = time.Now()
+
+	if key, ok := set.find(kid); ok {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("unknown key '%s'", kid)
+}
+
+func (v *Verifier) keySet(issuer string) *keySet {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	set, ok := v.keySets[issuer]
+	if !ok {
+		set = &keySet{lock: make(chan struct{}, 1)}
+		v.keySets[issuer] = set
+	}
+
+	return set
+}
+
+// Tokens without a key ID can only be verified if the issuer has a single key.
+func (s *keySet) find(kid string) (crypto.PublicKey, bool) {
+	if kid == "" && len(s.keys) == 1 {
+		for _, key := range s.keys {
+			return key, true
+		}
+	}
+
+	key, ok := s.keys[kid]
+	return key, ok
+}
+
+type discoveryDocument struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
+}
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (v *Verifier) fetchKeys(ctx context.Context, issuer string) (map[string]crypto.PublicKey, error) {
+	discovery := discoveryDocument{}
+	err := v.getJSON(ctx, strings.TrimSuffix(issuer, "/")+"/.well-known/openid-configuration", &discovery)
+	if err != nil {
+		return nil, err
+	}
+
+	if discovery.Issuer != issuer {
+		return nil, fmt.Errorf("OIDC discovery document is for issuer '%s'", discovery.Issuer)
+	}
+
+	jwksURL, err := url.Parse(discovery.JWKSURI)
+	if err != nil || jwksURL.Scheme != "https" || jwksURL.Host == "" {
+		return nil, fmt.Errorf("OIDC discovery document has an invalid jwks_uri '%s'", discovery.JWKSURI)
+	}
+
+	jwks := struct {
+		Keys []jsonWebKey `json:"keys"`
+	}{}
+
+	err = v.getJSON(ctx, discovery.JWKSURI, &jwks)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := map[string]crypto.PublicKey{}
+	for _, k := range jwks.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		key, err := parseKey(k)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing key '%s': %v", k.Kid, err)
+		}
+
+		if key != nil {
+			keys[k.Kid] = key
+		}
+	}
+
+	return keys, nil
+}
+
+func (v *Verifier) getJSON(ctx context.Context, URL string, target interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, URL, nil)
+	if err != nil {
+		return fmt.Errorf("error building request to %s: %v", URL, err)
+	}
+
+	res, err := v.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error executing request to %s: %v", URL, err)
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("request to %s failed with %d", URL, res.StatusCode)
+	}
+
+	err = json.NewDecoder(res.Body).Decode(target)
+	if err != nil {
+		return fmt.Errorf("error parsing response from %s: %v", URL, err)
+	}
+
+	return nil
+}
+
+// parseKey returns nil for key types and curves that can't be used to verify the accepted methods.
+func parseKey(k jsonWebKey) (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, nil
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,274 @@
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testIssuer struct {
+	server      *httptest.Server
+	key         *rsa.PrivateKey
+	kid         string
+	jwksFetches int32
+
+	// set before the first request to change how the issuer responds
+	jwksURI   string
+	discovery chan struct{}
+	otherKeys []map[string]string
+}
+
+func newTestIssuer(t *testing.T) *testIssuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	issuer := &testIssuer{key: key, kid: "key-1"}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		if issuer.discovery != nil {
+			<-issuer.discovery
+		}
+
+		jwksURI := issuer.server.URL + "/keys"
+		if issuer.jwksURI != "" {
+			jwksURI = issuer.jwksURI
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":   issuer.server.URL,
+			"jwks_uri": jwksURI,
+		})
+	})
+
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&issuer.jwksFetches, 1)
+		keys := append([]map[string]string{
+			{
+				"kid": issuer.kid,
+				"kty": "RSA",
+				"use": "sig",
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			},
+		}, issuer.otherKeys...)
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": keys})
+	})
+
+	issuer.server = httptest.NewTLSServer(mux)
+	t.Cleanup(issuer.server.Close)
+	return issuer
+}
+
+func (i *testIssuer) token(t *testing.T, kid string, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+
+	signed, err := token.SignedString(i.key)
+	require.NoError(t, err)
+	return signed
+}
+
+func (i *testIssuer) claims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"iss": i.server.URL,
+		"aud": "semaphore",
+		"sub": "system:serviceaccount:ci:agent",
+		"exp": time.Now().Add(time.Hour).Unix(),
+		"kubernetes.io": map[string]interface{}{
+			"namespace": "ci",
+			"pod":       map[string]interface{}{"name": "agent-abc12"},
+		},
+	}
+}
+
+func Test__AssignName(t *testing.T) {
+	issuer := newTestIssuer(t)
+	verifier := NewVerifier(issuer.server.Client())
+	template := "{kubernetes.io.namespace}-{kubernetes.io.pod.name}"
+
+	t.Run("valid token", func(t *testing.T) {
+		token := issuer.token(t, issuer.kid, issuer.claims())
+		name, err := verifier.AssignName(context.Background(), issuer.server.URL, "semaphore", template, token)
+		require.NoError(t, err)
+		assert.Equal(t, "ci-agent-abc12", name)
+	})
+
+	t.Run("keys are cached", func(t *testing.T) {
+		token := issuer.token(t, issuer.kid, issuer.claims())
+		_, err := verifier.AssignName(context.Background(), issuer.server.URL, "semaphore", template, token)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&issuer.jwksFetches))
+	})
+
+	t.Run("wrong audience", func(t *testing.T) {
+		claims := issuer.claims()
+		claims["aud"] = "someone-else"
+		_, err := verifier.AssignName(context.Background(), issuer.server.URL, "semaphore", template, issuer.token(t, issuer.kid, claims))
+		assert.ErrorContains(t, err, "invalid OIDC token")
+	})
+
+	t.Run("wrong issuer", func(t *testing.T) {
+		claims := issuer.claims()
+		claims["iss"] = "https://issuer.example.com"
+		_, err := verifier.AssignName(context.Background(), issuer.server.URL, "semaphore", template, issuer.token(t, issuer.kid, claims))
+		assert.ErrorContains(t, err, "invalid OIDC token")
+	})
+
+	t.Run("expired token", func(t *testing.T) {
+		claims := issuer.claims()
+		claims["exp"] = time.Now().Add(-time.Hour).Unix()
+		_, err := verifier.AssignName(context.Background(), issuer.server.URL, "semaphore", template, issuer.token(t, issuer.kid, claims))
+		assert.ErrorContains(t, err, "invalid OIDC token")
+	})
+
+	t.Run("no expiration", func(t *testing.T) {
+		claims := issuer.claims()
+		delete(claims, "exp")
+		_, err := verifier.AssignName(context.Background(), issuer.server.URL, "semaphore", template, issuer.token(t, issuer.kid, claims))
+		assert.ErrorContains(t, err, "invalid OIDC token")
+	})
+
+	t.Run("signed by another key", func(t *testing.T) {
+		other := newTestIssuer(t)
+		_, err := verifier.AssignName(context.Background(), issuer.server.URL, "semaphore", template, other.token(t, issuer.kid, issuer.claims()))
+		assert.ErrorContains(t, err, "invalid OIDC token")
+	})
+
+	t.Run("unknown key does not refresh keys too often", func(t *testing.T) {
+		_, err := verifier.AssignName(context.Background(), issuer.server.URL, "semaphore", template, issuer.token(t, "key-2", issuer.claims()))
+		assert.ErrorContains(t, err, "unknown key 'key-2'")
+		assert.Equal(t, int32(1), atomic.LoadInt32(&issuer.jwksFetches))
+	})
+
+	t.Run("unsigned token", func(t *testing.T) {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodNone, issuer.claims()).SignedString(jwt.UnsafeAllowNoneSignatureType)
+		require.NoError(t, err)
+
+		_, err = verifier.AssignName(context.Background(), issuer.server.URL, "semaphore", template, token)
+		assert.ErrorContains(t, err, "invalid OIDC token")
+	})
+
+	t.Run("missing claim", func(t *testing.T) {
+		token := issuer.token(t, issuer.kid, issuer.claims())
+		_, err := verifier.AssignName(context.Background(), issuer.server.URL, "semaphore", "{repository}", token)
+		assert.ErrorContains(t, err, "OIDC token does not have the claims [repository]")
+	})
+
+	t.Run("keys with unsupported curves are skipped", func(t *testing.T) {
+		other := newTestIssuer(t)
+		other.otherKeys = []map[string]string{
+			{"kid": "key-secp256k1", "kty": "EC", "use": "sig", "crv": "secp256k1", "x": "AQ", "y": "AQ"},
+		}
+
+		token := other.token(t, other.kid, other.claims())
+		name, err := NewVerifier(other.server.Client()).AssignName(context.Background(), other.server.URL, "semaphore", template, token)
+		require.NoError(t, err)
+		assert.Equal(t, "ci-agent-abc12", name)
+	})
+
+	t.Run("keys are not fetched from plain http", func(t *testing.T) {
+		other := newTestIssuer(t)
+		other.jwksURI = "http://" + other.server.Listener.Addr().String() + "/keys"
+
+		token := other.token(t, other.kid, other.claims())
+		_, err := NewVerifier(other.server.Client()).AssignName(context.Background(), other.server.URL, "semaphore", template, token)
+		assert.ErrorContains(t, err, "invalid jwks_uri")
+		assert.Equal(t, int32(0), atomic.LoadInt32(&other.jwksFetches))
+	})
+}
+
+func Test__AssignNameWithSlowIssuer(t *testing.T) {
+	slow := newTestIssuer(t)
+	slow.discovery = make(chan struct{})
+	fast := newTestIssuer(t)
+
+	verifier := NewVerifier(&http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: certPool(slow, fast)}}})
+	template := "{kubernetes.io.namespace}-{kubernetes.io.pod.name}"
+
+	// requests for the same issuer wait for a single fetch
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := verifier.AssignName(context.Background(), slow.server.URL, "semaphore", template, slow.token(t, slow.kid, slow.claims()))
+			assert.NoError(t, err)
+		}()
+	}
+
+	require.Eventually(t, func() bool {
+		return len(verifier.keySet(slow.server.URL).lock) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	// other issuers are not blocked while it is fetched
+	name, err := verifier.AssignName(context.Background(), fast.server.URL, "semaphore", template, fast.token(t, fast.kid, fast.claims()))
+	require.NoError(t, err)
+	assert.Equal(t, "ci-agent-abc12", name)
+
+	// waiting for the fetch stops when the request is canceled
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = verifier.key(ctx, slow.server.URL, slow.kid)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	close(slow.discovery)
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&slow.jwksFetches))
+}
+
+func certPool(issuers ...*testIssuer) *x509.CertPool {
+	pool := x509.NewCertPool()
+	for _, issuer := range issuers {
+		pool.AddCert(issuer.server.Certificate())
+	}
+
+	return pool
+}
+
+func Test__RenderName(t *testing.T) {
+	claims := map[string]interface{}{
+		"sub":       "repo:org/app:ref:refs/heads/main",
+		"run_id":    float64(1234),
+		"namespace": "ci",
+		"kubernetes.io": map[string]interface{}{
+			"pod": map[string]interface{}{"name": "agent-abc12"},
+		},
+		"empty": "",
+	}
+
+	name, err := RenderName("{namespace}-{run_id}", claims)
+	require.NoError(t, err)
+	assert.Equal(t, "ci-1234", name)
+
+	name, err = RenderName("{kubernetes.io.pod.name}", claims)
+	require.NoError(t, err)
+	assert.Equal(t, "agent-abc12", name)
+
+	_, err = RenderName("{kubernetes.io.pod.uid}", claims)
+	assert.ErrorContains(t, err, "OIDC token does not have the claims [kubernetes.io.pod.uid]")
+
+	_, err = RenderName("{empty}", claims)
+	assert.ErrorContains(t, err, "OIDC token does not have the claims [empty]")
+}
+
+func Test__ValidateNameTemplate(t *testing.T) {
+	assert.NoError(t, ValidateNameTemplate("agent-{sub}"))
+	assert.ErrorContains(t, ValidateNameTemplate("agent"), "OIDC name template must use at least one claim")
+	assert.ErrorContains(t, ValidateNameTemplate("agent-{}"), "OIDC name template must use at least one claim")
+}
@@ -33,6 +33,7 @@ const (
 	SelfHostedAgents_DeleteAgentType_FullMethodName  = "/InternalApi.SelfHosted.SelfHostedAgents/DeleteAgentType"
 	SelfHostedAgents_StopJob_FullMethodName          = "/InternalApi.SelfHosted.SelfHostedAgents/StopJob"
 	SelfHostedAgents_ResetToken_FullMethodName       = "/InternalApi.SelfHosted.SelfHostedAgents/ResetToken"
+	SelfHostedAgents_ListAgentEvents_FullMethodName  = "/InternalApi.SelfHosted.SelfHostedAgents/ListAgentEvents"
 )
 
 // SelfHostedAgentsClient is the client API for SelfHostedAgents service.
@@ -53,6 +54,7 @@ type SelfHostedAgentsClient interface {
 	DeleteAgentType(ctx context.Context, in *DeleteAgentTypeRequest, opts ...grpc.CallOption) (*DeleteAgentTypeResponse, error)
 	StopJob(ctx context.Context, in *StopJobRequest, opts ...grpc.CallOption) (*StopJobResponse, error)
 	ResetToken(ctx context.Context, in *ResetTokenRequest, opts ...grpc.CallOption) (*ResetTokenResponse, error)
+	ListAgentEvents(ctx context.Context, in *ListAgentEventsRequest, opts ...grpc.CallOption) (*ListAgentEventsResponse, error)
 }
 
 type selfHostedAgentsClient struct {
@@ -203,6 +205,16 @@ func (c *selfHostedAgentsClient) ResetToken(ctx context.Context, in *ResetTokenR
 	return out, nil
 }
 
+func (c *selfHostedAgentsClient) ListAgentEvents(ctx context.Context, in *ListAgentEventsRequest, opts ...grpc.CallOption) (*ListAgentEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAgentEventsResponse)
+	err := c.cc.Invoke(ctx, SelfHostedAgents_ListAgentEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SelfHostedAgentsServer is the server API for SelfHostedAgents service.
 // All implementations should embed UnimplementedSelfHostedAgentsServer
 // for forward compatibility.
@@ -221,6 +233,7 @@ type SelfHostedAgentsServer interface {
 	DeleteAgentType(context.Context, *DeleteAgentTypeRequest) (*DeleteAgentTypeResponse, error)
 	StopJob(context.Context, *StopJobRequest) (*StopJobResponse, error)
 	ResetToken(context.Context, *ResetTokenRequest) (*ResetTokenResponse, error)
+	ListAgentEvents(context.Context, *ListAgentEventsRequest) (*ListAgentEventsResponse, error)
 }
 
 // UnimplementedSelfHostedAgentsServer should be embedded to have
@@ -272,6 +285,9 @@ func (UnimplementedSelfHostedAgentsServer) StopJob(context.Context, *StopJobRequ
 func (UnimplementedSelfHostedAgentsServer) ResetToken(context.Context, *ResetTokenRequest) (*ResetTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetToken not implemented")
 }
+func (UnimplementedSelfHostedAgentsServer) ListAgentEvents(context.Context, *ListAgentEventsRequest) (*ListAgentEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAgentEvents not implemented")
+}
 func (UnimplementedSelfHostedAgentsServer) testEmbeddedByValue() {}
 
 // UnsafeSelfHostedAgentsServer may be embedded to opt out of forward compatibility for this service.
@@ -544,6 +560,24 @@ func _SelfHostedAgents_ResetToken_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SelfHostedAgents_ListAgentEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAgentEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SelfHostedAgentsServer).ListAgentEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SelfHostedAgents_ListAgentEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SelfHostedAgentsServer).ListAgentEvents(ctx, req.(*ListAgentEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SelfHostedAgents_ServiceDesc is the grpc.ServiceDesc for SelfHostedAgents service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -607,6 +641,10 @@ var SelfHostedAgents_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetToken",
 			Handler:    _SelfHostedAgents_ResetToken_Handler,
 		},
+		{
+			MethodName: "ListAgentEvents",
+			Handler:    _SelfHostedAgents_ListAgentEvents_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "self_hosted.proto",
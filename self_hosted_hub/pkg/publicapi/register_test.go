@@ -184,6 +184,21 @@ func Test__Register(t *testing.T) {
 		require.Contains(t, string(body), "only pre-signed AWS STS URLs for name assignment are allowed")
 	})
 
+	t.Run("it fails without details if the OIDC token can't be validated", func(t *testing.T) {
+		_, token, _ := newAgentTypeWithSettings("s1-oidc", models.AgentNameSettings{
+			NameAssignmentOrigin: models.NameAssignmentOriginFromOIDC,
+			OIDCIssuer:           "https://127.0.0.1:8443",
+			OIDCAudience:         "semaphore",
+			OIDCNameTemplate:     "{sub}",
+		})
+
+		res := run("POST", "/register", token, registerRequest("not-a-token"))
+		require.Equal(t, http.StatusBadRequest, res.Code)
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.Equal(t, "invalid OIDC token\n", string(body))
+	})
+
 	t.Run("it fails if job is requested and occupation request does not exist", func(t *testing.T) {
 		agentTypeName := fmt.Sprintf("s1-test-%d", rand.Int())
 		_, token, _ := newAgentType(agentTypeName)
@@ -230,4 +245,49 @@ func Test__Register(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, jobID, *agent.AssignedJobID)
 	})
+
+	t.Run("it stores the agent labels", func(t *testing.T) {
+		agentTypeName := fmt.Sprintf("s1-test-%d", rand.Int())
+		agentType, token, _ := newAgentType(agentTypeName)
+
+		agentName := fmt.Sprintf("%s-%d", agentType.Name, rand.Intn(100000000))
+		req := registerRequest(agentName)
+		req.Labels = map[string]string{"gpu": "false", "docker": "true", "region": "eu"}
+
+		res := run("POST", "/register", token, req)
+		require.Equal(t, http.StatusCreated, res.Code)
+
+		agent, err := models.FindAgentByName(testOrgID.String(), agentName)
+		require.NoError(t, err)
+		require.Equal(t, models.AgentLabels{"gpu": "false", "docker": "true", "region": "eu"}, agent.Labels)
+	})
+
+	t.Run("it fails if labels are invalid", func(t *testing.T) {
+		agentTypeName := fmt.Sprintf("s1-test-%d", rand.Int())
+		_, token, _ := newAgentType(agentTypeName)
+
+		req := registerRequest(fmt.Sprintf("%s-%d", agentTypeName, rand.Intn(100000000)))
+		req.Labels = map[string]string{"has space": "true"}
+
+		res := run("POST", "/register", token, req)
+		require.Equal(t, http.StatusBadRequest, res.Code)
+	})
+
+	t.Run("it fails if job is requested but agent does not have its labels", func(t *testing.T) {
+		agentTypeName := fmt.Sprintf("s1-test-%d", rand.Int())
+		agentType, token, _ := newAgentType(agentTypeName)
+		jobID := uuid.New()
+		require.NoError(t, models.CreateOccupationRequestWithLabels(testOrgID, agentTypeName, jobID, models.AgentLabels{"gpu": "true"}))
+
+		agentName := fmt.Sprintf("%s-%d", agentType.Name, rand.Intn(100000000))
+		req := registerRequest(agentName)
+		req.JobID = jobID.String()
+		req.Labels = map[string]string{"gpu": "false"}
+
+		res := run("POST", "/register", token, req)
+		require.Equal(t, http.StatusBadRequest, res.Code)
+
+		_, err := models.FindAgentByName(testOrgID.String(), agentName)
+		require.Error(t, err)
+	})
 }
@@ -20,10 +20,12 @@ import (
 	"github.com/semaphoreio/semaphore/self_hosted_hub/pkg/database"
 	logging "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/logging"
 	models "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/models"
+	oidc "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/oidc"
 	"github.com/semaphoreio/semaphore/self_hosted_hub/pkg/protos/audit"
 	zebra "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/protos/server_farm.job"
 	loghub2 "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/publicapi/loghub2"
 	zebraclient "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/publicapi/zebraclient"
+	"github.com/semaphoreio/semaphore/self_hosted_hub/pkg/publicnet"
 	quotas "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/quotas"
 	"github.com/semaphoreio/semaphore/self_hosted_hub/pkg/workers/agentcounter"
 	log "github.com/sirupsen/logrus"
@@ -42,7 +44,9 @@ type Server struct {
 	quotaClient           *quotas.QuotaClient
 	agentCounter          *agentcounter.AgentCounter
 	publisher             *amqp.Publisher
+	dispatcher            *agentsync.Dispatcher
 	httpClient            *http.Client
+	oidcVerifier          *oidc.Verifier
 }
 
 func NewServer(
@@ -56,11 +60,16 @@ func NewServer(
 		Timeout: 5 * time.Second,
 	}
 
+	dispatcher := agentsync.NewDispatcher()
+	go dispatcher.Start(context.Background())
+
 	server := &Server{
 		quotaClient:  quotaClient,
 		publisher:    publisher,
 		agentCounter: agentCounter,
+		dispatcher:   dispatcher,
 		httpClient:   &httpClient,
+		oidcVerifier: oidc.NewVerifier(publicnet.NewClient(5 * time.Second)),
 	}
 
 	server.timeoutHandlerTimeout = 15 * time.Second
@@ -176,16 +185,18 @@ func (s *Server) DescribeJob(w http.ResponseWriter, r *http.Request) {
 }
 
 type RegisterRequest struct {
-	Version                 string `json:"version"`
-	Arch                    string `json:"arch"`
-	Name                    string `json:"name"`
-	OS                      string `json:"os"`
-	PID                     int    `json:"pid"`
-	Hostname                string `json:"hostname"`
-	SingleJob               bool   `json:"single_job"`
-	IdleTimeout             int    `json:"idle_timeout"`
-	InterruptionGracePeriod int    `json:"interruption_grace_period"`
-	JobID                   string `json:"job_id"`
+	Version                 string            `json:"version"`
+	Arch                    string            `json:"arch"`
+	Name                    string            `json:"name"`
+	OS                      string            `json:"os"`
+	PID                     int               `json:"pid"`
+	Hostname                string            `json:"hostname"`
+	SingleJob               bool              `json:"single_job"`
+	IdleTimeout             int               `json:"idle_timeout"`
+	InterruptionGracePeriod int               `json:"interruption_grace_period"`
+	JobID                   string            `json:"job_id"`
+	Labels                  map[string]string `json:"labels"`
+	LongPoll                bool              `json:"long_poll"`
 }
 
 type RegisterResponse struct {
@@ -214,6 +225,12 @@ func (s *Server) Register(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	labels := models.AgentLabels(info.Labels)
+	if err := labels.Validate(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	agentName, err := s.assignAgentName(r.Context(), agentType, info.Name)
 	if err != nil {
 		logging.ForAgentType(agentType).Errorf("Error assigning agent name: %v", err)
@@ -232,6 +249,8 @@ func (s *Server) Register(w http.ResponseWriter, r *http.Request) {
 		IdleTimeout:             info.IdleTimeout,
 		InterruptionGracePeriod: info.InterruptionGracePeriod,
 		IPAddress:               r.RemoteAddr, // populated by handlers.ProxyHeaders middleware
+		Labels:                  labels,
+		LongPoll:                info.LongPoll,
 	}
 
 	// We use a blocking advisory lock here because counting the current number of agents
@@ -326,7 +345,13 @@ func (s *Server) registerAgent(ctx context.Context, tx *gorm.DB, agentType *mode
 		return nil, "", err
 	}
 
-	if _, err := models.FindOccupationRequestInTransaction(tx, agentType.OrganizationID, agentType.Name, *jobID); err != nil {
+	request, err := models.FindOccupationRequestInTransaction(tx, agentType.OrganizationID, agentType.Name, *jobID)
+	if err != nil {
+		return nil, "", ErrOccupationRequestNotFound
+	}
+
+	// The job can't run on this agent, so for the agent, there is nothing to take.
+	if !metadata.Labels.Satisfies(request.Labels) {
 		return nil, "", ErrOccupationRequestNotFound
 	}
 
@@ -530,7 +555,7 @@ func (s *Server) Sync(w http.ResponseWriter, r *http.Request) {
 
 	logging.ForAgent(agent).Debugf("Sync request: %v", request)
 
-	response, err := agentsync.Process(r.Context(), s.quotaClient, s.agentCounter, s.publisher, agent, request)
+	response, err := agentsync.Process(r.Context(), s.quotaClient, s.agentCounter, s.publisher, s.dispatcher, agent, request)
 	if err != nil {
 		logging.ForAgent(agent).Errorf("Error processing sync request: %v", err)
 		_ = watchman.IncrementWithTags("server.error", []string{"sync_error", orgID})
@@ -628,6 +653,28 @@ func (s *Server) assignAgentName(ctx context.Context, agentType *models.AgentTyp
 		return models.ValidateAgentName(nameFromAgent)
 	}
 
+	// Agent type requires the name to come from an OIDC token,
+	// so the agent sends the token instead of its name.
+	if agentType.AgentNameSettings.NameAssignmentOrigin == models.NameAssignmentOriginFromOIDC {
+		logging.ForAgentType(agentType).Info("Validating OIDC token to assign agent name")
+		name, err := s.oidcVerifier.AssignName(
+			ctx,
+			agentType.AgentNameSettings.OIDCIssuer,
+			agentType.AgentNameSettings.OIDCAudience,
+			agentType.AgentNameSettings.OIDCNameTemplate,
+			nameFromAgent,
+		)
+
+		// The details are only logged, so the response
+		// can't be used to probe the issuer or its keys.
+		if err != nil {
+			logging.ForAgentType(agentType).Errorf("Error validating OIDC token: %v", err)
+			return "", fmt.Errorf("invalid OIDC token")
+		}
+
+		return models.ValidateAgentName(name)
+	}
+
 	// Agent type requires the name to come from AWS STS,
 	// so if the URL isn't from AWS STS, we should still reject it.
 	if parsedURL, err := url.ParseRequestURI(nameFromAgent); err != nil || !aws.IsSTSURL(parsedURL) {
@@ -1060,6 +1060,74 @@ func Test__Sync(t *testing.T) {
 	})
 }
 
+func Test__SyncLongPoll(t *testing.T) {
+	database.TruncateTables()
+	_ = declareExchangeAndQueue()
+	t.Setenv("LONG_POLL_TIMEOUT", "2000")
+
+	agentType, agentTypeToken, err := newAgentType("s1-test")
+	require.Nil(t, err)
+
+	waitForJobs := func(token string) (*agentsync.Response, time.Duration) {
+		start := time.Now()
+		res := run("POST", "/sync", token, &agentsync.Request{State: agentsync.AgentStateWaitingForJobs})
+		require.Equal(t, http.StatusOK, res.Code)
+
+		var response agentsync.Response
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&response))
+		return &response, time.Since(start)
+	}
+
+	t.Run("agents advertise long polling support on registration", func(t *testing.T) {
+		agentName := fmt.Sprintf("%s-%d", agentType.Name, rand.Intn(100000000))
+		req := registerRequest(agentName)
+		req.LongPoll = true
+
+		res := run("POST", "/register", agentTypeToken, req)
+		require.Equal(t, http.StatusCreated, res.Code)
+
+		agent, err := models.FindAgentByName(testOrgID.String(), agentName)
+		require.NoError(t, err)
+		require.True(t, agent.LongPoll)
+	})
+
+	t.Run("request is held until a job arrives", func(t *testing.T) {
+		_, token, err := newAgentWithMetadata(agentType, models.AgentMetadata{LongPoll: true})
+		require.NoError(t, err)
+
+		jobID := database.UUID()
+		go func() {
+			time.Sleep(500 * time.Millisecond)
+			_ = models.CreateOccupationRequest(testOrgID, agentType.Name, jobID)
+		}()
+
+		response, took := waitForJobs(token)
+		require.Equal(t, agentsync.AgentAction(agentsync.AgentActionRunJob), response.Action)
+		require.Equal(t, jobID.String(), response.JobID)
+		require.Less(t, took, 2*time.Second)
+	})
+
+	t.Run("request times out if no job arrives", func(t *testing.T) {
+		_, token, err := newAgentWithMetadata(agentType, models.AgentMetadata{LongPoll: true})
+		require.NoError(t, err)
+
+		response, took := waitForJobs(token)
+		require.Equal(t, agentsync.AgentAction(agentsync.AgentActionContinue), response.Action)
+		require.GreaterOrEqual(t, took, 2*time.Second)
+		require.LessOrEqual(t, response.NextSyncAfter, 500)
+	})
+
+	t.Run("agents without long polling support are not held", func(t *testing.T) {
+		_, token, err := newAgent(agentType)
+		require.NoError(t, err)
+
+		response, took := waitForJobs(token)
+		require.Equal(t, agentsync.AgentAction(agentsync.AgentActionContinue), response.Action)
+		require.Less(t, took, time.Second)
+		require.GreaterOrEqual(t, response.NextSyncAfter, 4000)
+	})
+}
+
 func Test__DescribeJob(t *testing.T) {
 	database.TruncateTables()
 	grpcmock.Start()
@@ -1321,7 +1389,7 @@ func newAgentType(name string) (*models.AgentType, string, error) {
 }
 
 func newAgentTypeWithSettings(name string, settings models.AgentNameSettings) (*models.AgentType, string, error) {
-	return models.CreateAgentTypeWithSettings(testOrgID, &testRequesterID, name, settings)
+	return models.CreateAgentTypeWithSettings(testOrgID, &testRequesterID, name, settings, models.AutoscalingSettings{})
 }
 
 func newAgent(agentType *models.AgentType) (*models.Agent, string, error) {
@@ -0,0 +1,46 @@
+package publicnet
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test__NewClient(t *testing.T) {
+	t.Run("non-public addresses are refused", func(t *testing.T) {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		defer server.Close()
+
+		_, err := NewClient(time.Second).Get(server.URL)
+		assert.ErrorIs(t, err, ErrNonPublicAddress)
+	})
+
+	t.Run("redirects are not followed", func(t *testing.T) {
+		client := NewClient(time.Second)
+		assert.Equal(t, http.ErrUseLastResponse, client.CheckRedirect(nil, nil))
+	})
+}
+
+func Test__IsPublicIP(t *testing.T) {
+	for address, public := range map[string]bool{
+		"8.8.8.8":          true,
+		"2606:4700::1111":  true,
+		"127.0.0.1":        false,
+		"::1":              false,
+		"10.1.2.3":         false,
+		"172.16.0.1":       false,
+		"192.168.1.1":      false,
+		"169.254.169.254":  false,
+		"fe80::1":          false,
+		"fd00:ec2::254":    false,
+		"100.100.100.200":  false,
+		"0.0.0.0":          false,
+		"::ffff:127.0.0.1": false,
+	} {
+		assert.Equal(t, public, IsPublicIP(net.ParseIP(address)), address)
+	}
+}
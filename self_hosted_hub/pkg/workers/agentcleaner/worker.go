@@ -92,6 +92,12 @@ func deleteStuckAgents(db *gorm.DB, publisher *amqp.Publisher) error {
 
 	// Delete only the agents we've processed successfully
 	log.Printf("[%s] Deleting %d agents", CleanerName, len(idsToDelete))
+	err = models.RecordAgentsCleanedUp(db, idsToDelete)
+	if err != nil {
+		log.Printf("[%s] Error while recording cleaned up agents: %s", CleanerName, err.Error())
+		return err
+	}
+
 	err = db.Where("id IN (?)", idsToDelete).Delete(&models.Agent{}).Error
 	if err != nil {
 		log.Printf("[%s] Error while deleting agents: %s", CleanerName, err.Error())
@@ -0,0 +1,76 @@
+package agenteventcleaner
+
+import (
+	"os"
+	"time"
+
+	database "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/database"
+	"github.com/semaphoreio/semaphore/self_hosted_hub/pkg/models"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const AgentEventCleanerName = "self-hosted-agent-events-cleaner"
+const DefaultRetention = 30 * 24 * time.Hour
+const batchSize = 1000
+
+func Start() {
+	retention := Retention()
+	log.Infof("Keeping agent events for %v", retention)
+
+	for {
+		Tick(retention)
+		time.Sleep(1 * time.Minute)
+	}
+}
+
+// Retention is configured with AGENT_EVENTS_RETENTION, using Go durations, e.g. 720h.
+func Retention() time.Duration {
+	value := os.Getenv("AGENT_EVENTS_RETENTION")
+	if value == "" {
+		return DefaultRetention
+	}
+
+	retention, err := time.ParseDuration(value)
+	if err != nil || retention <= 0 {
+		log.Errorf("Invalid AGENT_EVENTS_RETENTION '%s', using %v", value, DefaultRetention)
+		return DefaultRetention
+	}
+
+	return retention
+}
+
+func Tick(retention time.Duration) {
+	// The advisory lock makes sure that only one cleaner is working at a time.
+	// Its transaction only holds the lock, and the batches are deleted outside of it.
+	_ = database.WithAdvisoryLock(AgentEventCleanerName, func(_ *gorm.DB) error {
+		return deleteOldEvents(database.Conn(), retention)
+	})
+}
+
+// Events are deleted in batches, so a backlog doesn't turn into a single huge delete,
+// until a batch comes back smaller than batchSize and there is nothing left to delete.
+// Every batch is committed on its own, so the deleted rows are not locked until the end.
+func deleteOldEvents(db *gorm.DB, retention time.Duration) error {
+	before := time.Now().Add(-retention)
+
+	var total int64
+	for {
+		deleted, err := models.DeleteAgentEventsOlderThan(db, before, batchSize)
+		if err != nil {
+			log.Errorf("Error deleting agent events: %v", err)
+			return err
+		}
+
+		total += deleted
+		if deleted < batchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		log.Infof("Deleted %d agent events older than %v", total, retention)
+	}
+
+	return nil
+}
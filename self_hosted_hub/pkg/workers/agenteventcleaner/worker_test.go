@@ -0,0 +1,71 @@
+package agenteventcleaner
+
+import (
+	"testing"
+	"time"
+
+	database "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/database"
+	"github.com/semaphoreio/semaphore/self_hosted_hub/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__Retention(t *testing.T) {
+	t.Setenv("AGENT_EVENTS_RETENTION", "")
+	assert.Equal(t, DefaultRetention, Retention())
+
+	t.Setenv("AGENT_EVENTS_RETENTION", "48h")
+	assert.Equal(t, 48*time.Hour, Retention())
+
+	t.Setenv("AGENT_EVENTS_RETENTION", "two days")
+	assert.Equal(t, DefaultRetention, Retention())
+
+	t.Setenv("AGENT_EVENTS_RETENTION", "-1h")
+	assert.Equal(t, DefaultRetention, Retention())
+}
+
+func Test__Tick(t *testing.T) {
+	database.TruncateTables()
+
+	orgID := database.UUID()
+	requesterID := database.UUID()
+	_, _, err := models.CreateAgentType(orgID, &requesterID, "s1-test")
+	require.NoError(t, err)
+
+	agent, _, err := models.RegisterAgent(orgID, "s1-test", "agent-1", models.AgentMetadata{})
+	require.NoError(t, err)
+
+	// a recent event is kept
+	Tick(time.Hour)
+	events, _, err := models.ListAgentEventsWithCursor(orgID, models.AgentEventFilter{}, 10, "")
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	// an old one is deleted
+	err = database.Conn().Exec("UPDATE agent_events SET created_at = ? WHERE agent_id = ?", time.Now().Add(-2*time.Hour), agent.ID).Error
+	require.NoError(t, err)
+
+	Tick(time.Hour)
+	events, _, err = models.ListAgentEventsWithCursor(orgID, models.AgentEventFilter{}, 10, "")
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
+
+func Test__TickDeletesAllOldEvents(t *testing.T) {
+	database.TruncateTables()
+
+	orgID := database.UUID()
+	err := database.Conn().Exec(`
+		INSERT INTO agent_events (organization_id, agent_type_name, agent_id, agent_name, event, created_at)
+		SELECT ?::uuid, 's1-test', uuid_generate_v4(), 'agent-' || n, 'registered', ?::timestamp
+		FROM generate_series(1, ?::int) AS n
+	`, orgID, time.Now().Add(-2*time.Hour), 2*batchSize+1).Error
+	require.NoError(t, err)
+
+	// more than one batch is deleted in a single tick
+	Tick(time.Hour)
+
+	var count int64
+	require.NoError(t, database.Conn().Table("agent_events").Count(&count).Error)
+	assert.Zero(t, count)
+}
@@ -0,0 +1,277 @@
+package autoscalinghints
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/renderedtext/go-watchman"
+	database "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/database"
+	"github.com/semaphoreio/semaphore/self_hosted_hub/pkg/encryption"
+	models "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/models"
+	zebra "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/protos/server_farm.job"
+	"github.com/semaphoreio/semaphore/self_hosted_hub/pkg/publicapi/zebraclient"
+	"github.com/semaphoreio/semaphore/self_hosted_hub/pkg/publicnet"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const NotifierName = "self-hosted-autoscaling-hints"
+
+const (
+	ReasonQueueDepth   = "queue_depth"
+	ReasonIdleCapacity = "idle_capacity"
+)
+
+const (
+	SignatureHeader = "X-Semaphore-Signature-256"
+	TimestampHeader = "X-Semaphore-Timestamp"
+)
+
+// Number of queued jobs samples kept per agent type to compute the queue trend.
+const trendSamples = 5
+
+type JobCounts struct {
+	Queued  int `json:"queued"`
+	Running int `json:"running"`
+}
+
+type AgentCounts struct {
+	Idle     int `json:"idle"`
+	Occupied int `json:"occupied"`
+}
+
+// Hint is the payload sent to the autoscaling webhook of an agent type.
+type Hint struct {
+	OrganizationID  string      `json:"organization_id"`
+	AgentType       string      `json:"agent_type"`
+	Reasons         []string    `json:"reasons"`
+	Jobs            JobCounts   `json:"jobs"`
+	Agents          AgentCounts `json:"agents"`
+	QueuedTrend     int         `json:"queued_trend"`
+	DesiredCapacity int         `json:"desired_capacity"`
+	Timestamp       int64       `json:"timestamp"`
+}
+
+type Notifier struct {
+	client    *http.Client
+	countJobs func(ctx context.Context, orgID, agentType string) (*zebra.CountByStateResponse, error)
+
+	// agent types checked less than this long ago are not claimed again,
+	// so replicas ticking at the same time don't check them twice.
+	checkInterval time.Duration
+}
+
+func NewNotifier() *Notifier {
+	return &Notifier{
+		client:        publicnet.NewClient(10 * time.Second),
+		countJobs:     zebraclient.CountByState,
+		checkInterval: 25 * time.Second,
+	}
+}
+
+func (n *Notifier) Start() {
+	for {
+		n.Tick()
+		time.Sleep(30 * time.Second)
+	}
+}
+
+func (n *Notifier) Tick() {
+	// The advisory lock is only held while the agent types are claimed,
+	// the webhooks are called after the transaction is committed.
+	var states []models.AutoscalingHintState
+	err := database.WithAdvisoryLock(NotifierName, func(tx *gorm.DB) error {
+		var err error
+		states, err = models.ClaimAutoscalingHintStates(tx, n.checkInterval)
+		return err
+	})
+
+	if err != nil {
+		log.Errorf("[%s] Error claiming agent types: %v", NotifierName, err)
+		return
+	}
+
+	for i := range states {
+		err := n.process(&states[i])
+		if err != nil {
+			log.Errorf("[%s] Error processing %s (%s): %v", NotifierName, states[i].AgentTypeName, states[i].OrganizationID, err)
+			_ = watchman.Increment("autoscaling_hints.error")
+		}
+	}
+}
+
+func (n *Notifier) process(state *models.AutoscalingHintState) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	agentType, err := models.FindAgentTypeWithContext(ctx, state.OrganizationID, state.AgentTypeName)
+	if err != nil {
+		return err
+	}
+
+	orgID := agentType.OrganizationID.String()
+	agentCounts, err := models.CountAgentsInStateForType(ctx, orgID, agentType.Name)
+	if err != nil {
+		return err
+	}
+
+	jobCounts, err := n.countJobs(ctx, orgID, agentType.Name)
+	if err != nil {
+		return err
+	}
+
+	hint := n.evaluate(agentType, state, countJobs(jobCounts), AgentCounts{
+		Idle:     int(agentCounts.Idle),
+		Occupied: int(agentCounts.Busy),
+	})
+
+	if hint == nil {
+		return state.Save()
+	}
+
+	err = n.send(ctx, agentType, hint)
+	if err != nil {
+		// the thresholds are considered crossed again on the next check, so the hint is retried
+		for _, reason := range hint.Reasons {
+			switch reason {
+			case ReasonQueueDepth:
+				state.QueueBreached = false
+			case ReasonIdleCapacity:
+				state.IdleBreached = false
+			}
+		}
+
+		if saveErr := state.Save(); saveErr != nil {
+			log.Errorf("[%s] Error saving state of %s (%s): %v", NotifierName, agentType.Name, orgID, saveErr)
+		}
+
+		return err
+	}
+
+	log.Infof("[%s] Sent %v hint for %s (%s): desired capacity %d", NotifierName, hint.Reasons, agentType.Name, orgID, hint.DesiredCapacity)
+	return state.Save()
+}
+
+// evaluate records the new counts for the agent type, and returns a hint
+// only if the queued jobs or idle agents reached their threshold since the last evaluation.
+func (n *Notifier) evaluate(agentType *models.AgentType, state *models.AutoscalingHintState, jobs JobCounts, agents AgentCounts) *Hint {
+	state.QueuedSamples = append(state.QueuedSamples, jobs.Queued)
+	if len(state.QueuedSamples) > trendSamples {
+		state.QueuedSamples = state.QueuedSamples[len(state.QueuedSamples)-trendSamples:]
+	}
+
+	trend := state.QueuedSamples[len(state.QueuedSamples)-1] - state.QueuedSamples[0]
+
+	queueBreached := agentType.AutoscalingQueuedJobsThreshold > 0 && jobs.Queued >= agentType.AutoscalingQueuedJobsThreshold
+	idleBreached := agentType.AutoscalingIdleAgentsThreshold > 0 && agents.Idle >= agentType.AutoscalingIdleAgentsThreshold
+
+	reasons := []string{}
+	if queueBreached && !state.QueueBreached {
+		reasons = append(reasons, ReasonQueueDepth)
+	}
+
+	if idleBreached && !state.IdleBreached {
+		reasons = append(reasons, ReasonIdleCapacity)
+	}
+
+	state.QueueBreached = queueBreached
+	state.IdleBreached = idleBreached
+
+	if len(reasons) == 0 {
+		return nil
+	}
+
+	return &Hint{
+		OrganizationID:  agentType.OrganizationID.String(),
+		AgentType:       agentType.Name,
+		Reasons:         reasons,
+		Jobs:            jobs,
+		Agents:          agents,
+		QueuedTrend:     trend,
+		DesiredCapacity: DesiredCapacity(jobs, agents, trend),
+		Timestamp:       time.Now().Unix(),
+	}
+}
+
+// DesiredCapacity is the number of agents needed to run the jobs already running,
+// the ones waiting in the queue, and the ones the queue is growing by.
+func DesiredCapacity(jobs JobCounts, agents AgentCounts, trend int) int {
+	desired := max(jobs.Running, agents.Occupied) + jobs.Queued
+	if trend > 0 {
+		desired += trend
+	}
+
+	return desired
+}
+
+func (n *Notifier) send(ctx context.Context, agentType *models.AgentType, hint *Hint) error {
+	u, err := url.Parse(agentType.AutoscalingWebhookURL)
+	if err != nil || u.Scheme != "https" {
+		return fmt.Errorf("webhook URL is not an https URL")
+	}
+
+	secret, err := encryption.Decrypt(agentType.AutoscalingWebhookSecret)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(hint)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, agentType.AutoscalingWebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TimestampHeader, strconv.FormatInt(hint.Timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(secret, hint.Timestamp, body))
+
+	res, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+// Sign returns the signature receivers compare with the signature header.
+// The timestamp is signed together with the body, so old payloads can't be replayed.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func countJobs(countByState *zebra.CountByStateResponse) JobCounts {
+	counts := JobCounts{}
+	for _, count := range countByState.Counts {
+		switch count.GetState() {
+		case zebra.Job_ENQUEUED, zebra.Job_SCHEDULED:
+			counts.Queued += int(count.GetCount())
+		case zebra.Job_STARTED:
+			counts.Running += int(count.GetCount())
+		}
+	}
+
+	return counts
+}
@@ -0,0 +1,151 @@
+package autoscalinghints
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/semaphoreio/semaphore/self_hosted_hub/pkg/database"
+	"github.com/semaphoreio/semaphore/self_hosted_hub/pkg/encryption"
+	"github.com/semaphoreio/semaphore/self_hosted_hub/pkg/models"
+	zebra "github.com/semaphoreio/semaphore/self_hosted_hub/pkg/protos/server_farm.job"
+	"github.com/semaphoreio/semaphore/self_hosted_hub/pkg/publicnet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test__Evaluate(t *testing.T) {
+	agentType := &models.AgentType{
+		Name: "s1-test",
+		AutoscalingSettings: models.AutoscalingSettings{
+			AutoscalingQueuedJobsThreshold: 5,
+			AutoscalingIdleAgentsThreshold: 3,
+		},
+	}
+
+	n := NewNotifier()
+	state := &models.AutoscalingHintState{}
+
+	// below the thresholds
+	assert.Nil(t, n.evaluate(agentType, state, JobCounts{Queued: 2, Running: 1}, AgentCounts{Idle: 0, Occupied: 1}))
+
+	// queue crosses the threshold
+	hint := n.evaluate(agentType, state, JobCounts{Queued: 6, Running: 1}, AgentCounts{Idle: 0, Occupied: 1})
+	require.NotNil(t, hint)
+	assert.Equal(t, []string{ReasonQueueDepth}, hint.Reasons)
+	assert.Equal(t, 4, hint.QueuedTrend)
+	assert.Equal(t, 1+6+4, hint.DesiredCapacity)
+
+	// still above the threshold => nothing new to tell
+	assert.Nil(t, n.evaluate(agentType, state, JobCounts{Queued: 8, Running: 1}, AgentCounts{Idle: 0, Occupied: 1}))
+
+	// queue drains, and agents are left idle
+	hint = n.evaluate(agentType, state, JobCounts{Queued: 0, Running: 2}, AgentCounts{Idle: 4, Occupied: 2})
+	require.NotNil(t, hint)
+	assert.Equal(t, []string{ReasonIdleCapacity}, hint.Reasons)
+	assert.Equal(t, 2, hint.DesiredCapacity)
+
+	// queue goes above the threshold again
+	hint = n.evaluate(agentType, state, JobCounts{Queued: 5, Running: 2}, AgentCounts{Idle: 0, Occupied: 2})
+	require.NotNil(t, hint)
+	assert.Equal(t, []string{ReasonQueueDepth}, hint.Reasons)
+}
+
+func Test__Sign(t *testing.T) {
+	body := []byte(`{"agent_type":"s1-test"}`)
+
+	signature := Sign("secret", 1700000000, body)
+	assert.Equal(t, signature, Sign("secret", 1700000000, body))
+	assert.NotEqual(t, signature, Sign("other-secret", 1700000000, body))
+	assert.NotEqual(t, signature, Sign("secret", 1700000001, body))
+	assert.Regexp(t, "^sha256=[0-9a-f]{64}$", signature)
+}
+
+func Test__Tick(t *testing.T) {
+	database.TruncateTables()
+
+	hints := make(chan *Hint, 10)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		timestamp, _ := strconv.ParseInt(r.Header.Get(TimestampHeader), 10, 64)
+		if r.Header.Get(SignatureHeader) != Sign("very-secret", timestamp, body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		hint := &Hint{}
+		_ = json.Unmarshal(body, hint)
+		hints <- hint
+	}))
+
+	defer server.Close()
+
+	secret, err := encryption.Encrypt("very-secret")
+	require.NoError(t, err)
+
+	orgID := database.UUID()
+	requesterID := database.UUID()
+	_, _, err = models.CreateAgentTypeWithSettings(orgID, &requesterID, "s1-test", models.AgentNameSettings{}, models.AutoscalingSettings{
+		AutoscalingWebhookURL:          server.URL,
+		AutoscalingWebhookSecret:       secret,
+		AutoscalingQueuedJobsThreshold: 2,
+	})
+
+	require.NoError(t, err)
+
+	// the test server listens on a loopback address, which the webhook client refuses
+	newNotifier := func() *Notifier {
+		n := NewNotifier()
+		n.client = server.Client()
+		n.checkInterval = 0
+		n.countJobs = func(context.Context, string, string) (*zebra.CountByStateResponse, error) {
+			return &zebra.CountByStateResponse{
+				Counts: []*zebra.CountByStateResponse_CountByState{
+					{State: zebra.Job_ENQUEUED, Count: 3},
+					{State: zebra.Job_STARTED, Count: 1},
+				},
+			}, nil
+		}
+
+		return n
+	}
+
+	n := newNotifier()
+
+	n.Tick()
+	require.Len(t, hints, 1)
+
+	hint := <-hints
+	assert.Equal(t, orgID.String(), hint.OrganizationID)
+	assert.Equal(t, "s1-test", hint.AgentType)
+	assert.Equal(t, []string{ReasonQueueDepth}, hint.Reasons)
+	assert.Equal(t, JobCounts{Queued: 3, Running: 1}, hint.Jobs)
+	assert.Equal(t, 4, hint.DesiredCapacity)
+
+	// the threshold was already crossed, so no new hint is sent
+	n.Tick()
+	assert.Len(t, hints, 0)
+
+	// the state is kept in the database, so other notifiers don't send it again either
+	newNotifier().Tick()
+	assert.Len(t, hints, 0)
+
+	// agent types checked recently are not claimed again
+	n.checkInterval = time.Minute
+	states, err := models.ClaimAutoscalingHintStates(database.Conn(), n.checkInterval)
+	require.NoError(t, err)
+	assert.Empty(t, states)
+}
+
+func Test__WebhookClient(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	_, err := NewNotifier().client.Post(server.URL, "application/json", nil)
+	assert.ErrorIs(t, err, publicnet.ErrNonPublicAddress)
+}
@@ -18,7 +18,7 @@ func Test__DeletingDisconnectedAgents(t *testing.T) {
 	database.TruncateTables()
 
 	at, _, err := models.CreateAgentTypeWithSettings(
-		orgID, &requesterID, "s1-test", models.AgentNameSettings{ReleaseNameAfter: 60},
+		orgID, &requesterID, "s1-test", models.AgentNameSettings{ReleaseNameAfter: 60}, models.AutoscalingSettings{},
 	)
 
 	require.Nil(t, err)